@@ -11,6 +11,7 @@ const (
 	WatcherKind           Kind = "Watcher"
 	ManifestKind          Kind = "Manifest"
 	ModuleReleaseMetaKind Kind = "ModuleReleaseMeta"
+	ModuleCatalogKind     Kind = "ModuleCatalog"
 )
 
 type Kind string
@@ -0,0 +1,32 @@
+package shared
+
+// MaxListedDeletionBlockers is the maximum number of blocking resources listed in DeletionBlockers.
+const MaxListedDeletionBlockers = 10
+
+// DeletionBlockers lists the resources that block the deletion of a module.
+// +k8s:deepcopy-gen=true
+type DeletionBlockers struct {
+	// Count is the total number of blocking resources.
+	Count int `json:"count"`
+
+	// Resources are the blocking resources, limited to the first 10 sorted by namespace and name.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	Resources []Resource `json:"resources,omitempty"`
+}
+
+// NewDeletionBlockers creates DeletionBlockers for the given blocking resources.
+// It returns nil if there are no blocking resources.
+func NewDeletionBlockers(resources []Resource) *DeletionBlockers {
+	if len(resources) == 0 {
+		return nil
+	}
+	listed := resources
+	if len(listed) > MaxListedDeletionBlockers {
+		listed = listed[:MaxListedDeletionBlockers]
+	}
+	return &DeletionBlockers{
+		Count:     len(resources),
+		Resources: append([]Resource(nil), listed...),
+	}
+}
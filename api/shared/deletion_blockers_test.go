@@ -0,0 +1,29 @@
+package shared_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+func TestNewDeletionBlockers_WithoutResources_ReturnsNil(t *testing.T) {
+	assert.Nil(t, shared.NewDeletionBlockers(nil))
+}
+
+func TestNewDeletionBlockers_CapsListedResources(t *testing.T) {
+	resources := make([]shared.Resource, 0, 12)
+	for i := range 12 {
+		resources = append(resources, shared.Resource{Name: fmt.Sprintf("sample-%02d", i), Namespace: "default"})
+	}
+
+	blockers := shared.NewDeletionBlockers(resources)
+
+	require.NotNil(t, blockers)
+	assert.Equal(t, 12, blockers.Count)
+	assert.Len(t, blockers.Resources, shared.MaxListedDeletionBlockers)
+	assert.Equal(t, "sample-00", blockers.Resources[0].Name)
+}
@@ -2,6 +2,7 @@ package shared
 
 const (
 	CACertificateName           = "klm-watcher-serving"
+	CASecretName                = "klm-watcher"
 	IstioNamespace              = "istio-system"
 	GatewaySecretName           = "klm-istio-gateway" //nolint:gosec // It is just a name
 	LastModifiedAtAnnotation    = "lastModifiedAt"
@@ -10,4 +10,26 @@ const (
 	OwnedByFormat             = "%s/%s"
 	IsClusterScopedAnnotation = OperatorGroup + Separator + "is-cluster-scoped"
 	UnmanagedAnnotation       = OperatorGroup + Separator + "is-unmanaged"
+
+	// DryRunAnnotation switches the Kyma reconciliation to plan mode. The changes the reconciliation
+	// would apply are computed and published, but nothing is changed for the Kyma.
+	DryRunAnnotation = OperatorGroup + Separator + "dry-run"
+
+	// MaintenanceWindowBeginAnnotation and MaintenanceWindowEndAnnotation define the maintenance window
+	// chosen for a Kyma runtime. If MaintenanceDaysAnnotation is set, they are times of the day such as
+	// "02:00:00+01:00", otherwise RFC 3339 timestamps.
+	MaintenanceWindowBeginAnnotation = OperatorGroup + Separator + "maintenance-window-begin"
+	MaintenanceWindowEndAnnotation   = OperatorGroup + Separator + "maintenance-window-end"
+	// MaintenanceDaysAnnotation is a comma-separated list of the weekdays the maintenance window recurs on,
+	// such as "Sat,Sun".
+	MaintenanceDaysAnnotation = OperatorGroup + Separator + "maintenance-days"
+
+	// ForceDeleteAnnotation confirms the deletion of the module CRs created by users that block the deletion
+	// of the module of a Manifest. It only takes effect if it is set to ForceDeleteConfirmation.
+	ForceDeleteAnnotation   = OperatorGroup + Separator + "force-delete"
+	ForceDeleteConfirmation = "delete-all-module-crs"
+
+	// UpgradeHookVersionAnnotation records the module version an upgrade hook Job was created for,
+	// so that the Job runs once per version.
+	UpgradeHookVersionAnnotation = OperatorGroup + Separator + "upgrade-hook-version"
 )
@@ -32,6 +32,8 @@ const (
 	SkipReconcileLabel    = OperatorGroup + Separator + "skip-reconciliation"
 	UnmanagedKyma         = "unmanaged-kyma"
 	DefaultRemoteKymaName = "default"
+	// DefaultModuleCatalogName is the name of the ModuleCatalog in the remote namespace of the SKR.
+	DefaultModuleCatalogName = "default"
 
 	InternalLabel = OperatorGroup + Separator + "internal"
 	BetaLabel     = OperatorGroup + Separator + "beta"
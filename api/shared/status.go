@@ -27,6 +27,10 @@ type Status struct {
 	// and it is used to determine effective differences from one state to the next.
 	// +listType=atomic
 	Synced []Resource `json:"synced,omitempty"`
+
+	// DeletionBlockers lists the module CRs created by users that block the deletion of the module.
+	// +optional
+	DeletionBlockers *DeletionBlockers `json:"deletionBlockers,omitempty"`
 }
 
 func (s Status) WithState(state State) Status {
@@ -43,3 +47,8 @@ func (s Status) WithOperation(operation string) Status {
 	s.LastOperation = LastOperation{Operation: operation, LastUpdateTime: apimetav1.NewTime(time.Now())}
 	return s
 }
+
+func (s Status) WithDeletionBlockers(blockers *DeletionBlockers) Status {
+	s.DeletionBlockers = blockers
+	return s
+}
@@ -24,6 +24,26 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionBlockers) DeepCopyInto(out *DeletionBlockers) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]Resource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionBlockers.
+func (in *DeletionBlockers) DeepCopy() *DeletionBlockers {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionBlockers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LastOperation) DeepCopyInto(out *LastOperation) {
 	*out = *in
@@ -72,6 +92,11 @@ func (in *Status) DeepCopyInto(out *Status) {
 		*out = make([]Resource, len(*in))
 		copy(*out, *in)
 	}
+	if in.DeletionBlockers != nil {
+		in, out := &in.DeletionBlockers, &out.DeletionBlockers
+		*out = new(DeletionBlockers)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Status.
@@ -14,6 +14,12 @@ const (
 
 	ConditionTypeSKRImagePullSecretSync KymaConditionType = "SKRImagePullSecretSync"
 
+	// ConditionTypeModuleUpgrades is False while a module upgrade is rolled back, and True otherwise.
+	ConditionTypeModuleUpgrades KymaConditionType = "ModuleUpgrades"
+
+	// ConditionTypeModuleDependencies is only set if an enabled module depends on other modules.
+	ConditionTypeModuleDependencies KymaConditionType = "ModuleDependencies"
+
 	// ConditionReason will be set to `Ready` on all Conditions. If the Condition is actual ready,
 	// can be determined by the state.
 	ConditionReason KymaConditionReason = "Ready"
@@ -28,6 +34,10 @@ const (
 	ConditionMessageSKRWebhookIsOutOfSync       = "skrwebhook is out of sync and needs to be resynchronized"
 	ConditionMessageSKRImagePullSecretSynced    = "skr image pull secret is synchronized"
 	ConditionMessageSKRImagePullSecretOutOfSync = "skr image pull secret is out of sync and needs to be resynchronized"
+	ConditionMessageModuleUpgradesSucceeded     = "all module upgrades succeeded"
+	ConditionMessageModuleUpgradesRolledBack    = "module upgrades were rolled back as the modules did not become ready in time"
+	ConditionMessageModuleDependenciesMet       = "all module dependencies are met"
+	ConditionMessageModuleDependenciesNotMet    = "module dependencies are not met, are cyclic, or block a module deletion"
 )
 
 func GenerateMessage(conditionType KymaConditionType, status apimetav1.ConditionStatus) string {
@@ -70,6 +80,24 @@ func GenerateMessage(conditionType KymaConditionType, status apimetav1.Condition
 		}
 
 		return ConditionMessageSKRImagePullSecretOutOfSync
+	case ConditionTypeModuleUpgrades:
+		switch status {
+		case apimetav1.ConditionTrue:
+			return ConditionMessageModuleUpgradesSucceeded
+		case apimetav1.ConditionUnknown:
+		case apimetav1.ConditionFalse:
+		}
+
+		return ConditionMessageModuleUpgradesRolledBack
+	case ConditionTypeModuleDependencies:
+		switch status {
+		case apimetav1.ConditionTrue:
+			return ConditionMessageModuleDependenciesMet
+		case apimetav1.ConditionUnknown:
+		case apimetav1.ConditionFalse:
+		}
+
+		return ConditionMessageModuleDependenciesNotMet
 	case DeprecatedConditionTypeReady:
 	}
 
@@ -18,10 +18,12 @@ package v1beta2
 
 import (
 	"slices"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -62,6 +64,8 @@ type KymaSpec struct {
 }
 
 // Module defines the components to be installed.
+// +kubebuilder:validation:XValidation:rule="!(has(self.version) && has(self.channel))",message="version and channel are mutually exclusive options"
+// +kubebuilder:validation:XValidation:rule="!has(self.config) || self.customResourcePolicy != 'Ignore'",message="config requires the CreateAndDelete customResourcePolicy"
 type Module struct {
 	// +kubebuilder:default:=CreateAndDelete
 	CustomResourcePolicy `json:"customResourcePolicy,omitempty"`
@@ -83,13 +87,14 @@ type Module struct {
 	Channel string `json:"channel,omitempty"`
 
 	// Version is the desired version of the Module. If this changes or is set, it will be used to resolve a new
-	// ModuleTemplate based on this specific version.
+	// ModuleTemplate based on this specific version. A module with a Version is pinned to it and does not
+	// follow any channel updates until the Version is changed or removed.
 	// The Version and Channel are mutually exclusive options.
 	// The regular expression come from here:
 	// https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
-	// json:"-" to disable installation of specific versions until decided to roll this out
-	// see https://github.com/kyma-project/lifecycle-manager/issues/1847
-	Version string `json:"-"`
+	// +kubebuilder:validation:Pattern:=`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	// +kubebuilder:validation:MaxLength:=32
+	Version string `json:"version,omitempty"`
 
 	// RemoteModuleTemplateRef is deprecated and will no longer have any functionality.
 	// It will be removed in the upcoming API version.
@@ -99,6 +104,14 @@ type Module struct {
 	// for the lifecycle of the module.
 	// +kubebuilder:default:=true
 	Managed bool `json:"managed"`
+
+	// Config is a partial module CR that is merged over the default data of the ModuleTemplate.
+	// It allows configuring the default module CR per runtime while the module CR stays managed.
+	// Changes of the Config are propagated to the module CR.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	Config *machineryruntime.RawExtension `json:"config,omitempty"`
 }
 
 // CustomResourcePolicy determines how a ModuleTemplate should be parsed. When CustomResourcePolicy is set to
@@ -152,6 +165,52 @@ type KymaStatus struct {
 	// Active Channel
 	// +optional
 	ActiveChannel string `json:"activeChannel,omitempty"`
+
+	// MaintenanceWindow contains the next maintenance window resolved for the Kyma
+	// and the modules whose upgrade is waiting for it.
+	// +optional
+	MaintenanceWindow *MaintenanceWindowStatus `json:"maintenanceWindow,omitempty"`
+}
+
+// MaintenanceWindowStatus previews when module upgrades that require downtime are applied.
+type MaintenanceWindowStatus struct {
+	// NextWindow is the next maintenance window resolved from the maintenance window policy.
+	// If a window is ongoing, it is the ongoing window.
+	// +optional
+	NextWindow *ResolvedMaintenanceWindow `json:"nextWindow,omitempty"`
+
+	// ActiveBlackout is the ongoing blackout period, during which no module version change is applied.
+	// +optional
+	ActiveBlackout *ResolvedMaintenanceWindow `json:"activeBlackout,omitempty"`
+
+	// WaitingModules lists the modules whose upgrade is held back until the next maintenance window
+	// or the end of the active blackout period.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	WaitingModules []WaitingModule `json:"waitingModules,omitempty"`
+}
+
+// ResolvedMaintenanceWindow is a maintenance window resolved for a Kyma.
+type ResolvedMaintenanceWindow struct {
+	// Begin is the start of the maintenance window.
+	Begin apimetav1.Time `json:"begin"`
+
+	// End is the end of the maintenance window.
+	End apimetav1.Time `json:"end"`
+}
+
+// WaitingModule is a module whose upgrade is held back until the next maintenance window.
+type WaitingModule struct {
+	// Name is the name of the module.
+	Name string `json:"name"`
+
+	// CurrentVersion is the module version installed in the runtime.
+	// +optional
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// TargetVersion is the module version that is installed in the next maintenance window.
+	TargetVersion string `json:"targetVersion"`
 }
 
 func (status *KymaStatus) GetModuleStatus(moduleName string) *ModuleStatus {
@@ -177,7 +236,8 @@ type ModuleStatus struct {
 	// a new lookup to be necessary that maybe picks a different ModuleTemplate, which is why we need to reconcile.
 	Channel string `json:"channel,omitempty"`
 
-	// Channel tracks the active Version of the Module.
+	// Version tracks the active Version of the Module.
+	// If the Module is pinned to a specific version, Channel is set to "none".
 	Version string `json:"version,omitempty"`
 
 	// Message is a human-readable message indicating details about the State.
@@ -200,6 +260,58 @@ type ModuleStatus struct {
 	// Maintenance indicates whether the module is currently in a maintenance window.
 	// +kubebuilder:default:=false
 	Maintenance bool `json:"maintenance,omitempty"`
+
+	// Upgrade tracks a module upgrade until the module reaches the Ready state.
+	// If the module does not become Ready within the health deadline, the upgrade is rolled back.
+	// +optional
+	Upgrade *ModuleUpgrade `json:"upgrade,omitempty"`
+
+	// FailedVersions lists the module versions that did not become Ready within the health deadline
+	// and were rolled back. These versions are not installed again in this runtime.
+	// Only the last 10 failed versions are kept.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems:=10
+	FailedVersions []string `json:"failedVersions,omitempty"`
+
+	// DeletionBlockers lists the module CRs created by users that block the deletion of the module.
+	// +optional
+	DeletionBlockers *shared.DeletionBlockers `json:"deletionBlockers,omitempty"`
+}
+
+// ModuleUpgrade tracks the version a module is upgraded from.
+type ModuleUpgrade struct {
+	// PreviousVersion is the module version that was active before the upgrade.
+	PreviousVersion string `json:"previousVersion"`
+
+	// PreviousTemplate contains information about the ModuleTemplate that was active before the upgrade.
+	// +optional
+	PreviousTemplate *TrackingObject `json:"previousTemplate,omitempty"`
+
+	// StartedAt is the time when the upgrade was applied.
+	StartedAt apimetav1.Time `json:"startedAt"`
+}
+
+// MaxFailedVersions is the number of failed versions kept in the status of a module.
+const MaxFailedVersions = 10
+
+// AddFailedVersion marks the given version as rolled back in this runtime.
+// If more than MaxFailedVersions versions failed, the oldest ones are dropped.
+func (m *ModuleStatus) AddFailedVersion(version string) {
+	m.FailedVersions = append(m.FailedVersions, version)
+	if len(m.FailedVersions) > MaxFailedVersions {
+		m.FailedVersions = m.FailedVersions[len(m.FailedVersions)-MaxFailedVersions:]
+	}
+}
+
+// IsFailedVersion checks if the given version was rolled back in this runtime.
+func (m *ModuleStatus) IsFailedVersion(version string) bool {
+	return slices.Contains(m.FailedVersions, version)
+}
+
+// HealthDeadlineExceeded checks if the upgrade did not finish within the given deadline.
+func (u *ModuleUpgrade) HealthDeadlineExceeded(deadline time.Duration, now time.Time) bool {
+	return now.After(u.StartedAt.Add(deadline))
 }
 
 func (m *ModuleStatus) GetManifestCR() *unstructured.Unstructured {
@@ -398,6 +510,11 @@ func (kyma *Kyma) SkipReconciliation() bool {
 	return found && shared.IsEnabled(skip)
 }
 
+func (kyma *Kyma) IsDryRun() bool {
+	dryRun, found := kyma.Annotations[shared.DryRunAnnotation]
+	return found && shared.IsEnabled(dryRun)
+}
+
 func (kyma *Kyma) IsInternal() bool {
 	internal, found := kyma.Labels[shared.InternalLabel]
 	return found && shared.IsEnabled(internal)
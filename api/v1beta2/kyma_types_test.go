@@ -1,6 +1,7 @@
 package v1beta2_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -244,3 +245,52 @@ func Test_GetRuntimeID(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsDryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name:        "Test IsDryRun() with enabled annotation",
+			annotations: map[string]string{shared.DryRunAnnotation: "true"},
+			want:        true,
+		},
+		{
+			name:        "Test IsDryRun() with disabled annotation",
+			annotations: map[string]string{shared.DryRunAnnotation: "false"},
+			want:        false,
+		},
+		{
+			name:        "Test IsDryRun() with non-existing annotation",
+			annotations: map[string]string{},
+			want:        false,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			kyma := &v1beta2.Kyma{
+				ObjectMeta: apimetav1.ObjectMeta{
+					Annotations: testCase.annotations,
+					Name:        "test-kyma",
+				},
+			}
+
+			assert.Equal(t, testCase.want, kyma.IsDryRun())
+		})
+	}
+}
+
+func Test_AddFailedVersion_KeepsLastMaxFailedVersions(t *testing.T) {
+	moduleStatus := &v1beta2.ModuleStatus{}
+	for i := range v1beta2.MaxFailedVersions + 2 {
+		moduleStatus.AddFailedVersion(fmt.Sprintf("1.0.%d", i))
+	}
+
+	assert.Len(t, moduleStatus.FailedVersions, v1beta2.MaxFailedVersions)
+	assert.False(t, moduleStatus.IsFailedVersion("1.0.0"))
+	assert.False(t, moduleStatus.IsFailedVersion("1.0.1"))
+	assert.Equal(t, "1.0.2", moduleStatus.FailedVersions[0])
+	assert.True(t, moduleStatus.IsFailedVersion(fmt.Sprintf("1.0.%d", v1beta2.MaxFailedVersions+1)))
+}
@@ -0,0 +1,184 @@
+package v1beta2
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MaintenanceWindowPolicyConditionTypeValid indicates whether the policy could be parsed.
+	MaintenanceWindowPolicyConditionTypeValid = "Valid"
+	// MaintenanceWindowPolicyConditionReasonParsed is the reason of a policy that was parsed successfully.
+	MaintenanceWindowPolicyConditionReasonParsed = "Parsed"
+	// MaintenanceWindowPolicyConditionReasonParseError is the reason of a policy that could not be parsed.
+	MaintenanceWindowPolicyConditionReasonParseError = "ParseError"
+)
+
+// MaintenanceWindowPolicy defines the maintenance windows in which module upgrades requiring downtime
+// are applied to the Kyma runtimes. Lifecycle Manager uses the policy with the configured name and
+// reloads it whenever it changes.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=mwp
+// +kubebuilder:printcolumn:name="Valid",type="string",JSONPath=".status.conditions[?(@.type==\"Valid\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+type MaintenanceWindowPolicy struct {
+	apimetav1.TypeMeta   `json:",inline"`
+	apimetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MaintenanceWindowPolicySpec   `json:"spec,omitempty"`
+	Status MaintenanceWindowPolicyStatus `json:"status,omitempty"`
+}
+
+// MaintenanceWindowPolicySpec defines the rules matching Kyma runtimes to maintenance windows.
+type MaintenanceWindowPolicySpec struct {
+	// Rules are evaluated in order. The windows of the first rule matching a runtime are used for it.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=100
+	Rules []MaintenancePolicyRule `json:"rules,omitempty"`
+
+	// Default is the maintenance window of the runtimes that no rule matches,
+	// or whose matching rule provides no upcoming window.
+	Default MaintenancePolicyWindow `json:"default"`
+
+	// Blackouts are periods in which no module version change is applied to the matched runtimes,
+	// regardless of whether the module requires downtime.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=100
+	Blackouts []MaintenancePolicyBlackout `json:"blackouts,omitempty"`
+}
+
+// MaintenancePolicyRule assigns maintenance windows to the runtimes it matches.
+type MaintenancePolicyRule struct {
+	// Match selects the runtimes the rule applies to.
+	Match MaintenancePolicyMatch `json:"match"`
+
+	// Windows are the maintenance windows of the matched runtimes. The first upcoming window is used.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=20
+	Windows []MaintenancePolicyWindow `json:"windows"`
+
+	// CustomerWindows defines how the maintenance window chosen for a runtime in the annotations
+	// of its Kyma is used. With Override, the window of the runtime replaces the windows of the rule.
+	// With Intersect, only the times in which the window of the runtime overlaps the windows of the rule are used.
+	// If not set, the window of the runtime is ignored.
+	// +optional
+	// +kubebuilder:validation:Enum:=Override;Intersect
+	CustomerWindows string `json:"customerWindows,omitempty"`
+}
+
+// MaintenancePolicyBlackout defines periods in which module version changes are frozen, such as year-end freezes.
+type MaintenancePolicyBlackout struct {
+	// Match selects the runtimes the blackout applies to. If not set, the blackout applies to all runtimes.
+	// +optional
+	Match *MaintenancePolicyMatch `json:"match,omitempty"`
+
+	// Windows are the blackout periods. A runtime is in a blackout while any of the windows is ongoing.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=20
+	Windows []MaintenancePolicyWindow `json:"windows"`
+}
+
+// MaintenancePolicyMatch selects runtimes by regular expressions on their attributes.
+// A runtime is matched if any of the set expressions matches the corresponding attribute.
+// +kubebuilder:validation:XValidation:rule="has(self.globalAccountID) || has(self.plan) || has(self.region) || has(self.platformRegion)",message="at least one of 'globalAccountID', 'plan', 'region' or 'platformRegion' must be specified"
+type MaintenancePolicyMatch struct {
+	// GlobalAccountID is a regular expression matching the global account ID of the runtime.
+	// +optional
+	// +kubebuilder:validation:MaxLength:=256
+	// +kubebuilder:validation:XValidation:rule="''.matches(self) || !''.matches(self)",message="must be a valid regular expression"
+	GlobalAccountID string `json:"globalAccountID,omitempty"` //nolint:tagliatelle // matches the JSON policy format
+
+	// Plan is a regular expression matching the plan of the runtime.
+	// +optional
+	// +kubebuilder:validation:MaxLength:=256
+	// +kubebuilder:validation:XValidation:rule="''.matches(self) || !''.matches(self)",message="must be a valid regular expression"
+	Plan string `json:"plan,omitempty"`
+
+	// Region is a regular expression matching the region of the runtime.
+	// +optional
+	// +kubebuilder:validation:MaxLength:=256
+	// +kubebuilder:validation:XValidation:rule="''.matches(self) || !''.matches(self)",message="must be a valid regular expression"
+	Region string `json:"region,omitempty"`
+
+	// PlatformRegion is a regular expression matching the platform region of the runtime.
+	// +optional
+	// +kubebuilder:validation:MaxLength:=256
+	// +kubebuilder:validation:XValidation:rule="''.matches(self) || !''.matches(self)",message="must be a valid regular expression"
+	PlatformRegion string `json:"platformRegion,omitempty"`
+}
+
+// MaintenancePolicyWindow defines a maintenance window.
+// If Days is empty, Begin and End are RFC 3339 timestamps, for example, "2026-01-10T02:00:00Z".
+// Otherwise, Begin and End are times of the day with a time zone, for example, "02:00:00+01:00",
+// and the window recurs on each of the given days.
+// +kubebuilder:validation:XValidation:rule="has(self.days) && size(self.days) > 0 ? self.begin.matches('^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](Z|[+-]([01][0-9]|2[0-3]):[0-5][0-9])$') && self.end.matches('^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](Z|[+-]([01][0-9]|2[0-3]):[0-5][0-9])$') : true",message="'begin' and 'end' must be times of the day in the format hh:mm:ss followed by Z or a time zone offset if 'days' are specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.days) || size(self.days) == 0 ? self.begin.matches('^[0-9]{4}-[0-9]{2}-[0-9]{2}T([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]([.][0-9]+)?(Z|[+-]([01][0-9]|2[0-3]):[0-5][0-9])$') && self.end.matches('^[0-9]{4}-[0-9]{2}-[0-9]{2}T([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]([.][0-9]+)?(Z|[+-]([01][0-9]|2[0-3]):[0-5][0-9])$') : true",message="'begin' and 'end' must be RFC 3339 timestamps if no 'days' are specified"
+type MaintenancePolicyWindow struct {
+	// Days are the weekdays the window recurs on.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems:=7
+	// +kubebuilder:validation:items:Enum:=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+	Days []string `json:"days,omitempty"`
+
+	// Begin is the start of the window.
+	// +kubebuilder:validation:MaxLength:=64
+	Begin string `json:"begin"`
+
+	// End is the end of the window. A recurring window ending before its begin ends on the next day.
+	// +kubebuilder:validation:MaxLength:=64
+	End string `json:"end"`
+}
+
+// MaintenanceWindowPolicyStatus defines the observed state of MaintenanceWindowPolicy.
+type MaintenanceWindowPolicyStatus struct {
+	// ObservedGeneration is the generation of the policy that was last parsed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions report whether the policy could be parsed. Parse errors are reported in the message
+	// of the Valid condition.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []apimetav1.Condition `json:"conditions,omitempty"`
+
+	// Rules reports the number of Kymas each rule matches, in the order of the rules in the spec.
+	// +optional
+	// +listType=atomic
+	Rules []MaintenancePolicyRuleStatus `json:"rules,omitempty"`
+
+	// DefaultMatchedKymas is the number of Kymas that no rule matches.
+	// +optional
+	DefaultMatchedKymas int `json:"defaultMatchedKymas,omitempty"`
+}
+
+// MaintenancePolicyRuleStatus reports the Kymas matched by a rule.
+type MaintenancePolicyRuleStatus struct {
+	// Index is the position of the rule in the spec.
+	Index int `json:"index"`
+
+	// MatchedKymas is the number of Kymas for which the rule is the first matching rule.
+	MatchedKymas int `json:"matchedKymas"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceWindowPolicyList contains a list of MaintenanceWindowPolicy.
+type MaintenanceWindowPolicyList struct {
+	apimetav1.TypeMeta `json:",inline"`
+	apimetav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MaintenanceWindowPolicy `json:"items"`
+}
+
+//nolint:gochecknoinits // registers MaintenanceWindowPolicy CRD on startup
+func init() {
+	SchemeBuilder.Register(&MaintenanceWindowPolicy{}, &MaintenanceWindowPolicyList{})
+}
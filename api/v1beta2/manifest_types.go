@@ -76,6 +76,14 @@ type ManifestSpec struct {
 	// Resource specifies a resource to be watched for state updates
 	Resource *unstructured.Unstructured `json:"resource,omitempty"`
 
+	// ResourceOverride is the partial configuration of the Resource that is defined per runtime.
+	// It is already merged into the Resource and is additionally applied to the existing Resource
+	// whenever it changes, so that changes of the configuration are propagated.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	ResourceOverride *machineryruntime.RawExtension `json:"resourceOverride,omitempty"`
+
 	// LocalizedImages specifies a list of docker image references valid for the environment
 	// where the Manifest is installed.
 	// The list entries are corresponding to the images actually used in the K8s resources of the Kyma module.
@@ -87,6 +95,36 @@ type ManifestSpec struct {
 	// Manager contains information for identifying a module's resource that can be used as indicator for the installation readiness of the module. Typically, this is the manager Deployment of the module. In exceptional cases, it may also be another resource.
 	// +optional
 	Manager *Manager `json:"manager,omitempty"`
+
+	// Hooks specify the Jobs that run in the remote cluster before and after the resources of a new
+	// installation layer are applied.
+	// +optional
+	Hooks *ManifestUpgradeHooks `json:"hooks,omitempty"`
+
+	// TemplateManifest enables the templating of the raw manifest with the values of the runtime
+	// and the configuration of the module before it is applied.
+	// +optional
+	TemplateManifest bool `json:"templateManifest,omitempty"`
+}
+
+// ManifestUpgradeHooks defines the Jobs that run during an upgrade of the module.
+type ManifestUpgradeHooks struct {
+	// PreUpgrade runs before the resources of the new installation layer are applied.
+	// +optional
+	PreUpgrade *ManifestUpgradeHook `json:"preUpgrade,omitempty"`
+
+	// PostUpgrade runs after the resources of the new installation layer are applied and the module is ready.
+	// +optional
+	PostUpgrade *ManifestUpgradeHook `json:"postUpgrade,omitempty"`
+}
+
+// ManifestUpgradeHook defines the OCI layer with the manifest of a hook Job.
+type ManifestUpgradeHook struct {
+	// Image specifies the OCI layer that contains the manifest of the hook.
+	Image ImageSpec `json:"image"`
+
+	// Timeout is the duration the Job may run before the hook is considered failed.
+	Timeout apimetav1.Duration `json:"timeout"`
 }
 
 // ImageSpec defines OCI Image specifications.
@@ -146,6 +184,12 @@ func (manifest *Manifest) IsUnmanaged() bool {
 		manifest.GetAnnotations()[shared.UnmanagedAnnotation] == shared.EnableLabelValue
 }
 
+// IsForceDeleteConfirmed reports whether the module CRs blocking the deletion of the Manifest may be deleted.
+func (manifest *Manifest) IsForceDeleteConfirmed() bool {
+	return manifest.GetAnnotations() != nil &&
+		manifest.GetAnnotations()[shared.ForceDeleteAnnotation] == shared.ForceDeleteConfirmation
+}
+
 func (manifest *Manifest) IsMandatoryModule() bool {
 	return manifest.GetLabels() != nil && manifest.GetLabels()[shared.IsMandatoryModule] == shared.EnableLabelValue
 }
@@ -88,3 +88,35 @@ func TestGetCacheKey(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, expectedKey, key)
 }
+
+func TestIsForceDeleteConfirmed(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name:        "returns true when annotation has the confirmation value",
+			annotations: map[string]string{shared.ForceDeleteAnnotation: shared.ForceDeleteConfirmation},
+			want:        true,
+		},
+		{
+			name:        "returns false when annotation has another value",
+			annotations: map[string]string{shared.ForceDeleteAnnotation: "true"},
+			want:        false,
+		},
+		{
+			name:        "returns false when annotation is absent",
+			annotations: nil,
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := &v1beta2.Manifest{}
+			manifest.SetAnnotations(tt.annotations)
+			require.Equal(t, tt.want, manifest.IsForceDeleteConfirmed())
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package v1beta2
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ModuleCatalog lists the modules available to a Kyma runtime in a single resource.
+// Lifecycle Manager computes it with the same filtering as the ModuleTemplates and ModuleReleaseMetas
+// synchronized to the runtime and keeps it up to date.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=mcat
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+type ModuleCatalog struct {
+	apimetav1.TypeMeta   `json:",inline"`
+	apimetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ModuleCatalogSpec `json:"spec,omitempty"`
+}
+
+// ModuleCatalogSpec defines the modules available to the Kyma runtime.
+type ModuleCatalogSpec struct {
+	// Modules are the available modules, sorted by name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Modules []ModuleCatalogEntry `json:"modules,omitempty"`
+}
+
+// ModuleCatalogEntry describes an available module with its channels and versions.
+type ModuleCatalogEntry struct {
+	// Name is the name of the module.
+	Name string `json:"name"`
+
+	// Channels are the channels of the module with the version assigned to the Kyma runtime.
+	// +optional
+	// +listType=map
+	// +listMapKey=channel
+	Channels []ModuleCatalogChannel `json:"channels,omitempty"`
+
+	// Versions are the available versions of the module, sorted by semantic version.
+	// +optional
+	// +listType=map
+	// +listMapKey=version
+	Versions []ModuleCatalogVersion `json:"versions,omitempty"`
+}
+
+// ModuleCatalogChannel assigns a module version to a channel.
+type ModuleCatalogChannel struct {
+	// Channel is the module channel.
+	Channel string `json:"channel"`
+
+	// Version is the module version of the channel.
+	Version string `json:"version"`
+}
+
+// ModuleCatalogVersion describes an available version of a module.
+type ModuleCatalogVersion struct {
+	// Version is the module version.
+	Version string `json:"version"`
+
+	// Beta indicates that the version is only available to beta Kyma runtimes.
+	// +optional
+	Beta bool `json:"beta,omitempty"`
+
+	// Internal indicates that the version is only available to internal Kyma runtimes.
+	// +optional
+	Internal bool `json:"internal,omitempty"`
+
+	// Info contains the documentation, repository and icon links of the module version.
+	// +optional
+	Info *ModuleInfo `json:"info,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ModuleCatalogList contains a list of ModuleCatalog.
+type ModuleCatalogList struct {
+	apimetav1.TypeMeta `json:",inline"`
+	apimetav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ModuleCatalog `json:"items"`
+}
+
+//nolint:gochecknoinits // registers ModuleCatalog CRD on startup
+func init() {
+	SchemeBuilder.Register(&ModuleCatalog{}, &ModuleCatalogList{})
+}
@@ -1,9 +1,14 @@
 package v1beta2
 
 import (
+	"hash/fnv"
+
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
+const rolloutBuckets = 100
+
 // ModuleReleaseMeta is the representation of the channel-version pairs for modules. Each item represents
 // a module version along with its assigned channel.
 //
@@ -11,13 +16,15 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:singular=modulereleasemeta,path=modulereleasemetas,shortName=mrm
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 
 type ModuleReleaseMeta struct {
 	apimetav1.TypeMeta   `json:",inline"`
 	apimetav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ModuleReleaseMetaSpec `json:"spec,omitempty"`
+	Spec   ModuleReleaseMetaSpec   `json:"spec,omitempty"`
+	Status ModuleReleaseMetaStatus `json:"status,omitempty"`
 }
 
 // ModuleReleaseMetaSpec defines the channel-version assignments for a module.
@@ -79,6 +86,7 @@ type ModuleReleaseMetaList struct {
 	Items []ModuleReleaseMeta `json:"items"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.rollout) || has(self.rollout) || self.version == oldSelf.rollout.version",message="a rollout can only be removed once its version is assigned to the channel"
 type ChannelVersionAssignment struct {
 	// Channel is the module channel.
 	// +kubebuilder:validation:Pattern:=^[a-z]+$
@@ -90,6 +98,61 @@ type ChannelVersionAssignment struct {
 	// +kubebuilder:validation:Pattern:=`^((0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[a-zA-Z-][0-9a-zA-Z-]*)?)?$`
 	// +kubebuilder:validation:MaxLength:=32
 	Version string `json:"version"`
+
+	// Rollout stages a candidate version to a cohort of Kymas on this channel.
+	// Kymas outside the cohort keep receiving Version.
+	// The rollout can only be removed once its version is assigned to the channel.
+	// +optional
+	Rollout *ChannelRollout `json:"rollout,omitempty"`
+}
+
+// ChannelRollout defines a candidate version and the cohort of Kymas that receives it.
+// A Kyma is part of the cohort if it matches the Selector or if the deterministic hash of its name
+// and the module name falls into the configured Percentage.
+// Kymas that already run the candidate version keep it if the cohort shrinks, because modules are not downgraded.
+// +kubebuilder:validation:XValidation:rule="has(self.percentage) || has(self.selector)",message="at least one of 'percentage' or 'selector' must be specified"
+type ChannelRollout struct {
+	// Version is the candidate module version rolled out to the cohort.
+	// +kubebuilder:validation:Pattern:=`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[a-zA-Z-][0-9a-zA-Z-]*)?$`
+	// +kubebuilder:validation:MaxLength:=32
+	Version string `json:"version"`
+
+	// Percentage is the share of Kymas on the channel that receives the candidate version.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=100
+	Percentage *int32 `json:"percentage,omitempty"`
+
+	// Selector selects Kymas by label that receive the candidate version regardless of Percentage.
+	// +optional
+	Selector *apimetav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// ModuleReleaseMetaStatus defines the observed state of ModuleReleaseMeta.
+type ModuleReleaseMetaStatus struct {
+	// Rollouts reports the progress of the staged rollouts configured in the channels.
+	// +optional
+	// +listType=map
+	// +listMapKey=channel
+	Rollouts []ChannelRolloutStatus `json:"rollouts,omitempty"`
+}
+
+// ChannelRolloutStatus reports the progress of a staged rollout on a channel.
+type ChannelRolloutStatus struct {
+	// Channel is the module channel the rollout is configured for.
+	Channel string `json:"channel"`
+
+	// Version is the candidate module version rolled out to the cohort.
+	Version string `json:"version"`
+
+	// TotalKymas is the number of Kymas that use the module on the channel.
+	TotalKymas int `json:"totalKymas"`
+
+	// TargetedKymas is the number of Kymas that are part of the rollout cohort.
+	TargetedKymas int `json:"targetedKymas"`
+
+	// UpdatedKymas is the number of Kymas in the cohort that already report the candidate version.
+	UpdatedKymas int `json:"updatedKymas"`
 }
 
 //nolint:gochecknoinits // registers ModuleReleaseMeta CRD on startup
@@ -104,3 +167,51 @@ func (m ModuleReleaseMeta) IsBeta() bool {
 func (m ModuleReleaseMeta) IsInternal() bool {
 	return m.Spec.Internal
 }
+
+// VersionFor resolves the version of the channel for the given Kyma and module.
+// It returns the rollout candidate version if the Kyma is part of the rollout cohort or already runs the candidate
+// version, otherwise the channel version. Pinning the Kymas that already run the candidate version keeps them
+// from being downgraded when the percentage is lowered or the selector changes.
+func (c ChannelVersionAssignment) VersionFor(moduleName string, kyma *Kyma) string {
+	if c.Rollout == nil {
+		return c.Version
+	}
+	if c.Rollout.Includes(moduleName, kyma) || c.Rollout.isInstalled(moduleName, kyma) {
+		return c.Rollout.Version
+	}
+	return c.Version
+}
+
+// Includes checks if the given Kyma is part of the rollout cohort of the module.
+// The cohort is derived from a hash of the module and Kyma name, so a Kyma stays in the cohort while the
+// percentage grows, and the cohorts of different modules are independent of each other.
+func (r *ChannelRollout) Includes(moduleName string, kyma *Kyma) bool {
+	if kyma == nil {
+		return false
+	}
+	if r.Selector != nil {
+		selector, err := apimetav1.LabelSelectorAsSelector(r.Selector)
+		if err == nil && !selector.Empty() && selector.Matches(labels.Set(kyma.GetLabels())) {
+			return true
+		}
+	}
+	if r.Percentage == nil {
+		return false
+	}
+	return RolloutBucket(moduleName, kyma.GetName()) < *r.Percentage
+}
+
+func (r *ChannelRollout) isInstalled(moduleName string, kyma *Kyma) bool {
+	if kyma == nil {
+		return false
+	}
+	moduleStatus, found := kyma.GetModuleStatusMap()[moduleName]
+	return found && moduleStatus.Version == r.Version
+}
+
+// RolloutBucket deterministically assigns a Kyma name to one of 100 rollout buckets of the module.
+func RolloutBucket(moduleName, kymaName string) int32 {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(moduleName + "/" + kymaName))
+	return int32(hash.Sum32() % rolloutBuckets) //nolint:gosec // bucket is always below 100
+}
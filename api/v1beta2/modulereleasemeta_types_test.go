@@ -0,0 +1,118 @@
+package v1beta2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+const moduleName = "test-module"
+
+func TestChannelRollout_Includes_WhenSelectorMatches(t *testing.T) {
+	rollout := &v1beta2.ChannelRollout{
+		Version: "1.1.0",
+		Selector: &apimetav1.LabelSelector{
+			MatchLabels: map[string]string{"canary": "true"},
+		},
+	}
+
+	assert.True(t, rollout.Includes(moduleName, kymaWithLabels("kyma", map[string]string{"canary": "true"})))
+	assert.False(t, rollout.Includes(moduleName, kymaWithLabels("kyma", map[string]string{"canary": "false"})))
+}
+
+func TestChannelRollout_Includes_WhenEmptySelector_DoesNotMatchAll(t *testing.T) {
+	rollout := &v1beta2.ChannelRollout{
+		Version:  "1.1.0",
+		Selector: &apimetav1.LabelSelector{},
+	}
+
+	assert.False(t, rollout.Includes(moduleName, kymaWithLabels("kyma", nil)))
+}
+
+func TestChannelRollout_Includes_WhenNilKyma(t *testing.T) {
+	percentage := int32(100)
+	rollout := &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &percentage}
+
+	assert.False(t, rollout.Includes(moduleName, nil))
+}
+
+func TestChannelRollout_Includes_PercentageIsDeterministicAndMonotonic(t *testing.T) {
+	const kymaCount = 1000
+	small, large := int32(5), int32(50)
+	smallRollout := &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &small}
+	largeRollout := &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &large}
+
+	included := 0
+	for i := range kymaCount {
+		kyma := kymaWithLabels(fmt.Sprintf("kyma-%d", i), nil)
+		inSmall := smallRollout.Includes(moduleName, kyma)
+		require.Equal(t, inSmall, smallRollout.Includes(moduleName, kyma))
+		if inSmall {
+			included++
+			require.True(t, largeRollout.Includes(moduleName, kyma))
+		}
+	}
+
+	assert.InDelta(t, kymaCount*small/100, included, 30)
+}
+
+func TestChannelVersionAssignment_VersionFor(t *testing.T) {
+	full, none := int32(100), int32(0)
+	kyma := kymaWithLabels("kyma", nil)
+
+	withoutRollout := v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"}
+	assert.Equal(t, "1.0.0", withoutRollout.VersionFor(moduleName, kyma))
+
+	fullRollout := v1beta2.ChannelVersionAssignment{
+		Channel: "regular", Version: "1.0.0",
+		Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &full},
+	}
+	assert.Equal(t, "1.1.0", fullRollout.VersionFor(moduleName, kyma))
+
+	noRollout := v1beta2.ChannelVersionAssignment{
+		Channel: "regular", Version: "1.0.0",
+		Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &none},
+	}
+	assert.Equal(t, "1.0.0", noRollout.VersionFor(moduleName, kyma))
+}
+
+func TestChannelRollout_Includes_CohortsDifferPerModule(t *testing.T) {
+	const kymaCount = 1000
+	percentage := int32(10)
+	rollout := &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &percentage}
+
+	inBoth := 0
+	for i := range kymaCount {
+		kyma := kymaWithLabels(fmt.Sprintf("kyma-%d", i), nil)
+		if rollout.Includes("module-a", kyma) && rollout.Includes("module-b", kyma) {
+			inBoth++
+		}
+	}
+
+	// independent cohorts of 10% overlap in about 1% of the Kymas
+	assert.Less(t, inBoth, kymaCount*int(percentage)/100/2)
+}
+
+func TestChannelVersionAssignment_VersionFor_WhenCohortShrinks_PinsUpgradedKyma(t *testing.T) {
+	none := int32(0)
+	assignment := v1beta2.ChannelVersionAssignment{
+		Channel: "regular", Version: "1.0.0",
+		Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &none},
+	}
+	upgraded := kymaWithLabels("upgraded", nil)
+	upgraded.Status.Modules = []v1beta2.ModuleStatus{{Name: moduleName, Version: "1.1.0"}}
+	otherModule := kymaWithLabels("other-module", nil)
+	otherModule.Status.Modules = []v1beta2.ModuleStatus{{Name: "other-module", Version: "1.1.0"}}
+
+	assert.Equal(t, "1.1.0", assignment.VersionFor(moduleName, upgraded))
+	assert.Equal(t, "1.0.0", assignment.VersionFor(moduleName, otherModule))
+}
+
+func kymaWithLabels(name string, labels map[string]string) *v1beta2.Kyma {
+	return &v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{Name: name, Labels: labels}}
+}
@@ -123,6 +123,60 @@ type ModuleTemplateSpec struct {
 	// RequiresDowntime indicates whether the module requires downtime in support of maintenance windows during module upgrades.
 	// +optional
 	RequiresDowntime bool `json:"requiresDowntime"`
+
+	// DependsOn lists the modules that must be installed and Ready before this module is installed.
+	// A module that is still required by another enabled module is not deleted.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	DependsOn []ModuleDependency `json:"dependsOn,omitempty"`
+
+	// Hooks reference the Jobs that run in the runtime before and after the resources of this version
+	// are applied during an upgrade of the module.
+	// +optional
+	Hooks *UpgradeHooks `json:"hooks,omitempty"`
+
+	// TemplateManifest enables the templating of the raw manifest of the module with the values of the runtime,
+	// such as its region and plan, and with the configuration of the module.
+	// +optional
+	TemplateManifest bool `json:"templateManifest,omitempty"`
+}
+
+// UpgradeHooks defines the Jobs that run when a module is upgraded to the version of the ModuleTemplate.
+type UpgradeHooks struct {
+	// PreUpgrade runs before the resources of the new version are applied.
+	// +optional
+	PreUpgrade *UpgradeHook `json:"preUpgrade,omitempty"`
+
+	// PostUpgrade runs after the resources of the new version are applied and the module is ready.
+	// +optional
+	PostUpgrade *UpgradeHook `json:"postUpgrade,omitempty"`
+}
+
+// UpgradeHook references the manifest of a hook Job in the OCM component descriptor.
+type UpgradeHook struct {
+	// Resource is the name of the resource in the OCM component descriptor that contains the manifest of the hook.
+	// The manifest must contain exactly one Job and may contain further resources the Job requires.
+	// +kubebuilder:validation:MinLength:=1
+	Resource string `json:"resource"`
+
+	// Timeout is the duration the Job may run before the hook is considered failed. Defaults to 10m.
+	// +optional
+	Timeout *apimetav1.Duration `json:"timeout,omitempty"`
+}
+
+// ModuleDependency defines a module that another module depends on.
+type ModuleDependency struct {
+	// Name is the name of the module that is required.
+	// +kubebuilder:validation:Pattern:=`^([a-z]{3,}(-[a-z]{3,})*)?$`
+	// +kubebuilder:validation:MaxLength:=64
+	Name string `json:"name"`
+
+	// Version is a semantic version constraint the required module must satisfy, e.g. ">=1.2.0 <2.0.0".
+	// If empty, any version of the required module satisfies the dependency.
+	// +optional
+	// +kubebuilder:validation:MaxLength:=128
+	Version string `json:"version,omitempty"`
 }
 
 // Manager defines the structure for the manager field in ModuleTemplateSpec.
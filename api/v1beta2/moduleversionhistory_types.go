@@ -0,0 +1,106 @@
+package v1beta2
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+// ModuleVersionTransitionTrigger is the cause of a module version transition.
+type ModuleVersionTransitionTrigger string
+
+const (
+	// TransitionTriggerInstallation is recorded when the first version of a module is installed.
+	TransitionTriggerInstallation ModuleVersionTransitionTrigger = "Installation"
+	// TransitionTriggerChannelChange is recorded when the version changed because the channel of the module changed.
+	TransitionTriggerChannelChange ModuleVersionTransitionTrigger = "ChannelChange"
+	// TransitionTriggerModuleReleaseMetaUpdate is recorded when the version assigned to the channel
+	// of the module changed.
+	TransitionTriggerModuleReleaseMetaUpdate ModuleVersionTransitionTrigger = "ModuleReleaseMetaUpdate"
+	// TransitionTriggerMaintenanceWindow is recorded when a version held back until the next maintenance window
+	// was applied because the window opened.
+	TransitionTriggerMaintenanceWindow ModuleVersionTransitionTrigger = "MaintenanceWindow"
+	// TransitionTriggerRollback is recorded when a failed upgrade was rolled back to the previous version.
+	TransitionTriggerRollback ModuleVersionTransitionTrigger = "Rollback"
+)
+
+// ModuleVersionHistory records the version transitions of the modules of a Kyma.
+// It is created by Lifecycle Manager next to the Kyma with the same name and is deleted together with it.
+// The history is kept in a separate resource so that the Kyma status does not grow with it.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=mvh
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+type ModuleVersionHistory struct {
+	apimetav1.TypeMeta   `json:",inline"`
+	apimetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ModuleVersionHistoryStatus `json:"status,omitempty"`
+}
+
+// ModuleVersionHistoryStatus defines the recorded version transitions.
+type ModuleVersionHistoryStatus struct {
+	// Modules are the modules with recorded transitions, sorted by name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Modules []ModuleVersionHistoryModule `json:"modules,omitempty"`
+}
+
+// ModuleVersionHistoryModule holds the version transitions of a single module.
+type ModuleVersionHistoryModule struct {
+	// Name is the name of the module.
+	Name string `json:"name"`
+
+	// Transitions are the latest version transitions of the module, oldest first.
+	// Older transitions are dropped once the configured maximum number of entries is reached.
+	// +optional
+	// +listType=atomic
+	Transitions []ModuleVersionTransition `json:"transitions,omitempty"`
+}
+
+// ModuleVersionTransition describes a change of the version of a module.
+type ModuleVersionTransition struct {
+	// PreviousVersion is the version of the module before the transition. It is empty for an installation.
+	// +optional
+	PreviousVersion string `json:"previousVersion,omitempty"`
+
+	// Version is the version of the module after the transition.
+	Version string `json:"version"`
+
+	// Channel is the channel of the module after the transition.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// TemplateGeneration is the generation of the ModuleTemplate the version was taken from.
+	// +optional
+	TemplateGeneration int64 `json:"templateGeneration,omitempty"`
+
+	// Timestamp is the time the transition was observed.
+	Timestamp apimetav1.Time `json:"timestamp"`
+
+	// Trigger is the cause of the transition.
+	// +kubebuilder:validation:Enum:=Installation;ChannelChange;ModuleReleaseMetaUpdate;MaintenanceWindow;Rollback
+	Trigger ModuleVersionTransitionTrigger `json:"trigger"`
+
+	// State is the state of the module resulting from the transition.
+	// It is updated until the next transition of the module is recorded.
+	State shared.State `json:"state"`
+}
+
+// +kubebuilder:object:root=true
+
+// ModuleVersionHistoryList contains a list of ModuleVersionHistory.
+type ModuleVersionHistoryList struct {
+	apimetav1.TypeMeta `json:",inline"`
+	apimetav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ModuleVersionHistory `json:"items"`
+}
+
+//nolint:gochecknoinits // registers ModuleVersionHistory CRD on startup
+func init() {
+	SchemeBuilder.Register(&ModuleVersionHistory{}, &ModuleVersionHistoryList{})
+}
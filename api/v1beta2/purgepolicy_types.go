@@ -0,0 +1,81 @@
+package v1beta2
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PurgeAction is the action the purge controller takes on the resources remaining in a Kyma runtime.
+type PurgeAction string
+
+const (
+	// PurgeActionRemoveFinalizers removes the finalizers of the remaining resources so that they are garbage collected.
+	PurgeActionRemoveFinalizers PurgeAction = "RemoveFinalizers"
+	// PurgeActionDelete deletes the remaining resources instead of removing their finalizers.
+	PurgeActionDelete PurgeAction = "Delete"
+	// PurgeActionSkip leaves the remaining resources untouched.
+	PurgeActionSkip PurgeAction = "Skip"
+)
+
+// PurgePolicy defines how the purge controller cleans up the resources remaining in a Kyma runtime
+// after its Kyma was marked for deletion. Lifecycle Manager uses the policy with the configured name and
+// reads it on every purge, so changes apply to the next purge without a restart.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=pp
+// +kubebuilder:printcolumn:name="Dry Run",type="boolean",JSONPath=".spec.dryRun"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+type PurgePolicy struct {
+	apimetav1.TypeMeta   `json:",inline"`
+	apimetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PurgePolicySpec `json:"spec,omitempty"`
+}
+
+// PurgePolicySpec defines the purge rules per CRD group.
+type PurgePolicySpec struct {
+	// DryRun reports the resources that would be purged in Events and metrics without changing them.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Rules are evaluated in order. The first rule matching the group of a CRD applies to its resources.
+	// Resources of CRDs that no rule matches have their finalizers removed after the purge finalizer timeout.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=100
+	Rules []PurgePolicyRule `json:"rules,omitempty"`
+}
+
+// PurgePolicyRule defines how the resources of the CRDs of a group are purged.
+type PurgePolicyRule struct {
+	// Group is the API group of the CRDs the rule applies to, for example, `cert-manager.io`.
+	// A leading `*.` matches all subgroups, for example, `*.kyma-project.io`. A single `*` matches all groups.
+	// +kubebuilder:validation:MinLength:=1
+	Group string `json:"group"`
+
+	// Timeout is the duration after the deletion of the Kyma after which the resources are purged.
+	// If not set, the purge finalizer timeout is used.
+	// +optional
+	Timeout *apimetav1.Duration `json:"timeout,omitempty"`
+
+	// Action is the action taken on the resources once the timeout passed.
+	// +optional
+	// +kubebuilder:default:=RemoveFinalizers
+	// +kubebuilder:validation:Enum:=RemoveFinalizers;Delete;Skip
+	Action PurgeAction `json:"action,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PurgePolicyList contains a list of PurgePolicy.
+type PurgePolicyList struct {
+	apimetav1.TypeMeta `json:",inline"`
+	apimetav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []PurgePolicy `json:"items"`
+}
+
+//nolint:gochecknoinits // registers PurgePolicy CRD on startup
+func init() {
+	SchemeBuilder.Register(&PurgePolicy{}, &PurgePolicyList{})
+}
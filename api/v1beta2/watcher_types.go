@@ -43,8 +43,8 @@ type WatcherSpec struct {
 	// Value can be one of ("spec", "status")
 	Field FieldName `json:"field"`
 
-	// Gateway configures the Istio Gateway for the VirtualService that is created/updated during processing
-	// of the Watcher CR.
+	// Gateway configures the Gateway that the route created/updated during processing of the Watcher CR is
+	// attached to. Depending on the routing backend, it selects an Istio Gateway or a Gateway API Gateway.
 	Gateway GatewayConfig `json:"gateway"`
 }
 
@@ -66,7 +66,7 @@ const (
 	StatusField FieldName = "status"
 )
 
-// GatewayConfig is used to select an Istio Gateway object in the cluster.
+// GatewayConfig is used to select an Istio Gateway or a Gateway API Gateway object in the cluster.
 type GatewayConfig struct {
 	// LabelSelector allows to select the Gateway using label selectors as defined in the K8s LIST API.
 	LabelSelector apimetav1.LabelSelector `json:"selector"`
@@ -165,7 +165,12 @@ func DefaultIstioGatewaySelector() apimetav1.LabelSelector {
 type WatcherConditionType string
 
 const (
+	// WatcherConditionTypeRoute represents WatcherConditionType Route. It reports whether the route that forwards
+	// the events to the manager is configured, independent of the routing backend.
+	WatcherConditionTypeRoute WatcherConditionType = "Route"
 	// WatcherConditionTypeVirtualService represents WatcherConditionType VirtualService.
+	//
+	// Deprecated: Use WatcherConditionTypeRoute, which is set for all routing backends.
 	WatcherConditionTypeVirtualService WatcherConditionType = "VirtualService"
 )
 
@@ -182,16 +187,20 @@ const (
 type WatcherConditionMessage string
 
 const (
-	VirtualServiceConfiguredConditionMessage    WatcherConditionMessage = "VirtualService is configured"
+	RouteConfiguredConditionMessage    WatcherConditionMessage = "Route is configured"
+	RouteNotConfiguredConditionMessage WatcherConditionMessage = "Route is not configured"
+	// Deprecated: Use RouteConfiguredConditionMessage.
+	VirtualServiceConfiguredConditionMessage WatcherConditionMessage = "VirtualService is configured"
+	// Deprecated: Use RouteNotConfiguredConditionMessage.
 	VirtualServiceNotConfiguredConditionMessage WatcherConditionMessage = "VirtualService is not configured"
 )
 
 func (watcher *Watcher) InitializeConditions() {
 	watcher.Status.Conditions = []apimetav1.Condition{
 		{
-			Type:               string(WatcherConditionTypeVirtualService),
+			Type:               string(WatcherConditionTypeRoute),
 			Status:             apimetav1.ConditionUnknown,
-			Message:            string(VirtualServiceNotConfiguredConditionMessage),
+			Message:            string(RouteNotConfiguredConditionMessage),
 			Reason:             string(ReadyConditionReason),
 			LastTransitionTime: apimetav1.Now(),
 		},
@@ -204,17 +213,21 @@ func (watcher *Watcher) UpdateWatcherConditionStatus(conditionType WatcherCondit
 	newCondition := apimetav1.Condition{
 		Type:               string(conditionType),
 		Status:             conditionStatus,
-		Message:            string(VirtualServiceNotConfiguredConditionMessage),
+		Message:            string(RouteNotConfiguredConditionMessage),
 		Reason:             string(ReadyConditionReason),
 		LastTransitionTime: apimetav1.Now(),
 	}
 	switch conditionStatus {
 	case apimetav1.ConditionTrue:
-		newCondition.Message = string(VirtualServiceConfiguredConditionMessage)
+		newCondition.Message = string(RouteConfiguredConditionMessage)
 	case apimetav1.ConditionFalse, apimetav1.ConditionUnknown:
 		fallthrough
 	default:
-		newCondition.Message = string(VirtualServiceNotConfiguredConditionMessage)
+		newCondition.Message = string(RouteNotConfiguredConditionMessage)
 	}
 	meta.SetStatusCondition(&watcher.Status.Conditions, newCondition)
+	// the Route condition replaces the VirtualService condition reported by previous versions
+	if conditionType == WatcherConditionTypeRoute {
+		meta.RemoveStatusCondition(&watcher.Status.Conditions, string(WatcherConditionTypeVirtualService))
+	}
 }
@@ -90,3 +90,60 @@ func TestWatcher_GetManagerName(t *testing.T) {
 		})
 	}
 }
+
+func TestWatcher_UpdateWatcherConditionStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      apimetav1.ConditionStatus
+		wantMessage v1beta2.WatcherConditionMessage
+	}{
+		{
+			name:        "should report configured route",
+			status:      apimetav1.ConditionTrue,
+			wantMessage: v1beta2.RouteConfiguredConditionMessage,
+		},
+		{
+			name:        "should report route that is not configured",
+			status:      apimetav1.ConditionFalse,
+			wantMessage: v1beta2.RouteNotConfiguredConditionMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher := &v1beta2.Watcher{}
+			watcher.InitializeConditions()
+
+			watcher.UpdateWatcherConditionStatus(v1beta2.WatcherConditionTypeRoute, tt.status)
+
+			if len(watcher.Status.Conditions) != 1 {
+				t.Fatalf("Conditions = %v, want a single Route condition", watcher.Status.Conditions)
+			}
+			got := watcher.Status.Conditions[0]
+			if got.Type != string(v1beta2.WatcherConditionTypeRoute) || got.Status != tt.status ||
+				got.Message != string(tt.wantMessage) {
+				t.Errorf("Condition = %v, want type %s with status %s and message %q",
+					got, v1beta2.WatcherConditionTypeRoute, tt.status, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestWatcher_UpdateWatcherConditionStatus_RemovesVirtualServiceCondition(t *testing.T) {
+	watcher := &v1beta2.Watcher{}
+	watcher.Status.Conditions = []apimetav1.Condition{
+		{
+			Type:    string(v1beta2.WatcherConditionTypeVirtualService),
+			Status:  apimetav1.ConditionTrue,
+			Message: string(v1beta2.VirtualServiceConfiguredConditionMessage),
+			Reason:  string(v1beta2.ReadyConditionReason),
+		},
+	}
+
+	watcher.UpdateWatcherConditionStatus(v1beta2.WatcherConditionTypeRoute, apimetav1.ConditionTrue)
+
+	if len(watcher.Status.Conditions) != 1 ||
+		watcher.Status.Conditions[0].Type != string(v1beta2.WatcherConditionTypeRoute) {
+		t.Errorf("Conditions = %v, want only the Route condition", watcher.Status.Conditions)
+	}
+}
@@ -21,13 +21,59 @@ limitations under the License.
 package v1beta2
 
 import (
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelRollout) DeepCopyInto(out *ChannelRollout) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelRollout.
+func (in *ChannelRollout) DeepCopy() *ChannelRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelRolloutStatus) DeepCopyInto(out *ChannelRolloutStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelRolloutStatus.
+func (in *ChannelRolloutStatus) DeepCopy() *ChannelRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChannelVersionAssignment) DeepCopyInto(out *ChannelVersionAssignment) {
 	*out = *in
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ChannelRollout)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelVersionAssignment.
@@ -172,7 +218,9 @@ func (in *KymaSpec) DeepCopyInto(out *KymaSpec) {
 	if in.Modules != nil {
 		in, out := &in.Modules, &out.Modules
 		*out = make([]Module, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -204,6 +252,11 @@ func (in *KymaStatus) DeepCopyInto(out *KymaStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaStatus.
@@ -217,38 +270,107 @@ func (in *KymaStatus) DeepCopy() *KymaStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Manager) DeepCopyInto(out *Manager) {
+func (in *MaintenancePolicyBlackout) DeepCopyInto(out *MaintenancePolicyBlackout) {
 	*out = *in
-	out.GroupVersionKind = in.GroupVersionKind
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(MaintenancePolicyMatch)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenancePolicyWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manager.
-func (in *Manager) DeepCopy() *Manager {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyBlackout.
+func (in *MaintenancePolicyBlackout) DeepCopy() *MaintenancePolicyBlackout {
 	if in == nil {
 		return nil
 	}
-	out := new(Manager)
+	out := new(MaintenancePolicyBlackout)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Mandatory) DeepCopyInto(out *Mandatory) {
+func (in *MaintenancePolicyMatch) DeepCopyInto(out *MaintenancePolicyMatch) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mandatory.
-func (in *Mandatory) DeepCopy() *Mandatory {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyMatch.
+func (in *MaintenancePolicyMatch) DeepCopy() *MaintenancePolicyMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(Mandatory)
+	out := new(MaintenancePolicyMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Manifest) DeepCopyInto(out *Manifest) {
+func (in *MaintenancePolicyRule) DeepCopyInto(out *MaintenancePolicyRule) {
+	*out = *in
+	out.Match = in.Match
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenancePolicyWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyRule.
+func (in *MaintenancePolicyRule) DeepCopy() *MaintenancePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicyRuleStatus) DeepCopyInto(out *MaintenancePolicyRuleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyRuleStatus.
+func (in *MaintenancePolicyRuleStatus) DeepCopy() *MaintenancePolicyRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicyRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicyWindow) DeepCopyInto(out *MaintenancePolicyWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyWindow.
+func (in *MaintenancePolicyWindow) DeepCopy() *MaintenancePolicyWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicyWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowPolicy) DeepCopyInto(out *MaintenanceWindowPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -256,18 +378,18 @@ func (in *Manifest) DeepCopyInto(out *Manifest) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manifest.
-func (in *Manifest) DeepCopy() *Manifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowPolicy.
+func (in *MaintenanceWindowPolicy) DeepCopy() *MaintenanceWindowPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(Manifest)
+	out := new(MaintenanceWindowPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Manifest) DeepCopyObject() runtime.Object {
+func (in *MaintenanceWindowPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -275,31 +397,31 @@ func (in *Manifest) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManifestList) DeepCopyInto(out *ManifestList) {
+func (in *MaintenanceWindowPolicyList) DeepCopyInto(out *MaintenanceWindowPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Manifest, len(*in))
+		*out = make([]MaintenanceWindowPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestList.
-func (in *ManifestList) DeepCopy() *ManifestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowPolicyList.
+func (in *MaintenanceWindowPolicyList) DeepCopy() *MaintenanceWindowPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ManifestList)
+	out := new(MaintenanceWindowPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManifestList) DeepCopyObject() runtime.Object {
+func (in *MaintenanceWindowPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -307,110 +429,144 @@ func (in *ManifestList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManifestSpec) DeepCopyInto(out *ManifestSpec) {
+func (in *MaintenanceWindowPolicySpec) DeepCopyInto(out *MaintenanceWindowPolicySpec) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = new(ImageSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]MaintenancePolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	in.Install.DeepCopyInto(&out.Install)
-	if in.Resource != nil {
-		in, out := &in.Resource, &out.Resource
-		*out = (*in).DeepCopy()
+	in.Default.DeepCopyInto(&out.Default)
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]MaintenancePolicyBlackout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.LocalizedImages != nil {
-		in, out := &in.LocalizedImages, &out.LocalizedImages
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowPolicySpec.
+func (in *MaintenanceWindowPolicySpec) DeepCopy() *MaintenanceWindowPolicySpec {
+	if in == nil {
+		return nil
 	}
-	if in.Manager != nil {
-		in, out := &in.Manager, &out.Manager
-		*out = new(Manager)
-		**out = **in
+	out := new(MaintenanceWindowPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowPolicyStatus) DeepCopyInto(out *MaintenanceWindowPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]MaintenancePolicyRuleStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestSpec.
-func (in *ManifestSpec) DeepCopy() *ManifestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowPolicyStatus.
+func (in *MaintenanceWindowPolicyStatus) DeepCopy() *MaintenanceWindowPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ManifestSpec)
+	out := new(MaintenanceWindowPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Module) DeepCopyInto(out *Module) {
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
 	*out = *in
+	if in.NextWindow != nil {
+		in, out := &in.NextWindow, &out.NextWindow
+		*out = new(ResolvedMaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveBlackout != nil {
+		in, out := &in.ActiveBlackout, &out.ActiveBlackout
+		*out = new(ResolvedMaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WaitingModules != nil {
+		in, out := &in.WaitingModules, &out.WaitingModules
+		*out = make([]WaitingModule, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Module.
-func (in *Module) DeepCopy() *Module {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Module)
+	out := new(MaintenanceWindowStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleIcon) DeepCopyInto(out *ModuleIcon) {
+func (in *Manager) DeepCopyInto(out *Manager) {
 	*out = *in
+	out.GroupVersionKind = in.GroupVersionKind
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleIcon.
-func (in *ModuleIcon) DeepCopy() *ModuleIcon {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manager.
+func (in *Manager) DeepCopy() *Manager {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleIcon)
+	out := new(Manager)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleInfo) DeepCopyInto(out *ModuleInfo) {
+func (in *Mandatory) DeepCopyInto(out *Mandatory) {
 	*out = *in
-	if in.Icons != nil {
-		in, out := &in.Icons, &out.Icons
-		*out = make([]ModuleIcon, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleInfo.
-func (in *ModuleInfo) DeepCopy() *ModuleInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mandatory.
+func (in *Mandatory) DeepCopy() *Mandatory {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleInfo)
+	out := new(Mandatory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleReleaseMeta) DeepCopyInto(out *ModuleReleaseMeta) {
+func (in *Manifest) DeepCopyInto(out *Manifest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleReleaseMeta.
-func (in *ModuleReleaseMeta) DeepCopy() *ModuleReleaseMeta {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manifest.
+func (in *Manifest) DeepCopy() *Manifest {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleReleaseMeta)
+	out := new(Manifest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ModuleReleaseMeta) DeepCopyObject() runtime.Object {
+func (in *Manifest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -418,31 +574,31 @@ func (in *ModuleReleaseMeta) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleReleaseMetaList) DeepCopyInto(out *ModuleReleaseMetaList) {
+func (in *ManifestList) DeepCopyInto(out *ManifestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ModuleReleaseMeta, len(*in))
+		*out = make([]Manifest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleReleaseMetaList.
-func (in *ModuleReleaseMetaList) DeepCopy() *ModuleReleaseMetaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestList.
+func (in *ManifestList) DeepCopy() *ManifestList {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleReleaseMetaList)
+	out := new(ManifestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ModuleReleaseMetaList) DeepCopyObject() runtime.Object {
+func (in *ManifestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -450,65 +606,481 @@ func (in *ModuleReleaseMetaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleReleaseMetaSpec) DeepCopyInto(out *ModuleReleaseMetaSpec) {
+func (in *ManifestSpec) DeepCopyInto(out *ManifestSpec) {
 	*out = *in
-	if in.Channels != nil {
-		in, out := &in.Channels, &out.Channels
-		*out = make([]ChannelVersionAssignment, len(*in))
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Install.DeepCopyInto(&out.Install)
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = (*in).DeepCopy()
+	}
+	if in.ResourceOverride != nil {
+		in, out := &in.ResourceOverride, &out.ResourceOverride
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalizedImages != nil {
+		in, out := &in.LocalizedImages, &out.LocalizedImages
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Mandatory != nil {
-		in, out := &in.Mandatory, &out.Mandatory
-		*out = new(Mandatory)
+	if in.Manager != nil {
+		in, out := &in.Manager, &out.Manager
+		*out = new(Manager)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ManifestUpgradeHooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleReleaseMetaSpec.
-func (in *ModuleReleaseMetaSpec) DeepCopy() *ModuleReleaseMetaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestSpec.
+func (in *ManifestSpec) DeepCopy() *ManifestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleReleaseMetaSpec)
+	out := new(ManifestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleStatus) DeepCopyInto(out *ModuleStatus) {
+func (in *ManifestUpgradeHook) DeepCopyInto(out *ManifestUpgradeHook) {
 	*out = *in
-	if in.Manifest != nil {
-		in, out := &in.Manifest, &out.Manifest
-		*out = new(TrackingObject)
-		**out = **in
-	}
-	if in.Resource != nil {
-		in, out := &in.Resource, &out.Resource
-		*out = new(TrackingObject)
-		**out = **in
-	}
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(TrackingObject)
-		**out = **in
-	}
+	out.Image = in.Image
+	out.Timeout = in.Timeout
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleStatus.
-func (in *ModuleStatus) DeepCopy() *ModuleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestUpgradeHook.
+func (in *ManifestUpgradeHook) DeepCopy() *ManifestUpgradeHook {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleStatus)
+	out := new(ManifestUpgradeHook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleTemplate) DeepCopyInto(out *ModuleTemplate) {
+func (in *ManifestUpgradeHooks) DeepCopyInto(out *ManifestUpgradeHooks) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.PreUpgrade != nil {
+		in, out := &in.PreUpgrade, &out.PreUpgrade
+		*out = new(ManifestUpgradeHook)
+		**out = **in
+	}
+	if in.PostUpgrade != nil {
+		in, out := &in.PostUpgrade, &out.PostUpgrade
+		*out = new(ManifestUpgradeHook)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestUpgradeHooks.
+func (in *ManifestUpgradeHooks) DeepCopy() *ManifestUpgradeHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestUpgradeHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Module) DeepCopyInto(out *Module) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Module.
+func (in *Module) DeepCopy() *Module {
+	if in == nil {
+		return nil
+	}
+	out := new(Module)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleCatalog) DeepCopyInto(out *ModuleCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleCatalog.
+func (in *ModuleCatalog) DeepCopy() *ModuleCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleCatalogChannel) DeepCopyInto(out *ModuleCatalogChannel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleCatalogChannel.
+func (in *ModuleCatalogChannel) DeepCopy() *ModuleCatalogChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleCatalogChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleCatalogEntry) DeepCopyInto(out *ModuleCatalogEntry) {
+	*out = *in
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]ModuleCatalogChannel, len(*in))
+		copy(*out, *in)
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]ModuleCatalogVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleCatalogEntry.
+func (in *ModuleCatalogEntry) DeepCopy() *ModuleCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleCatalogList) DeepCopyInto(out *ModuleCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ModuleCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleCatalogList.
+func (in *ModuleCatalogList) DeepCopy() *ModuleCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleCatalogSpec) DeepCopyInto(out *ModuleCatalogSpec) {
+	*out = *in
+	if in.Modules != nil {
+		in, out := &in.Modules, &out.Modules
+		*out = make([]ModuleCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleCatalogSpec.
+func (in *ModuleCatalogSpec) DeepCopy() *ModuleCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleCatalogVersion) DeepCopyInto(out *ModuleCatalogVersion) {
+	*out = *in
+	if in.Info != nil {
+		in, out := &in.Info, &out.Info
+		*out = new(ModuleInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleCatalogVersion.
+func (in *ModuleCatalogVersion) DeepCopy() *ModuleCatalogVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleCatalogVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleDependency) DeepCopyInto(out *ModuleDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleDependency.
+func (in *ModuleDependency) DeepCopy() *ModuleDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleIcon) DeepCopyInto(out *ModuleIcon) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleIcon.
+func (in *ModuleIcon) DeepCopy() *ModuleIcon {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleIcon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleInfo) DeepCopyInto(out *ModuleInfo) {
+	*out = *in
+	if in.Icons != nil {
+		in, out := &in.Icons, &out.Icons
+		*out = make([]ModuleIcon, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleInfo.
+func (in *ModuleInfo) DeepCopy() *ModuleInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleReleaseMeta) DeepCopyInto(out *ModuleReleaseMeta) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleReleaseMeta.
+func (in *ModuleReleaseMeta) DeepCopy() *ModuleReleaseMeta {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleReleaseMeta)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleReleaseMeta) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleReleaseMetaList) DeepCopyInto(out *ModuleReleaseMetaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ModuleReleaseMeta, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleReleaseMetaList.
+func (in *ModuleReleaseMetaList) DeepCopy() *ModuleReleaseMetaList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleReleaseMetaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleReleaseMetaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleReleaseMetaSpec) DeepCopyInto(out *ModuleReleaseMetaSpec) {
+	*out = *in
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]ChannelVersionAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mandatory != nil {
+		in, out := &in.Mandatory, &out.Mandatory
+		*out = new(Mandatory)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleReleaseMetaSpec.
+func (in *ModuleReleaseMetaSpec) DeepCopy() *ModuleReleaseMetaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleReleaseMetaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleReleaseMetaStatus) DeepCopyInto(out *ModuleReleaseMetaStatus) {
+	*out = *in
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]ChannelRolloutStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleReleaseMetaStatus.
+func (in *ModuleReleaseMetaStatus) DeepCopy() *ModuleReleaseMetaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleReleaseMetaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleStatus) DeepCopyInto(out *ModuleStatus) {
+	*out = *in
+	if in.Manifest != nil {
+		in, out := &in.Manifest, &out.Manifest
+		*out = new(TrackingObject)
+		**out = **in
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(TrackingObject)
+		**out = **in
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(TrackingObject)
+		**out = **in
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(ModuleUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailedVersions != nil {
+		in, out := &in.FailedVersions, &out.FailedVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeletionBlockers != nil {
+		in, out := &in.DeletionBlockers, &out.DeletionBlockers
+		*out = new(shared.DeletionBlockers)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleStatus.
+func (in *ModuleStatus) DeepCopy() *ModuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleUpgrade) DeepCopyInto(out *ModuleUpgrade) {
+	*out = *in
+	if in.PreviousTemplate != nil {
+		in, out := &in.PreviousTemplate, &out.PreviousTemplate
+		*out = new(TrackingObject)
+		**out = **in
+	}
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleUpgrade.
+func (in *ModuleUpgrade) DeepCopy() *ModuleUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleTemplate) DeepCopyInto(out *ModuleTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
@@ -601,6 +1173,16 @@ func (in *ModuleTemplateSpec) DeepCopyInto(out *ModuleTemplateSpec) {
 		*out = new(Manager)
 		**out = **in
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ModuleDependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(UpgradeHooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleTemplateSpec.
@@ -613,6 +1195,124 @@ func (in *ModuleTemplateSpec) DeepCopy() *ModuleTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleVersionHistory) DeepCopyInto(out *ModuleVersionHistory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleVersionHistory.
+func (in *ModuleVersionHistory) DeepCopy() *ModuleVersionHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleVersionHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleVersionHistory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleVersionHistoryList) DeepCopyInto(out *ModuleVersionHistoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ModuleVersionHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleVersionHistoryList.
+func (in *ModuleVersionHistoryList) DeepCopy() *ModuleVersionHistoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleVersionHistoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleVersionHistoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleVersionHistoryModule) DeepCopyInto(out *ModuleVersionHistoryModule) {
+	*out = *in
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]ModuleVersionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleVersionHistoryModule.
+func (in *ModuleVersionHistoryModule) DeepCopy() *ModuleVersionHistoryModule {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleVersionHistoryModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleVersionHistoryStatus) DeepCopyInto(out *ModuleVersionHistoryStatus) {
+	*out = *in
+	if in.Modules != nil {
+		in, out := &in.Modules, &out.Modules
+		*out = make([]ModuleVersionHistoryModule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleVersionHistoryStatus.
+func (in *ModuleVersionHistoryStatus) DeepCopy() *ModuleVersionHistoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleVersionHistoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleVersionTransition) DeepCopyInto(out *ModuleVersionTransition) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleVersionTransition.
+func (in *ModuleVersionTransition) DeepCopy() *ModuleVersionTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleVersionTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PartialMeta) DeepCopyInto(out *PartialMeta) {
 	*out = *in
@@ -628,6 +1328,123 @@ func (in *PartialMeta) DeepCopy() *PartialMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgePolicy) DeepCopyInto(out *PurgePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgePolicy.
+func (in *PurgePolicy) DeepCopy() *PurgePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PurgePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgePolicyList) DeepCopyInto(out *PurgePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PurgePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgePolicyList.
+func (in *PurgePolicyList) DeepCopy() *PurgePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PurgePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgePolicyRule) DeepCopyInto(out *PurgePolicyRule) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgePolicyRule.
+func (in *PurgePolicyRule) DeepCopy() *PurgePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgePolicySpec) DeepCopyInto(out *PurgePolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PurgePolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgePolicySpec.
+func (in *PurgePolicySpec) DeepCopy() *PurgePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedMaintenanceWindow) DeepCopyInto(out *ResolvedMaintenanceWindow) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedMaintenanceWindow.
+func (in *ResolvedMaintenanceWindow) DeepCopy() *ResolvedMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resource) DeepCopyInto(out *Resource) {
 	*out = *in
@@ -675,6 +1492,66 @@ func (in *TrackingObject) DeepCopy() *TrackingObject {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeHook) DeepCopyInto(out *UpgradeHook) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeHook.
+func (in *UpgradeHook) DeepCopy() *UpgradeHook {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeHooks) DeepCopyInto(out *UpgradeHooks) {
+	*out = *in
+	if in.PreUpgrade != nil {
+		in, out := &in.PreUpgrade, &out.PreUpgrade
+		*out = new(UpgradeHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostUpgrade != nil {
+		in, out := &in.PostUpgrade, &out.PostUpgrade
+		*out = new(UpgradeHook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeHooks.
+func (in *UpgradeHooks) DeepCopy() *UpgradeHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WaitingModule) DeepCopyInto(out *WaitingModule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitingModule.
+func (in *WaitingModule) DeepCopy() *WaitingModule {
+	if in == nil {
+		return nil
+	}
+	out := new(WaitingModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WatchableGVR) DeepCopyInto(out *WatchableGVR) {
 	*out = *in
@@ -22,7 +22,9 @@ import (
 	certmanagercertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/certmanager/certificate" //nolint:revive // not for import
 	"github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/config"
 	gcmcertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/gcm/certificate"
+	nativecertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/native/certificate" //nolint:revive // not for import
 	"github.com/kyma-project/lifecycle-manager/internal/service/watcher/certificate"
+	"github.com/kyma-project/lifecycle-manager/internal/service/watcher/certificate/inventory"
 	"github.com/kyma-project/lifecycle-manager/internal/service/watcher/chartreader"
 	"github.com/kyma-project/lifecycle-manager/internal/service/watcher/gateway"
 	skrwebhookresources "github.com/kyma-project/lifecycle-manager/internal/service/watcher/resources"
@@ -135,6 +137,11 @@ func ComposeCertificateRepository(kcpClient client.Client,
 			flagVar.SelfSignedCertificateIssuerName,
 			flagVar.SelfSignedCertIssuerNamespace,
 			certificateConfig)
+	case nativecertificate.CertificateManagement:
+		certRepoImpl, err = nativecertificate.NewRepository(kcpClient,
+			shared.CASecretName,
+			shared.IstioNamespace,
+			certificateConfig)
 	default:
 		return nil, errCertificateManagementNotSupported
 	}
@@ -145,6 +152,19 @@ func ComposeCertificateRepository(kcpClient client.Client,
 	return certRepoImpl, nil
 }
 
+func ComposeCertificateInventoryScanner(kcpClient client.Client,
+	certificateRepository CertificateRepository,
+	kymaRepository inventory.KymaRepository,
+	flagVar *flags.FlagVar,
+) *inventory.Scanner {
+	return inventory.NewScanner(kymaRepository,
+		secretrepo.NewRepository(kcpClient, flagVar.IstioNamespace),
+		certificateRepository,
+		metrics.NewCertificateInventoryMetrics(),
+		shared.GatewaySecretName,
+	)
+}
+
 func setupSKRCertService(kcpClient client.Client,
 	certificateRepository CertificateRepository,
 	flagVar *flags.FlagVar,
@@ -0,0 +1,197 @@
+// Command klm provides offline tooling for Lifecycle Manager.
+//
+// The render subcommand renders a ModuleTemplate for a Kyma into the resources Lifecycle Manager
+// server-side applies to the runtime, without a running control plane:
+//
+//	klm render --module-template template.yaml --kyma kyma.yaml --oci-layout ./layout
+//	klm render --module-template template.yaml --kyma kyma.yaml --registry http://localhost:5000
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/keychainprovider"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/spec"
+	"github.com/kyma-project/lifecycle-manager/internal/render"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/layout"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/oci"
+	"github.com/kyma-project/lifecycle-manager/internal/service/componentdescriptor"
+)
+
+const (
+	renderCommand     = "render"
+	httpSchemePrefix  = "http://"
+	httpsSchemePrefix = "https://"
+)
+
+var (
+	errUnknownCommand  = errors.New("unknown command")
+	errMissingArgument = errors.New("missing required argument")
+	errNoSource        = errors.New("either --oci-layout or --registry must be provided")
+)
+
+type renderFlags struct {
+	moduleTemplate      string
+	kyma                string
+	ociLayout           string
+	registry            string
+	ocmComponentName    string
+	remoteSyncNamespace string
+	skrImagePullSecret  string
+	output              string
+	keepExtractedLayers bool
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: expected %q", errUnknownCommand, renderCommand)
+	}
+	switch args[0] {
+	case renderCommand:
+		return runRender(ctx, args[1:], stdout)
+	default:
+		return fmt.Errorf("%w %q: expected %q", errUnknownCommand, args[0], renderCommand)
+	}
+}
+
+func runRender(ctx context.Context, args []string, stdout io.Writer) error {
+	renderFlags, err := parseRenderFlags(args)
+	if err != nil {
+		return err
+	}
+
+	kyma, err := render.LoadKyma(renderFlags.kyma)
+	if err != nil {
+		return err
+	}
+	template, err := render.LoadModuleTemplate(renderFlags.moduleTemplate)
+	if err != nil {
+		return err
+	}
+
+	workDir, err := os.MkdirTemp("", "klm-render-")
+	if err != nil {
+		return fmt.Errorf("failed to create work directory: %w", err)
+	}
+	if !renderFlags.keepExtractedLayers {
+		defer os.RemoveAll(workDir)
+	}
+
+	descriptorService, specResolver, registry, err := setupSources(renderFlags, workDir)
+	if err != nil {
+		return err
+	}
+	renderer := render.NewRenderer(descriptorService, specResolver, render.Options{
+		OCIRegistry:            registry,
+		RemoteSyncNamespace:    renderFlags.remoteSyncNamespace,
+		OcmComponentName:       renderFlags.ocmComponentName,
+		SkrImagePullSecretName: renderFlags.skrImagePullSecret,
+	})
+	resources, err := renderer.Render(ctx, kyma, template)
+	if err != nil {
+		return fmt.Errorf("failed to render module template %s: %w", template.GetName(), err)
+	}
+
+	writer := stdout
+	if renderFlags.output != "" {
+		file, err := os.Create(renderFlags.output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer file.Close()
+		writer = file
+	}
+	return render.WriteResources(writer, resources)
+}
+
+func parseRenderFlags(args []string) (*renderFlags, error) {
+	renderFlags := &renderFlags{}
+	flagSet := flag.NewFlagSet(renderCommand, flag.ContinueOnError)
+	flagSet.StringVar(&renderFlags.moduleTemplate, "module-template", "",
+		"Path to the ModuleTemplate YAML to render.")
+	flagSet.StringVar(&renderFlags.kyma, "kyma", "",
+		"Path to the Kyma YAML the module is rendered for.")
+	flagSet.StringVar(&renderFlags.ociLayout, "oci-layout", "",
+		"Path to an OCI image layout directory containing the component version of the module.")
+	flagSet.StringVar(&renderFlags.registry, "registry", "",
+		"Registry host containing the component version of the module, for example http://localhost:5000. "+
+			"If --oci-layout is set, the registry is only used for the references in the rendered Manifest.")
+	flagSet.StringVar(&renderFlags.ocmComponentName, "ocm-component-name", "",
+		"OCM component name of the module. Defaults to kyma-project.io/module/<module-name>.")
+	flagSet.StringVar(&renderFlags.remoteSyncNamespace, "remote-sync-namespace", shared.DefaultRemoteNamespace,
+		"Namespace of the default CR if the ModuleTemplate does not define one.")
+	flagSet.StringVar(&renderFlags.skrImagePullSecret, "skr-image-pull-secret", "",
+		"Image pull secret added to the module workloads, matching the --skr-image-pull-secret flag of the manager.")
+	flagSet.StringVar(&renderFlags.output, "o", "",
+		"Path to write the rendered resources to. Defaults to stdout.")
+	flagSet.BoolVar(&renderFlags.keepExtractedLayers, "keep-extracted-layers", false,
+		"Keep the extracted layers in the temporary work directory.")
+	if err := flagSet.Parse(args); err != nil {
+		return nil, fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	if renderFlags.moduleTemplate == "" {
+		return nil, fmt.Errorf("%w: --module-template", errMissingArgument)
+	}
+	if renderFlags.kyma == "" {
+		return nil, fmt.Errorf("%w: --kyma", errMissingArgument)
+	}
+	if renderFlags.ociLayout == "" && renderFlags.registry == "" {
+		return nil, errNoSource
+	}
+	return renderFlags, nil
+}
+
+// setupSources returns the component descriptor service and the spec resolver reading from the OCI layout
+// if one is provided, or from the registry otherwise, together with the registry reference without scheme.
+func setupSources(renderFlags *renderFlags, workDir string,
+) (*componentdescriptor.Service, *spec.Resolver, string, error) {
+	insecure := strings.HasPrefix(renderFlags.registry, httpSchemePrefix)
+	registry := strings.TrimPrefix(strings.TrimPrefix(renderFlags.registry, httpSchemePrefix), httpsSchemePrefix)
+	keyChainLookup := keychainprovider.NewDefaultKeyChainProvider()
+
+	var repository componentdescriptor.OCIRepository
+	var pathExtractor spec.PathExtractor
+	if renderFlags.ociLayout != "" {
+		layoutRepository, err := layout.NewRepository(renderFlags.ociLayout)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		repository = layoutRepository
+		pathExtractor = render.NewLayoutPathExtractor(layoutRepository, workDir)
+	} else {
+		ociRepository, err := oci.NewRepository(keyChainLookup, insecure)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("failed to create OCI repository: %w", err)
+		}
+		ocmRepository, err := ocm.NewRepository(registry, ociRepository)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("failed to create OCM repository: %w", err)
+		}
+		repository = ocmRepository
+		pathExtractor = img.NewPathExtractor()
+	}
+
+	fileExtractor := componentdescriptor.NewFileExtractor(componentdescriptor.NewTarExtractor())
+	descriptorService, err := componentdescriptor.NewService(repository, fileExtractor)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to create component descriptor service: %w", err)
+	}
+	return descriptorService, spec.NewResolver(keyChainLookup, pathExtractor), registry, nil
+}
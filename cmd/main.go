@@ -45,6 +45,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kyma-project/lifecycle-manager/api"
 	"github.com/kyma-project/lifecycle-manager/api/shared"
@@ -60,8 +61,10 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/controller/istiogatewaysecret"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/kyma"
 	kymadeletionctrl "github.com/kyma-project/lifecycle-manager/internal/controller/kyma/deletion"
+	"github.com/kyma-project/lifecycle-manager/internal/controller/maintenancewindowpolicy"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/mandatorymodule"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/manifest"
+	"github.com/kyma-project/lifecycle-manager/internal/controller/modulereleasemeta"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/purge"
 	watcherctrl "github.com/kyma-project/lifecycle-manager/internal/controller/watcher"
 	"github.com/kyma-project/lifecycle-manager/internal/crd"
@@ -72,19 +75,30 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/keychainprovider"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/manifestclient"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/spec"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/internal/remote"
+	configmaprepo "github.com/kyma-project/lifecycle-manager/internal/repository/configmap"
 	"github.com/kyma-project/lifecycle-manager/internal/repository/istiogateway"
 	kymarepo "github.com/kyma-project/lifecycle-manager/internal/repository/kyma"
+	manifestrepo "github.com/kyma-project/lifecycle-manager/internal/repository/manifest"
 	secretrepo "github.com/kyma-project/lifecycle-manager/internal/repository/secret"
+	nativecertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/native/certificate" //nolint:revive // not for import
 	resultevent "github.com/kyma-project/lifecycle-manager/internal/result/event"
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
 	"github.com/kyma-project/lifecycle-manager/internal/service/accessmanager"
 	kymadeletionsvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/deletion"
+	kymahistorysvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/history"
 	kymalookupsvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/lookup"
+	kymamaintenancewindowsvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/maintenancewindow"
+	kymamoduleeventsvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/moduleevent"
+	kymaplansvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/plan"
+	kymarollbacksvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/rollback"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator/fromerror"
@@ -92,6 +106,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/service/skrclient"
 	skrclientcache "github.com/kyma-project/lifecycle-manager/internal/service/skrclient/cache"
 	"github.com/kyma-project/lifecycle-manager/internal/service/skrsync"
+	"github.com/kyma-project/lifecycle-manager/internal/service/watcher/certificate/inventory"
 	"github.com/kyma-project/lifecycle-manager/internal/setup"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"github.com/kyma-project/lifecycle-manager/pkg/matcher"
@@ -105,18 +120,23 @@ import (
 )
 
 const (
-	metricCleanupTimeout    = 5 * time.Minute
-	bootstrapFailedExitCode = 1
-	runtimeProblemExitCode  = 2
+	metricCleanupTimeout     = 5 * time.Minute
+	certInventoryScanTimeout = 5 * time.Minute
+	layerCacheGCTimeout      = 5 * time.Minute
+	bootstrapFailedExitCode  = 1
+	runtimeProblemExitCode   = 2
 
 	maintenanceWindowPolicyName        = "policy"
 	maintenanceWindowPoliciesDirectory = "/etc/maintenance-policy"
+	purgePolicyName                    = "policy"
 )
 
 var (
 	buildVersion                         = "not_provided" //nolint:gochecknoglobals,revive // used to embed static binary version during release builds
 	errFailedToDropStoredVersions        = errors.New("failed to drop stored versions")
 	errFailedToScheduleMetricsCleanupJob = errors.New("failed to schedule metrics cleanup job")
+	errFailedToScheduleCertInventoryScan = errors.New("failed to schedule certificate inventory scan")
+	errFailedToScheduleLayerCacheGC      = errors.New("failed to schedule layer cache garbage collection")
 )
 
 func registerSchemas(scheme *machineryruntime.Scheme) {
@@ -126,6 +146,7 @@ func registerSchemas(scheme *machineryruntime.Scheme) {
 	machineryutilruntime.Must(certmanagerv1.AddToScheme(scheme))
 	machineryutilruntime.Must(gcertv1alpha1.AddToScheme(scheme))
 	machineryutilruntime.Must(istioclientapiv1beta1.AddToScheme(scheme))
+	machineryutilruntime.Must(gatewayv1.Install(scheme))
 	machineryutilruntime.Must(v1beta2.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
@@ -207,6 +228,13 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 		flagVar.SkrClientQPS,
 		flagVar.SkrClientBurst)
 
+	if flagVar.CertificateManagement == nativecertificate.CertificateManagement {
+		if err := nativecertificate.VerifyCASecret(context.Background(), kcpClientWithoutCache,
+			shared.CASecretName, shared.IstioNamespace); err != nil {
+			logger.Error(err, "failed to verify CA secret of native certificate management")
+			os.Exit(bootstrapFailedExitCode)
+		}
+	}
 	certificateRepository, err := skrwebhook.ComposeCertificateRepository(kcpClient, flagVar)
 	t := reflect.TypeOf(certificateRepository)
 	logger.Info("certificate repository", "type", t)
@@ -254,7 +282,9 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 
 	kymaMetrics := metrics.NewKymaMetrics(sharedMetrics)
 	mandatoryModulesMetrics := metrics.NewMandatoryModulesMetrics()
-	maintenanceWindow := initMaintenanceWindow(flagVar.MinMaintenanceWindowSize, logger)
+	maintenanceWindowsMetrics := metrics.NewMaintenanceWindowMetrics()
+	maintenanceWindow, maintenancePolicySource := initMaintenanceWindow(flagVar.MinMaintenanceWindowSize,
+		maintenanceWindowsMetrics, logger)
 	metrics.NewFipsMetrics().Update()
 
 	kymaRepo := kymarepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace)
@@ -278,6 +308,9 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 	setupMandatoryModuleReconciler(mgr, descriptorProvider, flagVar, options, mandatoryModulesMetrics, logger,
 		ociRegistry.GetReference())
 	setupMandatoryModuleDeletionReconciler(mgr, eventRecorder, flagVar, options, logger)
+	setupModuleReleaseMetaRolloutReconciler(mgr, flagVar, options, logger)
+	setupMaintenanceWindowPolicyReconciler(mgr, flagVar, options, maintenancePolicySource,
+		maintenanceWindowsMetrics, logger)
 
 	setupPurgeReconciler(mgr, skrContextProvider, eventRecorder, flagVar, options, logger)
 
@@ -291,6 +324,12 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 
 	go cleanupStoredVersions(flagVar.DropCrdStoredVersionMap, mgr, logger)
 	go scheduleMetricsCleanup(kymaMetrics, flagVar.MetricsCleanupIntervalInMinutes, mgr, logger)
+	if flagVar.CertificateInventoryScanInterval > 0 {
+		certInventoryScanner := skrwebhook.ComposeCertificateInventoryScanner(kcpClient, certificateRepository,
+			kymaRepo, flagVar)
+		go scheduleCertificateInventoryScan(certInventoryScanner, flagVar.CertificateInventoryScanInterval, mgr,
+			logger)
+	}
 
 	if err = mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		logger.Error(err, "problem running manager")
@@ -298,19 +337,22 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 	}
 }
 
-func initMaintenanceWindow(minWindowSize time.Duration, logger logr.Logger) maintenancewindows.MaintenanceWindow {
-	maintenanceWindowsMetrics := metrics.NewMaintenanceWindowMetrics()
-	maintenanceWindow, err := maintenancewindows.InitializeMaintenanceWindow(logger,
+func initMaintenanceWindow(minWindowSize time.Duration, maintenanceWindowsMetrics *metrics.MaintenanceWindowMetrics,
+	logger logr.Logger,
+) (maintenancewindows.MaintenanceWindow, *maintenancewindows.PolicySource) {
+	fileWindow, err := maintenancewindows.InitializeMaintenanceWindow(logger,
 		maintenanceWindowPoliciesDirectory,
 		maintenanceWindowPolicyName,
 		minWindowSize)
 	if err != nil {
 		maintenanceWindowsMetrics.RecordConfigReadSuccess(false)
-		logger.Error(err, "unable to set maintenance windows policy")
+		logger.Error(err, "unable to set maintenance windows policy from file, "+
+			"waiting for the MaintenanceWindowPolicy resource")
 	} else {
 		maintenanceWindowsMetrics.RecordConfigReadSuccess(true)
 	}
-	return maintenanceWindow
+	policySource := maintenancewindows.NewPolicySource(fileWindow.MaintenanceWindowPolicy)
+	return maintenancewindows.NewMaintenanceWindow(policySource, minWindowSize), policySource
 }
 
 //nolint:ireturn // the implementation is not a part of the public API
@@ -394,6 +436,30 @@ func scheduleMetricsCleanup(kymaMetrics *metrics.KymaMetrics, cleanupIntervalInM
 	setupLog.V(log.DebugLevel).Info("scheduled job for cleaning up metrics")
 }
 
+func scheduleCertificateInventoryScan(scanner *inventory.Scanner, scanInterval time.Duration,
+	mgr manager.Manager, setupLog logr.Logger,
+) {
+	ctx := context.Background()
+	if !mgr.GetCache().WaitForCacheSync(ctx) {
+		setupLog.V(log.InfoLevel).Error(errFailedToScheduleCertInventoryScan, "failed to sync cache")
+		return
+	}
+
+	scheduler := gocron.NewScheduler(time.UTC)
+	_, scheduleErr := scheduler.Every(scanInterval).Do(func() {
+		ctx, cancel := context.WithTimeout(ctx, certInventoryScanTimeout)
+		defer cancel()
+		if err := scanner.Scan(ctx); err != nil {
+			setupLog.Info(fmt.Sprintf("failed to scan certificate inventory, err: %s", err))
+		}
+	})
+	if scheduleErr != nil {
+		setupLog.Info(fmt.Sprintf("failed to setup certificate inventory scan, err: %s", scheduleErr))
+	}
+	scheduler.StartAsync()
+	setupLog.V(log.DebugLevel).Info("scheduled job for scanning the certificate inventory")
+}
+
 func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDescriptorProvider,
 	skrContextFactory remote.SkrContextProvider, event event.Event, flagVar *flags.FlagVar, options ctrlruntime.Options,
 	skrWebhookManager *watcher.SkrWebhookManifestManager, kymaMetrics *metrics.KymaMetrics,
@@ -436,6 +502,11 @@ func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDe
 		SkrSyncService:       skrSyncService,
 		ModulesStatusHandler: modulesStatusHandler,
 		SKRWebhookManager:    skrWebhookManager,
+		UpgradeRollback:      kymarollbacksvc.NewService(flagVar.ModuleUpgradeHealthDeadline, event),
+		PlanService:          kymaplansvc.NewService(configmaprepo.NewRepository(kcpClient)),
+		MaintenanceWindows:   kymamaintenancewindowsvc.NewService(maintenanceWindow),
+		ModuleHistory:        kymahistorysvc.NewService(kcpClient, flagVar.ModuleVersionHistorySize),
+		ModuleEvents:         kymamoduleeventsvc.NewService(event),
 		RateLimiter:          options.RateLimiter,
 		RequeueIntervals: queue.RequeueIntervals{
 			Success: flagVar.KymaRequeueSuccessInterval,
@@ -445,7 +516,7 @@ func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDe
 		},
 		Metrics: kymaMetrics,
 		RemoteCatalog: remote.NewRemoteCatalogFromKyma(kcpClient, skrContextFactory,
-			flagVar.RemoteSyncNamespace),
+			flagVar.RemoteSyncNamespace, remote.ModuleCatalogMode(flagVar.ModuleCatalogMode)),
 		TemplateLookup: templatelookup.NewTemplateLookup(kcpClient, descriptorProvider,
 			moduleTemplateInfoLookup),
 		Config:          kymaReconcilerConfig,
@@ -483,6 +554,7 @@ func setupPurgeReconciler(mgr ctrl.Manager,
 		SkipCRDs:              matcher.CreateCRDMatcherFrom(flagVar.SkipPurgingFor),
 		Metrics:               metrics.NewPurgeMetrics(),
 		RateLimiter:           options.RateLimiter,
+		PolicyName:            purgePolicyName,
 	}).SetupWithManager(
 		mgr, options,
 	); err != nil {
@@ -509,12 +581,16 @@ func setupManifestReconciler(mgr ctrl.Manager,
 	manifestClient := manifestclient.NewManifestClient(event, mgr.GetClient())
 	orphanDetectionClient := kymaRepo
 	orphanDetectionService := orphan.NewDetectionService(orphanDetectionClient)
-	specResolver := spec.NewResolver(keychainLookupFromFlag(mgr.GetClient(), flagVar), img.NewPathExtractor())
+	specResolver := spec.NewResolver(keychainLookupFromFlag(mgr.GetClient(), flagVar),
+		setupPathExtractor(mgr, flagVar, setupLog))
 	clientCache := skrclientcache.NewService()
 	skrClient := skrclient.NewService(mgr.GetConfig().QPS, mgr.GetConfig().Burst, accessManagerService)
 
 	kcpClient := mgr.GetClient()
-	cachedManifestParser := declarativev2.NewInMemoryCachedManifestParser(declarativev2.DefaultInMemoryParseTTL)
+	cacheCapacity := uint64(flagVar.ManifestParseCacheSize) //nolint:gosec // validated to be non-negative
+	cachedManifestParser := declarativev2.NewInMemoryCachedManifestParser(declarativev2.DefaultInMemoryParseTTL,
+		declarativev2.WithCacheCapacity(cacheCapacity),
+		declarativev2.WithCacheMetrics(metrics.NewManifestCacheMetrics()))
 	statefulChecker := statecheck.NewStatefulSetStateCheck()
 	deploymentChecker := statecheck.NewDeploymentStateCheck()
 	customStateCheck := statecheck.NewManagerStateCheck(statefulChecker, deploymentChecker)
@@ -529,12 +605,66 @@ func setupManifestReconciler(mgr ctrl.Manager,
 	}, options.RateLimiter,
 		metrics.NewManifestMetrics(sharedMetrics), mandatoryModulesMetrics, manifestClient, orphanDetectionService,
 		specResolver, clientCache, skrClient, kcpClient, cachedManifestParser, customStateCheck,
-		flagVar.SkrImagePullSecret); err != nil {
+		flagVar.SkrImagePullSecret, skrresources.DriftDetectionMode(flagVar.DriftDetectionMode),
+		flagVar.DriftDetectionInterval, event); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Manifest")
 		os.Exit(bootstrapFailedExitCode)
 	}
 }
 
+func setupPathExtractor(mgr ctrl.Manager, flagVar *flags.FlagVar, setupLog logr.Logger) *img.PathExtractor {
+	if flagVar.ManifestLayerCacheDir == "" {
+		return img.NewPathExtractor()
+	}
+
+	sizeLimit := int64(flagVar.ManifestLayerCacheSizeMiB) * 1024 * 1024
+	if sizeLimit == 0 {
+		volumeSizeLimit, err := layercache.VolumeSizeLimit(flagVar.ManifestLayerCacheDir)
+		if err != nil {
+			setupLog.Error(err, "unable to derive layer cache size from its volume")
+			os.Exit(bootstrapFailedExitCode)
+		}
+		sizeLimit = volumeSizeLimit
+	}
+	layerCache, err := layercache.NewCache(flagVar.ManifestLayerCacheDir, sizeLimit,
+		layercache.WithMetrics(metrics.NewLayerCacheMetrics()))
+	if err != nil {
+		setupLog.Error(err, "unable to load layer cache")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	if flagVar.ManifestLayerCacheGCInterval > 0 {
+		// the manager caches the Manifests in the namespace configured for the Istio Gateway
+		collector := layercache.NewGarbageCollector(
+			manifestrepo.NewRepository(mgr.GetClient(), flagVar.IstioGatewayNamespace), layerCache)
+		go scheduleLayerCacheGarbageCollection(collector, flagVar.ManifestLayerCacheGCInterval, mgr, setupLog)
+	}
+	return img.NewPathExtractor(img.WithLayerCache(layerCache))
+}
+
+func scheduleLayerCacheGarbageCollection(collector *layercache.GarbageCollector, gcInterval time.Duration,
+	mgr manager.Manager, setupLog logr.Logger,
+) {
+	ctx := context.Background()
+	if !mgr.GetCache().WaitForCacheSync(ctx) {
+		setupLog.V(log.InfoLevel).Error(errFailedToScheduleLayerCacheGC, "failed to sync cache")
+		return
+	}
+
+	scheduler := gocron.NewScheduler(time.UTC)
+	_, scheduleErr := scheduler.Every(gcInterval).Do(func() {
+		ctx, cancel := context.WithTimeout(ctx, layerCacheGCTimeout)
+		defer cancel()
+		if err := collector.Collect(ctx); err != nil {
+			setupLog.Info(fmt.Sprintf("failed to collect unreferenced layers, err: %s", err))
+		}
+	})
+	if scheduleErr != nil {
+		setupLog.Info(fmt.Sprintf("failed to setup layer cache garbage collection, err: %s", scheduleErr))
+	}
+	scheduler.StartAsync()
+	setupLog.V(log.DebugLevel).Info("scheduled job for collecting unreferenced layers")
+}
+
 //nolint:ireturn // constructor functions can return interfaces
 func keychainLookupFromFlag(clnt client.Client, flagVar *flags.FlagVar) spec.KeyChainLookup {
 	if flagVar.OciRegistryCredSecretName != "" {
@@ -567,6 +697,7 @@ func setupKcpWatcherReconciler(mgr ctrl.Manager, options ctrlruntime.Options, ev
 			Error:   flags.DefaultKymaRequeueErrInterval,
 			Warning: flags.DefaultKymaRequeueWarningInterval,
 		},
+		Routing:               routing.Backend(flagVar.WatcherRoutingBackend),
 		IstioGatewayNamespace: flagVar.IstioGatewayNamespace,
 	}).SetupWithManager(mgr, options); err != nil {
 		setupLog.Error(err, "unable to create watcher controller")
@@ -627,3 +758,43 @@ func setupMandatoryModuleDeletionReconciler(mgr ctrl.Manager,
 		os.Exit(bootstrapFailedExitCode)
 	}
 }
+
+func setupModuleReleaseMetaRolloutReconciler(mgr ctrl.Manager,
+	flagVar *flags.FlagVar,
+	options ctrlruntime.Options,
+	setupLog logr.Logger,
+) {
+	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
+		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
+	options.CacheSyncTimeout = flagVar.CacheSyncTimeout
+	options.MaxConcurrentReconciles = 1
+
+	rolloutReconciler := modulereleasemeta.NewRolloutReconciler(mgr.GetClient(),
+		flagVar.ModuleReleaseMetaRolloutRequeueInterval)
+
+	if err := rolloutReconciler.SetupWithManager(mgr, options); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ModuleReleaseMetaRollout")
+		os.Exit(bootstrapFailedExitCode)
+	}
+}
+
+func setupMaintenanceWindowPolicyReconciler(mgr ctrl.Manager,
+	flagVar *flags.FlagVar,
+	options ctrlruntime.Options,
+	policySource *maintenancewindows.PolicySource,
+	maintenanceWindowsMetrics *metrics.MaintenanceWindowMetrics,
+	setupLog logr.Logger,
+) {
+	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
+		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
+	options.CacheSyncTimeout = flagVar.CacheSyncTimeout
+	options.MaxConcurrentReconciles = 1
+
+	policyReconciler := maintenancewindowpolicy.NewReconciler(mgr.GetClient(), policySource,
+		maintenanceWindowsMetrics, maintenanceWindowPolicyName, flagVar.MaintenanceWindowPolicyRequeueInterval)
+
+	if err := policyReconciler.SetupWithManager(mgr, options); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MaintenanceWindowPolicy")
+		os.Exit(bootstrapFailedExitCode)
+	}
+}
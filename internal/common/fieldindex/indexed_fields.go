@@ -6,4 +6,6 @@ const (
 	MrmMandatoryModuleNegativeValue = "false"
 
 	ModuleTemplateVersionName = ".spec.version"
+
+	KymaModuleStatusName = ".status.modules.name"
 )
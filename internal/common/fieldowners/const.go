@@ -13,4 +13,6 @@ const (
 	DeclarativeApplier      = client.FieldOwner("declarative.kyma-project.io/applier")
 	ModuleCatalogSync       = client.FieldOwner("catalog-sync")
 	KymaSyncContextProvider = client.FieldOwner("kyma-sync-context")
+	ModuleConfig            = client.FieldOwner("operator.kyma-project.io/module-config")
+	UpgradeHook             = client.FieldOwner("operator.kyma-project.io/upgrade-hook")
 )
@@ -24,10 +24,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 )
 
-const (
-	controllerName    = "istio-controller"
-	kcpRootSecretName = "klm-watcher"
-)
+const controllerName = "istio-controller"
 
 var errCouldNotGetTimeFromAnnotation = errors.New("getting time from annotation failed")
 
@@ -91,7 +88,7 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager, opts ctrlruntime.Options
 }
 
 func isRootSecret(object client.Object) bool {
-	return object.GetNamespace() == shared.IstioNamespace && object.GetName() == kcpRootSecretName
+	return object.GetNamespace() == shared.IstioNamespace && object.GetName() == shared.CASecretName
 }
 
 func legacyHandlerParseAnnotationTime(secret *apicorev1.Secret,
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -59,6 +60,7 @@ var (
 	ErrManifestsStillExist = errors.New("manifests still exist")
 	ErrInvalidKymaSpec     = errors.New("invalid kyma spec")
 	ErrKymaInErrorState    = errors.New("kyma in error state")
+	ErrModuleStillRequired = errors.New("module deletion blocked")
 )
 
 const (
@@ -66,6 +68,8 @@ const (
 	updateSpecError   event.Reason = "UpdateSpecError"
 	updateStatusError event.Reason = "UpdateStatusError"
 	patchStatusError  event.Reason = "PatchStatus"
+
+	moduleDeletionBlocked event.Reason = "ModuleDeletionBlocked"
 )
 
 type DeletionMetricWriter interface {
@@ -94,6 +98,26 @@ type ModuleStatusHandler interface {
 	UpdateModuleStatuses(ctx context.Context, kyma *v1beta2.Kyma, modules modulecommon.Modules) error
 }
 
+type UpgradeRollbackService interface {
+	MarkFailedUpgrades(kyma *v1beta2.Kyma)
+}
+
+type MaintenanceWindowService interface {
+	UpdateStatus(kyma *v1beta2.Kyma, modules modulecommon.Modules)
+}
+
+type ModuleHistoryService interface {
+	Record(ctx context.Context, kyma *v1beta2.Kyma, previousStatuses []v1beta2.ModuleStatus) error
+}
+
+type ModuleEventService interface {
+	Publish(kyma *v1beta2.Kyma, previousStatuses []v1beta2.ModuleStatus)
+}
+
+type PlanService interface {
+	Publish(ctx context.Context, kyma *v1beta2.Kyma, modules modulecommon.Modules, changes []sync.ManifestChange) error
+}
+
 type SkrSyncService interface {
 	SyncCrds(ctx context.Context, kyma *v1beta2.Kyma) (bool, error)
 	SyncImagePullSecret(ctx context.Context, kyma types.NamespacedName) error
@@ -120,6 +144,11 @@ type Reconciler struct {
 	SkrSyncService       SkrSyncService
 	ModulesStatusHandler ModuleStatusHandler
 	SKRWebhookManager    SKRWebhookManager
+	UpgradeRollback      UpgradeRollbackService
+	PlanService          PlanService
+	MaintenanceWindows   MaintenanceWindowService
+	ModuleHistory        ModuleHistoryService
+	ModuleEvents         ModuleEventService
 
 	Metrics        *metrics.KymaMetrics
 	RemoteCatalog  *remote.RemoteCatalog
@@ -165,6 +194,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{RequeueAfter: r.Success}, nil
 	}
 
+	if kyma.IsDryRun() && kyma.DeletionTimestamp.IsZero() {
+		logger.V(log.DebugLevel).Info("planning reconciliation for Kyma: " + kyma.Name)
+		return r.planManifests(ctx, kyma)
+	}
+
 	err := r.SkrContextFactory.Init(ctx, kyma.GetNamespacedName())
 	if !kyma.DeletionTimestamp.IsZero() && errors.Is(err, accessmanager.ErrAccessSecretNotFound) {
 		return r.handleDeletedSkr(ctx, req, kyma)
@@ -210,7 +244,13 @@ func (r *Reconciler) ValidateDefaultChannel(kyma *v1beta2.Kyma) error {
 	return nil
 }
 
-func (r *Reconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1beta2.Kyma) error {
+// DeleteNoLongerExistingModules deletes the modules that were removed from the Kyma spec.
+// A module that other modules still depend on is kept until the depending modules are deleted.
+// The blocked deletion is reported in the message of the module status, and an event is only issued
+// if the persisted previous status did not report the same blocked deletion.
+func (r *Reconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1beta2.Kyma,
+	requiredBy map[string][]string, previousStatuses []v1beta2.ModuleStatus,
+) error {
 	moduleStatus := kyma.GetNoLongerExistingModuleStatus()
 	var err error
 	if len(moduleStatus) == 0 {
@@ -221,6 +261,16 @@ func (r *Reconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1
 		if moduleStatus.Manifest == nil {
 			continue
 		}
+		if dependents, required := requiredBy[moduleStatus.Name]; required {
+			kyma.UpdateCondition(v1beta2.ConditionTypeModuleDependencies, apimetav1.ConditionFalse)
+			blockedErr := fmt.Errorf("%w: module %s is required by %s",
+				ErrModuleStillRequired, moduleStatus.Name, strings.Join(dependents, ", "))
+			if previousMessage(previousStatuses, moduleStatus.Name) != blockedErr.Error() {
+				r.Event.Warning(kyma, moduleDeletionBlocked, blockedErr)
+			}
+			moduleStatus.Message = blockedErr.Error()
+			continue
+		}
 		err = r.deleteManifest(ctx, moduleStatus.Manifest)
 	}
 
@@ -230,6 +280,15 @@ func (r *Reconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1
 	return nil
 }
 
+func previousMessage(previousStatuses []v1beta2.ModuleStatus, moduleName string) string {
+	for _, previousStatus := range previousStatuses {
+		if previousStatus.Name == moduleName {
+			return previousStatus.Message
+		}
+	}
+	return ""
+}
+
 func (r *Reconciler) UpdateMetrics(ctx context.Context, kyma *v1beta2.Kyma) {
 	if err := r.Metrics.UpdateAll(kyma); err != nil {
 		if metrics.IsMissingMetricsAnnotationOrLabel(err) {
@@ -684,28 +743,82 @@ func (r *Reconciler) updateKyma(ctx context.Context, kyma *v1beta2.Kyma) error {
 	return nil
 }
 
-func (r *Reconciler) reconcileManifests(ctx context.Context, kyma *v1beta2.Kyma) error {
+// resolveModules resolves the desired modules of the Kyma. It is shared by the reconciliation and
+// the dry-run plan, so that the plan reports the same module versions the reconciliation applies.
+func (r *Reconciler) resolveModules(ctx context.Context, kyma *v1beta2.Kyma) modulecommon.Modules {
+	if r.UpgradeRollback != nil {
+		r.UpgradeRollback.MarkFailedUpgrades(kyma)
+	}
+
 	templates := r.TemplateLookup.GetRegularTemplates(ctx, kyma)
 	prsr := parser.NewParser(r.Client, r.DescriptorProvider, r.Config.RemoteSyncNamespace, r.Config.OCIRegistry)
-	modules := prsr.GenerateModulesFromTemplates(kyma, templates)
+	return prsr.GenerateModulesFromTemplates(kyma, templates)
+}
+
+func (r *Reconciler) reconcileManifests(ctx context.Context, kyma *v1beta2.Kyma) error {
+	modules := r.resolveModules(ctx, kyma)
 
 	runner := sync.New(r)
 	if err := runner.ReconcileManifests(ctx, kyma, modules); err != nil {
 		return fmt.Errorf("sync failed: %w", err)
 	}
 
+	previousStatuses := kyma.Status.DeepCopy().Modules
 	err := r.ModulesStatusHandler.UpdateModuleStatuses(ctx, kyma, modules)
 	if err != nil {
 		return fmt.Errorf("failed to update module statuses: %w", err)
 	}
 
+	if r.ModuleHistory != nil {
+		// The history is informational only and must not block the reconciliation of the modules.
+		if err := r.ModuleHistory.Record(ctx, kyma, previousStatuses); err != nil {
+			logf.FromContext(ctx).Error(err, "failed to record module version history")
+		}
+	}
+
+	if r.MaintenanceWindows != nil {
+		r.MaintenanceWindows.UpdateStatus(kyma, modules)
+	}
+
+	if r.ModuleEvents != nil {
+		r.ModuleEvents.Publish(kyma, previousStatuses)
+	}
+
+	if modules.ContainsRolledBackModule() {
+		kyma.UpdateCondition(v1beta2.ConditionTypeModuleUpgrades, apimetav1.ConditionFalse)
+	} else if r.UpgradeRollback != nil {
+		kyma.UpdateCondition(v1beta2.ConditionTypeModuleUpgrades, apimetav1.ConditionTrue)
+	}
+
+	requiredBy := modules.RequiredBy()
+	if modules.ContainsUnresolvableDependency() {
+		kyma.UpdateCondition(v1beta2.ConditionTypeModuleDependencies, apimetav1.ConditionFalse)
+	} else if len(requiredBy) > 0 {
+		kyma.UpdateCondition(v1beta2.ConditionTypeModuleDependencies, apimetav1.ConditionTrue)
+	}
+
 	// If module get removed from kyma, the module deletion happens here.
-	if err := r.DeleteNoLongerExistingModules(ctx, kyma); err != nil {
+	if err := r.DeleteNoLongerExistingModules(ctx, kyma, requiredBy, previousStatuses); err != nil {
 		return fmt.Errorf("error while syncing conditions during deleting non exists modules: %w", err)
 	}
 	return nil
 }
 
+// planManifests publishes the changes reconcileManifests would apply to the Manifests of the Kyma,
+// following the same module resolution, without changing the Kyma or its Manifests.
+func (r *Reconciler) planManifests(ctx context.Context, kyma *v1beta2.Kyma) (ctrl.Result, error) {
+	modules := r.resolveModules(ctx, kyma)
+
+	changes, err := sync.New(r).Plan(ctx, kyma, modules)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not plan manifests: %w", err)
+	}
+	if err := r.PlanService.Publish(ctx, kyma, modules, changes); err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not publish plan: %w", err)
+	}
+	return ctrl.Result{RequeueAfter: r.Success}, nil
+}
+
 func (r *Reconciler) updateStatus(ctx context.Context, kyma *v1beta2.Kyma,
 	state shared.State, message string,
 ) error {
@@ -0,0 +1,58 @@
+package kyma_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/controller/kyma"
+	"github.com/kyma-project/lifecycle-manager/internal/event"
+)
+
+func TestDeleteNoLongerExistingModules_WhenDeletionStaysBlocked_IssuesEventOnce(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	eventStub := &eventStub{}
+	reconciler := &kyma.Reconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Event:  eventStub,
+	}
+	kymaCR := &v1beta2.Kyma{
+		Spec: v1beta2.KymaSpec{Modules: []v1beta2.Module{{Name: "dependent"}}},
+		Status: v1beta2.KymaStatus{Modules: []v1beta2.ModuleStatus{
+			{Name: "dependent"},
+			{Name: "base", Manifest: &v1beta2.TrackingObject{}},
+		}},
+	}
+	requiredBy := map[string][]string{"base": {"dependent"}}
+
+	for range 2 {
+		// the persisted status of the previous reconciliation
+		previousStatuses := kymaCR.Status.DeepCopy().Modules
+		// the module statuses are regenerated in every reconciliation
+		for i := range kymaCR.Status.Modules {
+			kymaCR.Status.Modules[i].Message = ""
+		}
+
+		err := reconciler.DeleteNoLongerExistingModules(t.Context(), kymaCR, requiredBy, previousStatuses)
+
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, eventStub.warnings)
+	assert.Contains(t, kymaCR.Status.Modules[1].Message, "module base is required by dependent")
+}
+
+type eventStub struct {
+	warnings int
+}
+
+func (e *eventStub) Normal(_ machineryruntime.Object, _ event.Reason, _ string) {}
+
+func (e *eventStub) Warning(_ machineryruntime.Object, _ event.Reason, _ error) {
+	e.warnings++
+}
@@ -0,0 +1,130 @@
+package maintenancewindowpolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+type PolicySource interface {
+	SetPolicy(policy maintenancewindows.MaintenanceWindowPolicy)
+}
+
+type ConfigReadMetrics interface {
+	RecordConfigReadSuccess(success bool)
+}
+
+// Reconciler loads the MaintenanceWindowPolicy with the configured name into the policy source
+// used to resolve maintenance windows, and reports parse errors and rule matches in the status of all policies.
+type Reconciler struct {
+	client          client.Client
+	policySource    PolicySource
+	metrics         ConfigReadMetrics
+	policyName      string
+	requeueInterval time.Duration
+}
+
+func NewReconciler(client client.Client, policySource PolicySource, metrics ConfigReadMetrics,
+	policyName string, requeueInterval time.Duration,
+) *Reconciler {
+	return &Reconciler{
+		client:          client,
+		policySource:    policySource,
+		metrics:         metrics,
+		policyName:      policyName,
+		requeueInterval: requeueInterval,
+	}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	policy := &v1beta2.MaintenanceWindowPolicy{}
+	if err := r.client.Get(ctx, req.NamespacedName, policy); err != nil {
+		if !util.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to get MaintenanceWindowPolicy: %w", err)
+		}
+		if req.Name == r.policyName {
+			logf.FromContext(ctx).Info("maintenance window policy deleted, falling back to the policy file")
+			r.policySource.SetPolicy(nil)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	parsedPolicy, parseErr := maintenancewindows.NewPolicyFromResource(policy.Spec)
+	if policy.Name == r.policyName {
+		r.metrics.RecordConfigReadSuccess(parseErr == nil)
+		// An invalid policy keeps the previously loaded one active.
+		if parseErr == nil {
+			r.policySource.SetPolicy(parsedPolicy)
+		}
+	}
+
+	kymaList := &v1beta2.KymaList{}
+	if err := r.client.List(ctx, kymaList); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list Kymas: %w", err)
+	}
+
+	status := CalculateStatus(policy, parsedPolicy, parseErr, kymaList.Items)
+	if !equality.Semantic.DeepEqual(status, policy.Status) {
+		policy.Status = status
+		if err := r.client.Status().Update(ctx, policy); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update MaintenanceWindowPolicy status: %w", err)
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: r.requeueInterval}, nil
+}
+
+// CalculateStatus reports whether the policy could be parsed and, for a parsed policy,
+// how many Kymas each rule matches first and how many fall back to the default window.
+func CalculateStatus(policy *v1beta2.MaintenanceWindowPolicy, parsedPolicy *resolver.MaintenanceWindowPolicy,
+	parseErr error, kymas []v1beta2.Kyma,
+) v1beta2.MaintenanceWindowPolicyStatus {
+	status := v1beta2.MaintenanceWindowPolicyStatus{
+		ObservedGeneration: policy.GetGeneration(),
+		Conditions:         append([]apimetav1.Condition{}, policy.Status.Conditions...),
+	}
+
+	condition := apimetav1.Condition{
+		Type:               v1beta2.MaintenanceWindowPolicyConditionTypeValid,
+		Status:             apimetav1.ConditionTrue,
+		Reason:             v1beta2.MaintenanceWindowPolicyConditionReasonParsed,
+		Message:            "maintenance window policy parsed successfully",
+		ObservedGeneration: policy.GetGeneration(),
+	}
+	if parseErr != nil {
+		condition.Status = apimetav1.ConditionFalse
+		condition.Reason = v1beta2.MaintenanceWindowPolicyConditionReasonParseError
+		condition.Message = parseErr.Error()
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	if parsedPolicy == nil {
+		return status
+	}
+
+	status.Rules = make([]v1beta2.MaintenancePolicyRuleStatus, len(parsedPolicy.Rules))
+	for idx := range status.Rules {
+		status.Rules[idx].Index = idx
+	}
+	for i := range kymas {
+		ruleIdx := maintenancewindows.MatchingRule(parsedPolicy, maintenancewindows.RuntimeOf(&kymas[i]))
+		if ruleIdx == maintenancewindows.NoMatchingRule {
+			status.DefaultMatchedKymas++
+			continue
+		}
+		status.Rules[ruleIdx].MatchedKymas++
+	}
+	return status
+}
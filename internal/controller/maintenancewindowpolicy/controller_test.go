@@ -0,0 +1,165 @@
+package maintenancewindowpolicy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	machineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api"
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/controller/maintenancewindowpolicy"
+	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
+)
+
+const (
+	policyName      = "policy"
+	requeueInterval = 5 * time.Minute
+)
+
+func TestReconcile_WhenPolicyIsValid_LoadsPolicyAndReportsMatches(t *testing.T) {
+	policy := policyWithPlan(policyName, "trial")
+	clnt := fakeClient(policy, kymaWithPlan("trial-1", "trial"), kymaWithPlan("trial-2", "trial"),
+		kymaWithPlan("aws", "aws"))
+	source := &policySourceStub{}
+	metrics := &metricsStub{}
+	reconciler := maintenancewindowpolicy.NewReconciler(clnt, source, metrics, policyName, requeueInterval)
+
+	result, err := reconciler.Reconcile(t.Context(), requestFor(policyName))
+
+	require.NoError(t, err)
+	assert.Equal(t, requeueInterval, result.RequeueAfter)
+	assert.NotNil(t, source.policy)
+	assert.Equal(t, []bool{true}, metrics.recorded)
+	updated := getPolicy(t, clnt, policyName)
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, v1beta2.MaintenanceWindowPolicyConditionTypeValid))
+	assert.Equal(t, []v1beta2.MaintenancePolicyRuleStatus{{Index: 0, MatchedKymas: 2}}, updated.Status.Rules)
+	assert.Equal(t, 1, updated.Status.DefaultMatchedKymas)
+}
+
+func TestReconcile_WhenPolicyIsInvalid_KeepsPreviousPolicyAndReportsParseError(t *testing.T) {
+	policy := policyWithPlan(policyName, "trial(")
+	clnt := fakeClient(policy)
+	previous := maintenancewindows.NewPolicySource(nil)
+	source := &policySourceStub{policy: previous}
+	metrics := &metricsStub{}
+	reconciler := maintenancewindowpolicy.NewReconciler(clnt, source, metrics, policyName, requeueInterval)
+
+	_, err := reconciler.Reconcile(t.Context(), requestFor(policyName))
+
+	require.NoError(t, err)
+	assert.Same(t, previous, source.policy)
+	assert.Equal(t, []bool{false}, metrics.recorded)
+	updated := getPolicy(t, clnt, policyName)
+	condition := meta.FindStatusCondition(updated.Status.Conditions, v1beta2.MaintenanceWindowPolicyConditionTypeValid)
+	require.NotNil(t, condition)
+	assert.Equal(t, apimetav1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1beta2.MaintenanceWindowPolicyConditionReasonParseError, condition.Reason)
+	assert.Contains(t, condition.Message, "spec.rules[0].match.plan")
+	assert.Empty(t, updated.Status.Rules)
+}
+
+func TestReconcile_WhenOtherPolicy_DoesNotLoadPolicy(t *testing.T) {
+	policy := policyWithPlan("other", "trial")
+	clnt := fakeClient(policy)
+	source := &policySourceStub{}
+	metrics := &metricsStub{}
+	reconciler := maintenancewindowpolicy.NewReconciler(clnt, source, metrics, policyName, requeueInterval)
+
+	_, err := reconciler.Reconcile(t.Context(), requestFor("other"))
+
+	require.NoError(t, err)
+	assert.False(t, source.set)
+	assert.Empty(t, metrics.recorded)
+	updated := getPolicy(t, clnt, "other")
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, v1beta2.MaintenanceWindowPolicyConditionTypeValid))
+}
+
+func TestReconcile_WhenPolicyDeleted_ResetsPolicy(t *testing.T) {
+	source := &policySourceStub{policy: maintenancewindows.NewPolicySource(nil)}
+	reconciler := maintenancewindowpolicy.NewReconciler(fakeClient(), source, &metricsStub{}, policyName,
+		requeueInterval)
+
+	result, err := reconciler.Reconcile(t.Context(), requestFor(policyName))
+
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.True(t, source.set)
+	assert.Nil(t, source.policy)
+}
+
+type policySourceStub struct {
+	policy maintenancewindows.MaintenanceWindowPolicy
+	set    bool
+}
+
+func (s *policySourceStub) SetPolicy(policy maintenancewindows.MaintenanceWindowPolicy) {
+	s.policy = policy
+	s.set = true
+}
+
+type metricsStub struct {
+	recorded []bool
+}
+
+func (m *metricsStub) RecordConfigReadSuccess(success bool) {
+	m.recorded = append(m.recorded, success)
+}
+
+func policyWithPlan(name, plan string) *v1beta2.MaintenanceWindowPolicy {
+	return &v1beta2.MaintenanceWindowPolicy{
+		ObjectMeta: apimetav1.ObjectMeta{Name: name, Generation: 1},
+		Spec: v1beta2.MaintenanceWindowPolicySpec{
+			Rules: []v1beta2.MaintenancePolicyRule{
+				{
+					Match: v1beta2.MaintenancePolicyMatch{Plan: plan},
+					Windows: []v1beta2.MaintenancePolicyWindow{
+						{Days: []string{"Sat"}, Begin: "02:00:00Z", End: "06:00:00Z"},
+					},
+				},
+			},
+			Default: v1beta2.MaintenancePolicyWindow{Begin: "2026-01-01T00:00:00Z", End: "2026-01-01T04:00:00Z"},
+		},
+	}
+}
+
+func kymaWithPlan(name, plan string) *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      name,
+			Namespace: "kcp-system",
+			Labels:    map[string]string{shared.PlanLabel: plan},
+		},
+	}
+}
+
+func requestFor(name string) ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Name: name}}
+}
+
+func getPolicy(t *testing.T, clnt client.Client, name string) *v1beta2.MaintenanceWindowPolicy {
+	t.Helper()
+	policy := &v1beta2.MaintenanceWindowPolicy{}
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKey{Name: name}, policy))
+	return policy
+}
+
+func fakeClient(objs ...client.Object) client.Client {
+	scheme := machineryruntime.NewScheme()
+	machineryutilruntime.Must(api.AddToScheme(scheme))
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1beta2.MaintenanceWindowPolicy{}).
+		Build()
+}
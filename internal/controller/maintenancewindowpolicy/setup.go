@@ -0,0 +1,25 @@
+package maintenancewindowpolicy
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlruntime "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+const controllerName = "maintenance-window-policy"
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts ctrlruntime.Options) error {
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta2.MaintenanceWindowPolicy{}).
+		Named(controllerName).
+		WithOptions(opts).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to setup manager for maintenance window policy controller: %w", err)
+	}
+	return nil
+}
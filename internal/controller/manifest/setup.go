@@ -2,6 +2,7 @@ package manifest
 
 import (
 	"fmt"
+	"time"
 
 	apicorev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
@@ -15,6 +16,8 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	declarativev2 "github.com/kyma-project/lifecycle-manager/internal/declarative/v2"
+	"github.com/kyma-project/lifecycle-manager/internal/event"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/spec"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
@@ -41,6 +44,9 @@ func SetupWithManager(mgr manager.Manager,
 	cachedManifestParser declarativev2.CachedManifestParser,
 	customStateCheck declarativev2.StateCheck,
 	skrImagePullSecretName string,
+	driftDetectionMode skrresources.DriftDetectionMode,
+	driftDetectionInterval time.Duration,
+	event event.Event,
 ) error {
 	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta2.Manifest{}).
@@ -52,7 +58,8 @@ func SetupWithManager(mgr manager.Manager,
 		Complete(declarativev2.NewReconciler(
 			requeueIntervals, rateLimiter, manifestMetrics, mandatoryModulesMetrics, manifestClient,
 			orphanDetectionService, specResolver, skrClientCache, skrClient, kcpClient, cachedManifestParser,
-			customStateCheck, skrImagePullSecretName)); err != nil {
+			customStateCheck, skrImagePullSecretName, driftDetectionMode, driftDetectionInterval,
+			event)); err != nil {
 		return fmt.Errorf("failed to setup manager for manifest controller: %w", err)
 	}
 
@@ -0,0 +1,102 @@
+package modulereleasemeta
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldindex"
+	"github.com/kyma-project/lifecycle-manager/pkg/queue"
+)
+
+// rolloutRequeueJitterPercentage spreads the requeues of the ModuleReleaseMetas with a staged rollout,
+// so that they do not refresh their status at the same time.
+const rolloutRequeueJitterPercentage = 0.1
+
+// RolloutReconciler reports the progress of staged channel rollouts in the ModuleReleaseMeta status.
+type RolloutReconciler struct {
+	client          client.Client
+	requeueInterval time.Duration
+	jitter          *queue.RequeueJitter
+}
+
+func NewRolloutReconciler(client client.Client, requeueInterval time.Duration) *RolloutReconciler {
+	return &RolloutReconciler{
+		client:          client,
+		requeueInterval: requeueInterval,
+		jitter:          queue.NewRequeueJitter(1, rolloutRequeueJitterPercentage),
+	}
+}
+
+func (r *RolloutReconciler) Reconcile(ctx context.Context, mrm *v1beta2.ModuleReleaseMeta) (ctrl.Result, error) {
+	kymaList := &v1beta2.KymaList{}
+	if err := r.client.List(ctx, kymaList,
+		client.MatchingFields{fieldindex.KymaModuleStatusName: mrm.Spec.ModuleName}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list Kymas: %w", err)
+	}
+
+	status := CalculateRolloutStatus(mrm, kymaList.Items)
+	if !equality.Semantic.DeepEqual(status, mrm.Status) {
+		mrm.Status = status
+		if err := r.client.Status().Update(ctx, mrm); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update ModuleReleaseMeta rollout status: %w", err)
+		}
+	}
+
+	if len(status.Rollouts) == 0 {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: r.jitter.Apply(r.requeueInterval)}, nil
+}
+
+// CalculateRolloutStatus counts, per channel with a staged rollout, the Kymas using the module on that channel,
+// the Kymas that are part of the rollout cohort, and the cohort Kymas already reporting the candidate version.
+// Kymas that already run the candidate version count to the cohort, as they are pinned to it.
+func CalculateRolloutStatus(mrm *v1beta2.ModuleReleaseMeta, kymas []v1beta2.Kyma) v1beta2.ModuleReleaseMetaStatus {
+	status := v1beta2.ModuleReleaseMetaStatus{}
+	for _, channel := range mrm.Spec.Channels {
+		if channel.Rollout == nil {
+			continue
+		}
+
+		rolloutStatus := v1beta2.ChannelRolloutStatus{
+			Channel: channel.Channel,
+			Version: channel.Rollout.Version,
+		}
+		for i := range kymas {
+			module, found := findModuleStatusOnChannel(&kymas[i], mrm.Spec.ModuleName, channel.Channel)
+			if !found {
+				continue
+			}
+			rolloutStatus.TotalKymas++
+			if channel.VersionFor(mrm.Spec.ModuleName, &kymas[i]) != channel.Rollout.Version {
+				continue
+			}
+			rolloutStatus.TargetedKymas++
+			if module.Version == channel.Rollout.Version {
+				rolloutStatus.UpdatedKymas++
+			}
+		}
+		status.Rollouts = append(status.Rollouts, rolloutStatus)
+	}
+	return status
+}
+
+func findModuleStatusOnChannel(kyma *v1beta2.Kyma, moduleName, channel string) (v1beta2.ModuleStatus, bool) {
+	for _, module := range kyma.Status.Modules {
+		if module.Name != moduleName {
+			continue
+		}
+		moduleChannel := module.Channel
+		if moduleChannel == "" {
+			moduleChannel = kyma.Spec.Channel
+		}
+		return module, moduleChannel == channel
+	}
+	return v1beta2.ModuleStatus{}, false
+}
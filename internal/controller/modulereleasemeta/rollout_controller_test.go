@@ -0,0 +1,173 @@
+package modulereleasemeta_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	machineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldindex"
+	"github.com/kyma-project/lifecycle-manager/internal/controller/modulereleasemeta"
+)
+
+const (
+	moduleName      = "test-module"
+	requeueInterval = 30 * time.Second
+)
+
+func TestCalculateRolloutStatus_CountsCohortAndUpdatedKymas(t *testing.T) {
+	mrm := moduleReleaseMetaWithCanaryRollout()
+	kymas := []v1beta2.Kyma{
+		kymaWithModule("canary-updated", "regular", "", "1.1.0", true),
+		kymaWithModule("canary-pending", "regular", "", "1.0.0", true),
+		kymaWithModule("stable", "regular", "", "1.0.0", false),
+		kymaWithModule("other-channel", "fast", "", "2.0.0", true),
+		kymaWithModule("module-channel-override", "fast", "regular", "1.0.0", true),
+		{ObjectMeta: apimetav1.ObjectMeta{Name: "without-module"}},
+	}
+
+	status := modulereleasemeta.CalculateRolloutStatus(mrm, kymas)
+
+	require.Len(t, status.Rollouts, 1)
+	assert.Equal(t, v1beta2.ChannelRolloutStatus{
+		Channel:       "regular",
+		Version:       "1.1.0",
+		TotalKymas:    4,
+		TargetedKymas: 3,
+		UpdatedKymas:  1,
+	}, status.Rollouts[0])
+}
+
+func TestCalculateRolloutStatus_WhenKymaLeftCohort_CountsPinnedKyma(t *testing.T) {
+	mrm := moduleReleaseMetaWithCanaryRollout()
+	kymas := []v1beta2.Kyma{
+		kymaWithModule("former-canary", "regular", "", "1.1.0", false),
+	}
+
+	status := modulereleasemeta.CalculateRolloutStatus(mrm, kymas)
+
+	require.Len(t, status.Rollouts, 1)
+	assert.Equal(t, 1, status.Rollouts[0].TargetedKymas)
+	assert.Equal(t, 1, status.Rollouts[0].UpdatedKymas)
+}
+
+func TestCalculateRolloutStatus_WithoutRollout_ReturnsEmptyStatus(t *testing.T) {
+	mrm := &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName: moduleName,
+			Channels:   []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.0.0"}},
+		},
+	}
+
+	status := modulereleasemeta.CalculateRolloutStatus(mrm, []v1beta2.Kyma{
+		kymaWithModule("kyma", "regular", "", "1.0.0", true),
+	})
+
+	assert.Empty(t, status.Rollouts)
+}
+
+func TestRolloutReconciler_Reconcile_WhenRolloutConfigured_UpdatesStatusAndRequeues(t *testing.T) {
+	mrm := moduleReleaseMetaWithCanaryRollout()
+	kyma := kymaWithModule("canary", "regular", "", "1.1.0", true)
+	clnt := fakeClient(mrm, &kyma)
+	reconciler := modulereleasemeta.NewRolloutReconciler(clnt, requeueInterval)
+
+	result, err := reconciler.Reconcile(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.InDelta(t, requeueInterval, result.RequeueAfter, float64(requeueInterval)/10)
+	updated := &v1beta2.ModuleReleaseMeta{}
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKeyFromObject(mrm), updated))
+	require.Len(t, updated.Status.Rollouts, 1)
+	assert.Equal(t, 1, updated.Status.Rollouts[0].UpdatedKymas)
+}
+
+func TestRolloutReconciler_Reconcile_CountsOnlyKymasWithModule(t *testing.T) {
+	mrm := moduleReleaseMetaWithCanaryRollout()
+	kyma := kymaWithModule("canary", "regular", "", "1.1.0", true)
+	otherModuleKyma := kymaWithModule("other", "regular", "", "1.1.0", true)
+	otherModuleKyma.Status.Modules[0].Name = "other-module"
+	clnt := fakeClient(mrm, &kyma, &otherModuleKyma)
+	reconciler := modulereleasemeta.NewRolloutReconciler(clnt, requeueInterval)
+
+	_, err := reconciler.Reconcile(t.Context(), mrm)
+
+	require.NoError(t, err)
+	updated := &v1beta2.ModuleReleaseMeta{}
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKeyFromObject(mrm), updated))
+	require.Len(t, updated.Status.Rollouts, 1)
+	assert.Equal(t, 1, updated.Status.Rollouts[0].TotalKymas)
+}
+
+func TestRolloutReconciler_Reconcile_WhenNoRollout_DoesNotRequeue(t *testing.T) {
+	mrm := &v1beta2.ModuleReleaseMeta{
+		ObjectMeta: apimetav1.ObjectMeta{Name: moduleName, Namespace: "kcp-system"},
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName: moduleName,
+			Channels:   []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.0.0"}},
+		},
+	}
+	reconciler := modulereleasemeta.NewRolloutReconciler(fakeClient(mrm), requeueInterval)
+
+	result, err := reconciler.Reconcile(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+}
+
+func moduleReleaseMetaWithCanaryRollout() *v1beta2.ModuleReleaseMeta {
+	return &v1beta2.ModuleReleaseMeta{
+		ObjectMeta: apimetav1.ObjectMeta{Name: moduleName, Namespace: "kcp-system"},
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName: moduleName,
+			Channels: []v1beta2.ChannelVersionAssignment{
+				{
+					Channel: "regular",
+					Version: "1.0.0",
+					Rollout: &v1beta2.ChannelRollout{
+						Version: "1.1.0",
+						Selector: &apimetav1.LabelSelector{
+							MatchLabels: map[string]string{"canary": "true"},
+						},
+					},
+				},
+				{Channel: "fast", Version: "2.0.0"},
+			},
+		},
+	}
+}
+
+func kymaWithModule(name, kymaChannel, moduleChannel, version string, canary bool) v1beta2.Kyma {
+	labels := map[string]string{}
+	if canary {
+		labels["canary"] = "true"
+	}
+	return v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: name, Namespace: "kcp-system", Labels: labels},
+		Spec:       v1beta2.KymaSpec{Channel: kymaChannel},
+		Status: v1beta2.KymaStatus{
+			Modules: []v1beta2.ModuleStatus{
+				{Name: moduleName, Channel: moduleChannel, Version: version},
+			},
+		},
+	}
+}
+
+func fakeClient(objs ...client.Object) client.Client {
+	scheme := machineryruntime.NewScheme()
+	machineryutilruntime.Must(api.AddToScheme(scheme))
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1beta2.ModuleReleaseMeta{}).
+		WithIndex(&v1beta2.Kyma{}, fieldindex.KymaModuleStatusName, modulereleasemeta.KymaModuleNames).
+		Build()
+}
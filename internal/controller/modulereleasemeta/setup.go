@@ -0,0 +1,68 @@
+package modulereleasemeta
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntime "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldindex"
+)
+
+const rolloutControllerName = "modulereleasemeta-rollout"
+
+func (r *RolloutReconciler) SetupWithManager(mgr ctrl.Manager, opts ctrlruntime.Options) error {
+	if err := setupFieldIndexForKymaByModuleName(mgr); err != nil {
+		return err
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta2.ModuleReleaseMeta{}).
+		Named(rolloutControllerName).
+		WithOptions(opts).
+		WithEventFilter(predicate.And(predicate.GenerationChangedPredicate{},
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				mrm, ok := obj.(*v1beta2.ModuleReleaseMeta)
+				if !ok {
+					return false
+				}
+				return mrm.Spec.Mandatory == nil
+			}))).
+		Complete(reconcile.AsReconciler[*v1beta2.ModuleReleaseMeta](mgr.GetClient(), r)); err != nil {
+		return fmt.Errorf("failed to setup manager for modulereleasemeta rollout controller: %w", err)
+	}
+	return nil
+}
+
+// setupFieldIndexForKymaByModuleName sets up a field indexer on Kymas to optimize lookup by the modules in the status.
+// MatchingFields: ".status.modules.name" -> "<module name>".
+func setupFieldIndexForKymaByModuleName(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&v1beta2.Kyma{},
+		fieldindex.KymaModuleStatusName,
+		KymaModuleNames,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to index field for Kyma by module name: %w", err)
+	}
+	return nil
+}
+
+// KymaModuleNames returns the names of the modules in the Kyma status.
+func KymaModuleNames(obj client.Object) []string {
+	kyma, ok := obj.(*v1beta2.Kyma)
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(kyma.Status.Modules))
+	for _, module := range kyma.Status.Modules {
+		names = append(names, module.Name)
+	}
+	return names
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/go-logr/logr"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/util/workqueue"
@@ -46,6 +47,9 @@ import (
 const (
 	setFinalizerFailure    event.Reason = "SettingPurgeFinalizerFailed"
 	removeFinalizerFailure event.Reason = "RemovingPurgeFinalizerFailed"
+	finalizersPurged       event.Reason = "PurgeFinalizersRemoved"
+	resourcesPurged        event.Reason = "PurgeResourcesDeleted"
+	purgeDryRun            event.Reason = "PurgeDryRun"
 )
 
 type Reconciler struct {
@@ -58,6 +62,10 @@ type Reconciler struct {
 	PurgeFinalizerTimeout time.Duration
 	SkipCRDs              matcher.CRDMatcherFunc
 	Metrics               *metrics.PurgeMetrics
+	// PolicyName is the name of the PurgePolicy deciding per CRD group how resources are purged.
+	// If it is empty or the PurgePolicy does not exist, the finalizers of all resources are removed
+	// after the PurgeFinalizerTimeout.
+	PolicyName string
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -73,12 +81,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.handleKymaNotMarkedForDeletion(ctx, kyma)
 	}
 
-	if requeueAfter := r.calculateRequeueAfterTime(kyma); requeueAfter != 0 {
+	policy, err := r.getPolicy(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if requeueAfter := calculateRequeueAfterTime(kyma, policy.EarliestTimeout()); requeueAfter != 0 {
 		return handlePurgeNotDue(logger, kyma, requeueAfter)
 	}
 
 	start := time.Now()
-	err := r.SkrContextFactory.Init(ctx, kyma.GetNamespacedName())
+	err = r.SkrContextFactory.Init(ctx, kyma.GetNamespacedName())
 	if err != nil {
 		return r.handleSkrNotFoundError(ctx, req, kyma, err)
 	}
@@ -88,7 +101,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.handleSkrNotFoundError(ctx, req, kyma, err)
 	}
 
-	return r.handlePurge(ctx, kyma, skrContext.Client, start)
+	return r.handlePurge(ctx, req, kyma, skrContext.Client, policy, start)
+}
+
+func (r *Reconciler) getPolicy(ctx context.Context) (Policy, error) {
+	if r.PolicyName == "" {
+		return NewPolicy(nil, r.PurgeFinalizerTimeout), nil
+	}
+	purgePolicy := &v1beta2.PurgePolicy{}
+	if err := r.Get(ctx, client.ObjectKey{Name: r.PolicyName}, purgePolicy); err != nil {
+		if util.IsNotFound(err) {
+			return NewPolicy(nil, r.PurgeFinalizerTimeout), nil
+		}
+		return Policy{}, fmt.Errorf("failed getting PurgePolicy %s: %w", r.PolicyName, err)
+	}
+	return NewPolicy(&purgePolicy.Spec, r.PurgeFinalizerTimeout), nil
 }
 
 func (r *Reconciler) UpdateStatus(ctx context.Context, kyma *v1beta2.Kyma, state shared.State, message string) error {
@@ -162,21 +189,23 @@ func (r *Reconciler) handleCleanupError(ctx context.Context, kyma *v1beta2.Kyma,
 
 func (r *Reconciler) handlePurge(
 	ctx context.Context,
+	req ctrl.Request,
 	kyma *v1beta2.Kyma,
 	remoteClient client.Client,
+	policy Policy,
 	start time.Time,
 ) (ctrl.Result, error) {
 	logger := logf.FromContext(ctx)
 
 	r.Metrics.UpdatePurgeCount()
 
-	handledResources, err := r.performCleanup(ctx, remoteClient)
-	if len(handledResources) > 0 {
+	result, err := r.performCleanup(ctx, kyma, remoteClient, policy)
+	if len(result.handledResources) > 0 {
 		logger.Info(
 			fmt.Sprintf(
-				"Removed all finalizers for Kyma %s related resources %s",
+				"Purged Kyma %s related resources %s",
 				kyma.GetName(),
-				strings.Join(handledResources, ", "),
+				strings.Join(result.handledResources, ", "),
 			),
 		)
 	}
@@ -185,6 +214,20 @@ func (r *Reconciler) handlePurge(
 	}
 	r.Metrics.DeletePurgeError(ctx, kyma, metrics.ErrCleanup)
 
+	if result.requeueAfter != 0 {
+		return handlePurgeNotDue(logger, kyma, result.requeueAfter)
+	}
+
+	if policy.DryRun() {
+		logger.Info(fmt.Sprintf("Purge of Kyma %s is a dry run, keeping the purge finalizer", kyma.GetName()))
+		return ctrl.Result{RequeueAfter: r.RateLimiter.When(req)}, nil
+	}
+
+	if result.awaitingDeletion {
+		logger.Info(fmt.Sprintf("Purge of Kyma %s waits for the deleted resources to be removed", kyma.GetName()))
+		return ctrl.Result{RequeueAfter: r.RateLimiter.When(req)}, nil
+	}
+
 	dropped, err := r.dropPurgeFinalizer(ctx, kyma)
 	if dropped {
 		logger.Info("Removed purge finalizer for Kyma " + kyma.GetName())
@@ -217,40 +260,96 @@ func (r *Reconciler) dropPurgeFinalizer(ctx context.Context, kyma *v1beta2.Kyma)
 	return false, nil
 }
 
-func (r *Reconciler) calculateRequeueAfterTime(kyma *v1beta2.Kyma) time.Duration {
-	deletionDeadline := kyma.DeletionTimestamp.Add(r.PurgeFinalizerTimeout)
+func calculateRequeueAfterTime(kyma *v1beta2.Kyma, timeout time.Duration) time.Duration {
+	deletionDeadline := kyma.DeletionTimestamp.Add(timeout)
 	if time.Now().Before(deletionDeadline) {
 		return time.Until(deletionDeadline.Add(time.Second))
 	}
 	return 0
 }
 
-func (r *Reconciler) performCleanup(ctx context.Context, remoteClient client.Client) ([]string, error) {
+// cleanupResult contains the purged resources and, if the timeout of some CRD groups did not pass yet,
+// the duration after which the purge must be continued.
+// If resources were deleted but are not removed yet, awaitingDeletion is set.
+type cleanupResult struct {
+	handledResources []string
+	requeueAfter     time.Duration
+	awaitingDeletion bool
+}
+
+func (r *Reconciler) performCleanup(ctx context.Context, kyma *v1beta2.Kyma, remoteClient client.Client,
+	policy Policy,
+) (cleanupResult, error) {
+	result := cleanupResult{}
 	crdList := apiextensionsv1.CustomResourceDefinitionList{}
 	if err := remoteClient.List(ctx, &crdList); err != nil {
-		return nil, fmt.Errorf("failed fetching CRDs from remote cluster: %w", err)
+		return result, fmt.Errorf("failed fetching CRDs from remote cluster: %w", err)
 	}
 
-	var allHandledResources []string
 	for _, crd := range crdList.Items {
 		if shouldSkip(crd, r.SkipCRDs) {
 			continue
 		}
 
+		decision := policy.Decide(crd.Spec.Group)
+		if decision.Action == v1beta2.PurgeActionSkip {
+			continue
+		}
+		if requeueAfter := calculateRequeueAfterTime(kyma, decision.Timeout); requeueAfter != 0 {
+			if result.requeueAfter == 0 || requeueAfter < result.requeueAfter {
+				result.requeueAfter = requeueAfter
+			}
+			continue
+		}
+
 		staleResources, err := getAllRemainingCRs(ctx, remoteClient, crd)
 		if err != nil {
-			return allHandledResources, fmt.Errorf("failed fetching stale resources from remote cluster: %w", err)
+			return result, fmt.Errorf("failed fetching stale resources from remote cluster: %w", err)
+		}
+		if len(staleResources.Items) == 0 {
+			continue
 		}
 
-		handledResources, err := dropFinalizers(ctx, remoteClient, staleResources)
+		handledResources, err := purgeResources(ctx, remoteClient, staleResources, decision.Action, policy.DryRun())
+		r.recordDecision(kyma, crd, decision.Action, policy.DryRun(), handledResources)
+		result.handledResources = append(result.handledResources, handledResources...)
 		if err != nil {
-			return allHandledResources, fmt.Errorf("failed removing finalizers from stale resources: %w", err)
+			return result, fmt.Errorf("failed purging stale resources: %w", err)
+		}
+		if decision.Action == v1beta2.PurgeActionDelete && !policy.DryRun() {
+			result.awaitingDeletion = true
 		}
+	}
 
-		allHandledResources = append(allHandledResources, handledResources...)
+	return result, nil
+}
+
+func (r *Reconciler) recordDecision(kyma *v1beta2.Kyma, crd apiextensionsv1.CustomResourceDefinition,
+	action v1beta2.PurgeAction, dryRun bool, handledResources []string,
+) {
+	if len(handledResources) == 0 {
+		return
+	}
+	r.Metrics.RecordPurgeDecision(crd.Spec.Group, action, dryRun, len(handledResources))
+
+	reason := finalizersPurged
+	message := fmt.Sprintf("removed finalizers of %d %s", len(handledResources), crd.Name)
+	switch {
+	case dryRun:
+		reason = purgeDryRun
+		message = fmt.Sprintf("dry run: would %s %d %s", dryRunVerb(action), len(handledResources), crd.Name)
+	case action == v1beta2.PurgeActionDelete:
+		reason = resourcesPurged
+		message = fmt.Sprintf("deleted %d %s", len(handledResources), crd.Name)
 	}
+	r.Normal(kyma, reason, message)
+}
 
-	return allHandledResources, nil
+func dryRunVerb(action v1beta2.PurgeAction) string {
+	if action == v1beta2.PurgeActionDelete {
+		return "delete"
+	}
+	return "remove finalizers of"
 }
 
 func shouldSkip(crd apiextensionsv1.CustomResourceDefinition, matcher matcher.CRDMatcherFunc) bool {
@@ -286,17 +385,43 @@ func getAllRemainingCRs(ctx context.Context, remoteClient client.Client,
 	return staleResources, nil
 }
 
-func dropFinalizers(ctx context.Context, remoteClient client.Client,
-	staleResources unstructured.UnstructuredList,
+// purgeResources takes the action on the stale resources and returns the handled resources.
+// In a dry run, the resources that would be handled are returned without changing them.
+// Resources already being deleted are not deleted again.
+func purgeResources(ctx context.Context, remoteClient client.Client,
+	staleResources unstructured.UnstructuredList, action v1beta2.PurgeAction, dryRun bool,
 ) ([]string, error) {
 	handledResources := make([]string, 0, len(staleResources.Items))
 	for index := range staleResources.Items {
 		resource := staleResources.Items[index]
-		resource.SetFinalizers(nil)
-		if err := remoteClient.Update(ctx, &resource); err != nil {
-			return handledResources, fmt.Errorf("failed updating resource: %w", err)
+		if action == v1beta2.PurgeActionDelete && !resource.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if !dryRun {
+			if err := purgeResource(ctx, remoteClient, &resource, action); err != nil {
+				return handledResources, err
+			}
 		}
 		handledResources = append(handledResources, fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName()))
 	}
 	return handledResources, nil
 }
+
+func purgeResource(ctx context.Context, remoteClient client.Client, resource *unstructured.Unstructured,
+	action v1beta2.PurgeAction,
+) error {
+	switch action {
+	case v1beta2.PurgeActionDelete:
+		err := remoteClient.Delete(ctx, resource, client.PropagationPolicy(apimetav1.DeletePropagationBackground))
+		if err != nil && !util.IsNotFound(err) {
+			return fmt.Errorf("failed deleting resource: %w", err)
+		}
+	case v1beta2.PurgeActionSkip:
+	case v1beta2.PurgeActionRemoveFinalizers:
+		resource.SetFinalizers(nil)
+		if err := remoteClient.Update(ctx, resource); err != nil {
+			return fmt.Errorf("failed updating resource: %w", err)
+		}
+	}
+	return nil
+}
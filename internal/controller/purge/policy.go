@@ -0,0 +1,80 @@
+package purge
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// Policy decides per CRD group when and how the resources remaining in a Kyma runtime are purged.
+// Groups that no rule matches have the finalizers of their resources removed after the default timeout.
+type Policy struct {
+	dryRun         bool
+	rules          []v1beta2.PurgePolicyRule
+	defaultTimeout time.Duration
+}
+
+// Decision is the action taken on the resources of a CRD once the timeout after the Kyma deletion passed.
+type Decision struct {
+	Action  v1beta2.PurgeAction
+	Timeout time.Duration
+}
+
+// NewPolicy creates a Policy from the spec of a PurgePolicy. A nil spec results in the default policy.
+func NewPolicy(spec *v1beta2.PurgePolicySpec, defaultTimeout time.Duration) Policy {
+	policy := Policy{defaultTimeout: defaultTimeout}
+	if spec != nil {
+		policy.dryRun = spec.DryRun
+		policy.rules = spec.Rules
+	}
+	return policy
+}
+
+// DryRun reports whether the decisions are only reported without changing the resources.
+func (p Policy) DryRun() bool {
+	return p.dryRun
+}
+
+// Decide returns the decision of the first rule matching the group, or the default decision.
+func (p Policy) Decide(group string) Decision {
+	for _, rule := range p.rules {
+		if !matchesGroup(rule.Group, group) {
+			continue
+		}
+		decision := Decision{Action: rule.Action, Timeout: p.defaultTimeout}
+		if decision.Action == "" {
+			decision.Action = v1beta2.PurgeActionRemoveFinalizers
+		}
+		if rule.Timeout != nil {
+			decision.Timeout = rule.Timeout.Duration
+		}
+		return decision
+	}
+	return Decision{Action: v1beta2.PurgeActionRemoveFinalizers, Timeout: p.defaultTimeout}
+}
+
+// EarliestTimeout returns the shortest timeout after which any resources are purged.
+func (p Policy) EarliestTimeout() time.Duration {
+	earliest := p.defaultTimeout
+	for _, rule := range p.rules {
+		if rule.Action == v1beta2.PurgeActionSkip || rule.Timeout == nil {
+			continue
+		}
+		earliest = min(earliest, rule.Timeout.Duration)
+	}
+	return earliest
+}
+
+func matchesGroup(pattern, group string) bool {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	group = strings.ToLower(group)
+	switch {
+	case pattern == "*":
+		return true
+	case strings.HasPrefix(pattern, "*."):
+		return strings.HasSuffix(group, pattern[1:])
+	default:
+		return pattern == group
+	}
+}
@@ -0,0 +1,89 @@
+package purge_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/controller/purge"
+)
+
+const defaultTimeout = 5 * time.Minute
+
+func TestPolicy_Decide(t *testing.T) {
+	policy := purge.NewPolicy(&v1beta2.PurgePolicySpec{
+		Rules: []v1beta2.PurgePolicyRule{
+			{Group: "cert-manager.io", Action: v1beta2.PurgeActionDelete, Timeout: duration(time.Minute)},
+			{Group: "*.istio.io", Action: v1beta2.PurgeActionSkip},
+			{Group: "*.kyma-project.io", Timeout: duration(time.Hour)},
+			{Group: "*", Action: v1beta2.PurgeActionDelete},
+		},
+	}, defaultTimeout)
+
+	tests := []struct {
+		name     string
+		group    string
+		expected purge.Decision
+	}{
+		{
+			name:     "exact group",
+			group:    "cert-manager.io",
+			expected: purge.Decision{Action: v1beta2.PurgeActionDelete, Timeout: time.Minute},
+		},
+		{
+			name:     "subgroup wildcard",
+			group:    "networking.istio.io",
+			expected: purge.Decision{Action: v1beta2.PurgeActionSkip, Timeout: defaultTimeout},
+		},
+		{
+			name:     "rule without action removes finalizers",
+			group:    "operator.kyma-project.io",
+			expected: purge.Decision{Action: v1beta2.PurgeActionRemoveFinalizers, Timeout: time.Hour},
+		},
+		{
+			name:     "catch-all rule",
+			group:    "example.com",
+			expected: purge.Decision{Action: v1beta2.PurgeActionDelete, Timeout: defaultTimeout},
+		},
+		{
+			name:     "subgroup wildcard does not match the group itself",
+			group:    "istio.io",
+			expected: purge.Decision{Action: v1beta2.PurgeActionDelete, Timeout: defaultTimeout},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, policy.Decide(testCase.group))
+		})
+	}
+}
+
+func TestPolicy_WithoutSpec_RemovesFinalizersAfterDefaultTimeout(t *testing.T) {
+	policy := purge.NewPolicy(nil, defaultTimeout)
+
+	assert.False(t, policy.DryRun())
+	assert.Equal(t, purge.Decision{Action: v1beta2.PurgeActionRemoveFinalizers, Timeout: defaultTimeout},
+		policy.Decide("cert-manager.io"))
+	assert.Equal(t, defaultTimeout, policy.EarliestTimeout())
+}
+
+func TestPolicy_EarliestTimeout_IgnoresSkippedGroups(t *testing.T) {
+	policy := purge.NewPolicy(&v1beta2.PurgePolicySpec{
+		DryRun: true,
+		Rules: []v1beta2.PurgePolicyRule{
+			{Group: "*.istio.io", Action: v1beta2.PurgeActionSkip, Timeout: duration(time.Second)},
+			{Group: "cert-manager.io", Timeout: duration(time.Minute)},
+			{Group: "*.kyma-project.io", Timeout: duration(time.Hour)},
+		},
+	}, defaultTimeout)
+
+	assert.True(t, policy.DryRun())
+	assert.Equal(t, time.Minute, policy.EarliestTimeout())
+}
+
+func duration(d time.Duration) *apimetav1.Duration {
+	return &apimetav1.Duration{Duration: d}
+}
@@ -34,7 +34,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
 	"github.com/kyma-project/lifecycle-manager/internal/event"
-	"github.com/kyma-project/lifecycle-manager/internal/istio"
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 	"github.com/kyma-project/lifecycle-manager/pkg/status"
@@ -51,9 +51,15 @@ const (
 var (
 	errFinalizerRemove = errors.New("error removing finalizer")
 	errFinalizerAdd    = errors.New("error adding finalizer")
-	errGateway         = errors.New("gateway for the VirtualService not found")
 )
 
+// RoutingBackend configures the route in the KCP that forwards the events of the runtime watcher to the
+// manager of the Watcher.
+type RoutingBackend interface {
+	ConfigureRoute(ctx context.Context, watcher *v1beta2.Watcher) error
+	DeleteRoute(ctx context.Context, watcher *v1beta2.Watcher) error
+}
+
 type Reconciler struct {
 	client.Client
 	event.Event
@@ -61,8 +67,8 @@ type Reconciler struct {
 
 	RateLimiter workqueue.TypedRateLimiter[ctrl.Request]
 
-	IstioClient           *istio.Client
-	VirtualServiceFactory istio.VirtualServiceFactory
+	RoutingBackend        RoutingBackend
+	Routing               routing.Backend
 	RestConfig            *rest.Config
 	Scheme                *machineryruntime.Scheme
 	IstioGatewayNamespace string
@@ -134,10 +140,8 @@ func (r *Reconciler) stateHandling(
 func (r *Reconciler) handleDeletingState(
 	ctx context.Context, req ctrl.Request, watcher *v1beta2.Watcher,
 ) (ctrl.Result, error) {
-	err := r.IstioClient.DeleteVirtualService(ctx, watcher.GetName(), watcher.GetNamespace())
-	if err != nil && !util.IsNotFound(err) {
-		vsConfigDelErr := fmt.Errorf("failed to delete virtual service (config): %w", err)
-		return r.updateWatcherState(ctx, watcher, shared.StateError, vsConfigDelErr)
+	if err := r.RoutingBackend.DeleteRoute(ctx, watcher); err != nil {
+		return r.updateWatcherState(ctx, watcher, shared.StateError, err)
 	}
 	finalizerRemoved := controllerutil.RemoveFinalizer(watcher, shared.WatcherFinalizer)
 	if !finalizerRemoved {
@@ -150,35 +154,11 @@ func (r *Reconciler) handleDeletingState(
 }
 
 func (r *Reconciler) handleProcessingState(ctx context.Context, watcherCR *v1beta2.Watcher) (ctrl.Result, error) {
-	gateways, err := r.IstioClient.ListGatewaysByLabelSelector(ctx, &watcherCR.Spec.Gateway.LabelSelector,
-		r.IstioGatewayNamespace)
-	if err != nil || len(gateways.Items) == 0 {
-		r.Event.Warning(watcherCR, gatewayNotFoundFailure, errGateway)
-		return r.updateWatcherState(ctx, watcherCR, shared.StateError, err)
-	}
-
-	virtualSvc, err := r.VirtualServiceFactory.NewVirtualService(watcherCR, gateways)
-	if err != nil {
-		return r.updateWatcherState(ctx, watcherCR, shared.StateError, err)
-	}
-
-	virtualSvcRemote, err := r.IstioClient.GetVirtualService(ctx, watcherCR.GetName(), watcherCR.GetNamespace())
-	if client.IgnoreNotFound(err) != nil {
-		return r.updateWatcherState(ctx, watcherCR, shared.StateError, err)
-	}
-	if util.IsNotFound(err) {
-		err = r.IstioClient.CreateVirtualService(ctx, virtualSvc)
-		if err != nil {
-			vsCreateErr := fmt.Errorf("failed to create virtual service: %w", err)
-			return r.updateWatcherState(ctx, watcherCR, shared.StateError, vsCreateErr)
+	if err := r.RoutingBackend.ConfigureRoute(ctx, watcherCR); err != nil {
+		if errors.Is(err, routing.ErrGatewayNotFound) {
+			r.Event.Warning(watcherCR, gatewayNotFoundFailure, err)
 		}
-		return r.updateWatcherState(ctx, watcherCR, shared.StateReady, nil)
-	}
-
-	err = r.IstioClient.UpdateVirtualService(ctx, virtualSvc, virtualSvcRemote)
-	if err != nil {
-		vsUpdateErr := fmt.Errorf("failed to update virtual service: %w", err)
-		return r.updateWatcherState(ctx, watcherCR, shared.StateError, vsUpdateErr)
+		return r.updateWatcherState(ctx, watcherCR, shared.StateError, err)
 	}
 	return r.updateWatcherState(ctx, watcherCR, shared.StateReady, nil)
 }
@@ -189,9 +169,9 @@ func (r *Reconciler) updateWatcherState(ctx context.Context, watcher *v1beta2.Wa
 	watcher.Status.State = state
 	switch state {
 	case shared.StateReady:
-		watcher.UpdateWatcherConditionStatus(v1beta2.WatcherConditionTypeVirtualService, apimetav1.ConditionTrue)
+		watcher.UpdateWatcherConditionStatus(v1beta2.WatcherConditionTypeRoute, apimetav1.ConditionTrue)
 	case shared.StateError:
-		watcher.UpdateWatcherConditionStatus(v1beta2.WatcherConditionTypeVirtualService, apimetav1.ConditionFalse)
+		watcher.UpdateWatcherConditionStatus(v1beta2.WatcherConditionTypeRoute, apimetav1.ConditionFalse)
 	case shared.StateWarning:
 	case shared.StateProcessing:
 	case shared.StateDeleting:
@@ -5,33 +5,35 @@ import (
 	"fmt"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntime "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/gatewayapi"
 	"github.com/kyma-project/lifecycle-manager/internal/istio"
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
 )
 
 const controllerName = "watcher"
 
-var errRestConfigIsNotSet = errors.New("reconciler rest config is not set")
+var (
+	errRestConfigIsNotSet    = errors.New("reconciler rest config is not set")
+	errUnknownRoutingBackend = errors.New("unknown routing backend")
+)
 
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options ctrlruntime.Options) error {
 	if r.RestConfig == nil {
 		return errRestConfigIsNotSet
 	}
-	var err error
-	r.IstioClient, err = istio.NewIstioClient(r.RestConfig, ctrl.Log.WithName("istioClient"))
-	if err != nil {
-		return fmt.Errorf("unable to set istio client for watcher controller: %w", err)
-	}
-
-	r.VirtualServiceFactory, err = istio.NewVirtualServiceService(r.Scheme)
-	if err != nil {
-		return fmt.Errorf("unable to set VirtualService service for watcher controller: %w", err)
+	if r.RoutingBackend == nil {
+		var err error
+		if r.RoutingBackend, err = r.newRoutingBackend(); err != nil {
+			return err
+		}
 	}
 
-	if err = ctrl.NewControllerManagedBy(mgr).
+	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta2.Watcher{}).
 		Named(controllerName).
 		WithOptions(options).
@@ -42,3 +44,33 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options ctrlruntime.Opti
 
 	return nil
 }
+
+// newRoutingBackend creates the routing backend selected by Routing, which defaults to Istio.
+//
+//nolint:ireturn // the backend is selected at runtime
+func (r *Reconciler) newRoutingBackend() (RoutingBackend, error) {
+	switch r.Routing {
+	case routing.BackendIstio, "":
+		istioClient, err := istio.NewIstioClient(r.RestConfig, ctrl.Log.WithName("istioClient"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to set istio client for watcher controller: %w", err)
+		}
+		virtualServiceFactory, err := istio.NewVirtualServiceService(r.Scheme)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set VirtualService service for watcher controller: %w", err)
+		}
+		return istio.NewRoutingBackend(istioClient, virtualServiceFactory, r.IstioGatewayNamespace), nil
+	case routing.BackendGatewayAPI:
+		clnt, err := client.New(r.RestConfig, client.Options{Scheme: r.Scheme})
+		if err != nil {
+			return nil, fmt.Errorf("unable to set Gateway API client for watcher controller: %w", err)
+		}
+		backend, err := gatewayapi.NewRoutingBackend(clnt, r.Scheme, r.IstioGatewayNamespace)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set Gateway API routing for watcher controller: %w", err)
+		}
+		return backend, nil
+	default:
+		return nil, fmt.Errorf("%w: '%s'", errUnknownRoutingBackend, r.Routing)
+	}
+}
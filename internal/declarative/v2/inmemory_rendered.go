@@ -1,55 +1,151 @@
 package v2
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"path/filepath"
+	"runtime/debug"
+	runtimemetrics "runtime/metrics"
 	"time"
 
 	"github.com/jellydator/ttlcache/v3"
+	"golang.org/x/sync/singleflight"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kyma-project/lifecycle-manager/internal"
 )
 
-const ManifestFilePrefix = "manifest"
+const (
+	ManifestFilePrefix = "manifest"
+
+	CacheEvictionReasonExpired        = "expired"
+	CacheEvictionReasonCapacity       = "capacity"
+	CacheEvictionReasonMemoryPressure = "memory_pressure"
+	CacheEvictionReasonInvalidated    = "invalidated"
+
+	// memoryPressureThreshold is the share of the Go memory limit (GOMEMLIMIT) the heap objects may take up
+	// before cached manifests are freed.
+	memoryPressureThreshold = 0.8
+	heapObjectsMetric       = "/memory/classes/heap/objects:bytes"
+)
 
 type CachedManifestParser interface {
 	Parse(spec *Spec) (internal.ManifestResources, error)
 	EvictCache(spec *Spec)
 }
 
+type ManifestCacheMetrics interface {
+	RecordHit()
+	RecordMiss()
+	RecordEviction(reason string)
+	SetEntries(entries int)
+}
+
+// InMemoryCachedManifestParser caches the parsed resources of raw manifests by the digest of their layer,
+// so that all Manifests of the same module version share one parsed copy. The cache holds up to its capacity
+// of layers and evicts the least recently used layer first. If the heap comes close to the Go memory limit,
+// the least recently used half of the layers is freed. Parse returns copies of the cached resources, so
+// that transforms specific to a Manifest never modify the cache.
 type InMemoryCachedManifestParser struct {
 	*ttlcache.Cache[string, internal.ManifestResources]
 
 	TTL time.Duration
+
+	capacity            uint64
+	metrics             ManifestCacheMetrics
+	underMemoryPressure func() bool
+	parseGroup          singleflight.Group
+}
+
+func NewInMemoryCachedManifestParser(ttl time.Duration,
+	opts ...func(*InMemoryCachedManifestParser) *InMemoryCachedManifestParser,
+) *InMemoryCachedManifestParser {
+	parser := &InMemoryCachedManifestParser{
+		TTL:                 ttl,
+		capacity:            DefaultInMemoryParseCapacity,
+		metrics:             noopManifestCacheMetrics{},
+		underMemoryPressure: underMemoryPressure,
+	}
+	for _, opt := range opts {
+		parser = opt(parser)
+	}
+
+	parser.Cache = ttlcache.New(ttlcache.WithCapacity[string, internal.ManifestResources](parser.capacity))
+	parser.OnEviction(func(_ context.Context, reason ttlcache.EvictionReason,
+		_ *ttlcache.Item[string, internal.ManifestResources],
+	) {
+		switch reason {
+		case ttlcache.EvictionReasonExpired:
+			parser.metrics.RecordEviction(CacheEvictionReasonExpired)
+		case ttlcache.EvictionReasonCapacityReached:
+			parser.metrics.RecordEviction(CacheEvictionReasonCapacity)
+		case ttlcache.EvictionReasonDeleted, ttlcache.EvictionReasonMaxCostExceeded:
+		}
+		parser.metrics.SetEntries(parser.Len())
+	})
+	parser.OnInsertion(func(_ context.Context, _ *ttlcache.Item[string, internal.ManifestResources]) {
+		parser.metrics.SetEntries(parser.Len())
+	})
+	go parser.Start()
+	return parser
+}
+
+// WithCacheCapacity sets the maximum number of layers whose parsed resources are cached.
+func WithCacheCapacity(capacity uint64) func(*InMemoryCachedManifestParser) *InMemoryCachedManifestParser {
+	return func(p *InMemoryCachedManifestParser) *InMemoryCachedManifestParser {
+		p.capacity = capacity
+		return p
+	}
+}
+
+// WithCacheMetrics records the hits, misses, and evictions of the cache.
+func WithCacheMetrics(metrics ManifestCacheMetrics) func(*InMemoryCachedManifestParser) *InMemoryCachedManifestParser {
+	return func(p *InMemoryCachedManifestParser) *InMemoryCachedManifestParser {
+		p.metrics = metrics
+		return p
+	}
 }
 
-func NewInMemoryCachedManifestParser(ttl time.Duration) *InMemoryCachedManifestParser {
-	cache := ttlcache.New[string, internal.ManifestResources]()
-	go cache.Start()
-	return &InMemoryCachedManifestParser{Cache: cache, TTL: ttl}
+// WithMemoryPressureFunction is a low level primitive that replaces the default detection of memory pressure.
+func WithMemoryPressureFunction(f func() bool) func(*InMemoryCachedManifestParser) *InMemoryCachedManifestParser {
+	return func(p *InMemoryCachedManifestParser) *InMemoryCachedManifestParser {
+		p.underMemoryPressure = f
+		return p
+	}
 }
 
 func (c *InMemoryCachedManifestParser) EvictCache(spec *Spec) {
 	key := generateCacheKey(spec)
-	c.Delete(key)
+	if c.Has(key) {
+		c.Delete(key)
+		c.metrics.RecordEviction(CacheEvictionReasonInvalidated)
+	}
 }
 
 func (c *InMemoryCachedManifestParser) Parse(spec *Spec,
 ) (internal.ManifestResources, error) {
 	key := generateCacheKey(spec)
 
-	var err error
-	item := c.Get(key)
 	var resources internal.ManifestResources
-	if item != nil {
+	if item := c.Get(key); item != nil {
+		c.metrics.RecordHit()
 		resources = item.Value()
 	} else {
-		resources, err = internal.ParseManifestToObjects(spec.Path)
+		c.metrics.RecordMiss()
+		parsed, err, _ := c.parseGroup.Do(key, func() (any, error) {
+			resources, err := internal.ParseManifestToObjects(spec.Path)
+			if err != nil {
+				return nil, err
+			}
+			c.freeMemoryUnderPressure()
+			c.Set(key, resources, c.TTL)
+			return resources, nil
+		})
 		if err != nil {
 			return internal.ManifestResources{}, fmt.Errorf("failed to parse manifest objects: %w", err)
 		}
-		c.Set(key, resources, c.TTL)
+		resources, _ = parsed.(internal.ManifestResources)
 	}
 	copied := &internal.ManifestResources{
 		Items: make([]*unstructured.Unstructured, 0, len(resources.Items)),
@@ -60,6 +156,52 @@ func (c *InMemoryCachedManifestParser) Parse(spec *Spec,
 	return *copied, nil
 }
 
+// freeMemoryUnderPressure evicts the least recently used half of the cached layers if memory is under pressure.
+func (c *InMemoryCachedManifestParser) freeMemoryUnderPressure() {
+	if c.Len() == 0 || !c.underMemoryPressure() {
+		return
+	}
+
+	toEvict := (c.Len() + 1) / 2
+	keys := make([]string, 0, toEvict)
+	c.RangeBackwards(func(item *ttlcache.Item[string, internal.ManifestResources]) bool {
+		keys = append(keys, item.Key())
+		return len(keys) < toEvict
+	})
+	for _, key := range keys {
+		c.Delete(key)
+		c.metrics.RecordEviction(CacheEvictionReasonMemoryPressure)
+	}
+	debug.FreeOSMemory()
+}
+
+// generateCacheKey returns the digest of the layer, which identifies its content. Specs without digest
+// are cached by the path of the extracted raw manifest.
 func generateCacheKey(spec *Spec) string {
+	if spec.OCIRef != "" {
+		return spec.OCIRef
+	}
 	return filepath.Join(ManifestFilePrefix, spec.Path, spec.ManifestName)
 }
+
+// underMemoryPressure reports whether the heap objects take up more than the memoryPressureThreshold of the
+// Go memory limit. Without memory limit, memory is never under pressure.
+func underMemoryPressure() bool {
+	limit := debug.SetMemoryLimit(-1)
+	if limit == math.MaxInt64 {
+		return false
+	}
+	sample := []runtimemetrics.Sample{{Name: heapObjectsMetric}}
+	runtimemetrics.Read(sample)
+	if sample[0].Value.Kind() != runtimemetrics.KindUint64 {
+		return false
+	}
+	return float64(sample[0].Value.Uint64()) > memoryPressureThreshold*float64(limit)
+}
+
+type noopManifestCacheMetrics struct{}
+
+func (noopManifestCacheMetrics) RecordHit()            {}
+func (noopManifestCacheMetrics) RecordMiss()           {}
+func (noopManifestCacheMetrics) RecordEviction(string) {}
+func (noopManifestCacheMetrics) SetEntries(int)        {}
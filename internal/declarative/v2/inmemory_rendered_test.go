@@ -0,0 +1,176 @@
+package v2_test
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	declarativev2 "github.com/kyma-project/lifecycle-manager/internal/declarative/v2"
+)
+
+const rawManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: template-operator-config
+  namespace: kyma-system
+data:
+  key: value
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: template-operator
+  namespace: kyma-system
+`
+
+func TestParse_SharesParsedResourcesOfSameLayer(t *testing.T) {
+	metrics := &cacheMetricsStub{}
+	parser := declarativev2.NewInMemoryCachedManifestParser(time.Hour, declarativev2.WithCacheMetrics(metrics))
+	path := writeRawManifest(t)
+
+	first, err := parser.Parse(&declarativev2.Spec{ManifestName: "kyma-1", Path: path, OCIRef: "sha256:1"})
+	require.NoError(t, err)
+	second, err := parser.Parse(&declarativev2.Spec{ManifestName: "kyma-2", Path: "removed", OCIRef: "sha256:1"})
+
+	require.NoError(t, err)
+	require.Len(t, second.Items, 2)
+	assert.Equal(t, first.Items, second.Items)
+	assert.Equal(t, 1, metrics.getMisses())
+	assert.Equal(t, 1, metrics.getHits())
+	assert.Equal(t, 1, parser.Len())
+}
+
+func TestParse_ReturnsCopiesOfCachedResources(t *testing.T) {
+	parser := declarativev2.NewInMemoryCachedManifestParser(time.Hour)
+	spec := &declarativev2.Spec{Path: writeRawManifest(t), OCIRef: "sha256:1"}
+
+	first, err := parser.Parse(spec)
+	require.NoError(t, err)
+	first.Items[0].SetNamespace("transformed")
+	second, err := parser.Parse(spec)
+
+	require.NoError(t, err)
+	assert.Equal(t, "kyma-system", second.Items[0].GetNamespace())
+}
+
+func TestParse_WhenCapacityReached_EvictsLeastRecentlyUsedLayer(t *testing.T) {
+	metrics := &cacheMetricsStub{}
+	parser := declarativev2.NewInMemoryCachedManifestParser(time.Hour,
+		declarativev2.WithCacheCapacity(2), declarativev2.WithCacheMetrics(metrics))
+	path := writeRawManifest(t)
+
+	for _, digest := range []string{"sha256:1", "sha256:2", "sha256:1", "sha256:3"} {
+		_, err := parser.Parse(&declarativev2.Spec{Path: path, OCIRef: digest})
+		require.NoError(t, err)
+	}
+
+	assert.True(t, parser.Has("sha256:1"))
+	assert.False(t, parser.Has("sha256:2"))
+	assert.True(t, parser.Has("sha256:3"))
+	assert.Eventually(t, func() bool {
+		return metrics.getEvictions(declarativev2.CacheEvictionReasonCapacity) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestParse_WhenUnderMemoryPressure_FreesLeastRecentlyUsedHalf(t *testing.T) {
+	metrics := &cacheMetricsStub{}
+	underPressure := false
+	parser := declarativev2.NewInMemoryCachedManifestParser(time.Hour, declarativev2.WithCacheMetrics(metrics),
+		declarativev2.WithMemoryPressureFunction(func() bool { return underPressure }))
+	path := writeRawManifest(t)
+	for _, digest := range []string{"sha256:1", "sha256:2", "sha256:3", "sha256:4"} {
+		_, err := parser.Parse(&declarativev2.Spec{Path: path, OCIRef: digest})
+		require.NoError(t, err)
+	}
+
+	underPressure = true
+	_, err := parser.Parse(&declarativev2.Spec{Path: path, OCIRef: "sha256:5"})
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"sha256:3", "sha256:4", "sha256:5"}, parser.Keys())
+	assert.Equal(t, 2, metrics.getEvictions(declarativev2.CacheEvictionReasonMemoryPressure))
+}
+
+func TestEvictCache_RemovesLayer(t *testing.T) {
+	metrics := &cacheMetricsStub{}
+	parser := declarativev2.NewInMemoryCachedManifestParser(time.Hour, declarativev2.WithCacheMetrics(metrics))
+	spec := &declarativev2.Spec{Path: writeRawManifest(t), OCIRef: "sha256:1"}
+	_, err := parser.Parse(spec)
+	require.NoError(t, err)
+
+	parser.EvictCache(spec)
+
+	assert.Equal(t, 0, parser.Len())
+	assert.Equal(t, 1, metrics.getEvictions(declarativev2.CacheEvictionReasonInvalidated))
+}
+
+func TestParse_WhenManifestInvalid_ReturnsError(t *testing.T) {
+	parser := declarativev2.NewInMemoryCachedManifestParser(time.Hour)
+	path := filepath.Join(t.TempDir(), "raw-manifest.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("kind: [invalid"), 0o600))
+
+	_, err := parser.Parse(&declarativev2.Spec{Path: path, OCIRef: "sha256:1"})
+
+	require.ErrorContains(t, err, "failed to parse manifest objects")
+	assert.Equal(t, 0, parser.Len())
+}
+
+func writeRawManifest(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "raw-manifest.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(rawManifest), 0o600))
+	return path
+}
+
+type cacheMetricsStub struct {
+	mu        sync.Mutex
+	hits      int
+	misses    int
+	evictions map[string]int
+}
+
+func (m *cacheMetricsStub) RecordHit() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hits++
+}
+
+func (m *cacheMetricsStub) RecordMiss() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.misses++
+}
+
+func (m *cacheMetricsStub) RecordEviction(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.evictions == nil {
+		m.evictions = map[string]int{}
+	}
+	m.evictions[reason]++
+}
+
+func (m *cacheMetricsStub) SetEntries(_ int) {}
+
+func (m *cacheMetricsStub) getHits() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.hits
+}
+
+func (m *cacheMetricsStub) getMisses() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.misses
+}
+
+func (m *cacheMetricsStub) getEvictions(reason string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.evictions[reason]
+}
@@ -2,6 +2,9 @@ package v2
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -18,11 +21,16 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	"github.com/kyma-project/lifecycle-manager/internal/event"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/finalizer"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/labelsremoval"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/status"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/templating"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/upgradehook"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/resources"
 	"github.com/kyma-project/lifecycle-manager/internal/service/accessmanager"
@@ -42,10 +50,20 @@ var (
 )
 
 const (
-	DefaultInMemoryParseTTL = 24 * time.Hour
+	DefaultInMemoryParseTTL      = 24 * time.Hour
+	DefaultInMemoryParseCapacity = 200
 
 	namespaceNotBeRemoved  = "kyma-system"
 	SyncedOCIRefAnnotation = "sync-oci-ref"
+	// SyncedTemplateValuesAnnotation holds the hash of the values the synced resources of a templated manifest
+	// were rendered with.
+	SyncedTemplateValuesAnnotation = "sync-template-values"
+	// CompletedPreUpgradeHookAnnotation holds the OCI ref of the installation layer the pre-upgrade hook completed for.
+	CompletedPreUpgradeHookAnnotation = "completed-pre-upgrade-hook"
+	// SyncedModuleConfigAnnotation holds the hash of the ResourceOverride that was last applied to the default CR.
+	SyncedModuleConfigAnnotation = "sync-module-config"
+
+	waitingForModuleCRsDeletion = "waiting for module crs deletion"
 )
 
 type ManagedByLabelRemoval interface {
@@ -95,6 +113,9 @@ type Reconciler struct {
 	skrClientCache             SKRClientCache
 	skrClient                  SKRClient
 	resourceTransforms         []ResourceTransform
+	driftDetectionMode         skrresources.DriftDetectionMode
+	driftCheckCache            *skrresources.DriftCheckCache
+	event                      event.Event
 }
 
 func NewReconciler(requeueIntervals queue.RequeueIntervals,
@@ -110,6 +131,9 @@ func NewReconciler(requeueIntervals queue.RequeueIntervals,
 	cachedManifestParser CachedManifestParser,
 	stateCheck StateCheck,
 	skrImagePullSecretName string,
+	driftDetectionMode skrresources.DriftDetectionMode,
+	driftDetectionInterval time.Duration,
+	event event.Event,
 ) *Reconciler {
 	reconciler := &Reconciler{}
 	reconciler.manifestMetrics = metrics
@@ -133,6 +157,9 @@ func NewReconciler(requeueIntervals queue.RequeueIntervals,
 	reconciler.cachedManifestParser = cachedManifestParser
 
 	reconciler.customStateCheck = stateCheck
+	reconciler.driftDetectionMode = driftDetectionMode
+	reconciler.driftCheckCache = skrresources.NewDriftCheckCache(driftDetectionInterval)
+	reconciler.event = event
 	return reconciler
 }
 
@@ -227,10 +254,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.finishReconcile(ctx, manifest, metrics.ManifestRenderResources, manifestStatus, err)
 	}
 
-	if manifestUnderDeletingButNoSyncedResources(manifest, current) {
+	if manifestUnderDeletingButNoSyncedResources(manifest) {
 		r.evictSKRClientCache(ctx, manifest)
 	}
 
+	// A new installation layer is rolled out, so the upgrade hooks run around the sync of its resources.
+	upgrading := manifest.GetDeletionTimestamp().IsZero() && requireUpdateSyncedOCIRefAnnotation(manifest, spec.OCIRef)
+	if upgrading {
+		if completed, err := r.runPreUpgradeHook(ctx, skrClient, manifest, spec.OCIRef); err != nil {
+			return r.finishReconcile(ctx, manifest, metrics.ManifestUpgradeHook, manifestStatus, err)
+		} else if !completed {
+			return r.finishReconcile(ctx, manifest, metrics.ManifestUpgradeHookRunning, manifestStatus, nil)
+		}
+		if requireUpdateCompletedPreUpgradeHookAnnotation(manifest, spec.OCIRef) {
+			updateCompletedPreUpgradeHookAnnotation(manifest, spec.OCIRef)
+			return r.updateManifest(ctx, req, manifest, metrics.ManifestUpdateCompletedPreUpgradeHook)
+		}
+	}
+
 	if err := r.pruneDiff(ctx, skrClient, manifest, current, target, spec); errors.Is(err,
 		resources.ErrDeletionNotFinished) {
 		r.manifestMetrics.RecordRequeueReason(metrics.ManifestPruneDiffNotFinished, queue.IntendedRequeue)
@@ -249,11 +290,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	if err := skrresources.SyncResources(ctx, skrClient, manifest, target); err != nil {
+	excluded := r.detectDrift(ctx, skrClient, manifest, target)
+	if err := skrresources.SyncResources(ctx, skrClient, manifest, target, excluded); err != nil {
 		return r.finishReconcile(ctx, manifest, metrics.ManifestSyncResources, manifestStatus, err)
 	}
 
-	if err := r.syncManifestState(ctx, skrClient, manifest, target); err != nil {
+	if err := r.syncManifestState(ctx, skrClient, manifest, target, upgrading); err != nil {
 		if errors.Is(err, finalizer.ErrRequeueRequired) {
 			r.manifestMetrics.RecordRequeueReason(metrics.ManifestSyncResourcesEnqueueRequired, queue.IntendedRequeue)
 			return ctrl.Result{RequeueAfter: r.rateLimiter.When(req)}, nil
@@ -264,10 +306,30 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// This situation happens when manifest get new installation layer to update resources,
 	// we need to make sure all updates successfully before we can update synced oci ref
 	if requireUpdateSyncedOCIRefAnnotation(manifest, spec.OCIRef) {
+		if upgrading && awaitsPostUpgradeHook(manifest) {
+			return r.finishReconcile(ctx, manifest, metrics.ManifestUpgradeHookRunning, manifestStatus, nil)
+		}
 		updateSyncedOCIRefAnnotation(manifest, spec.OCIRef)
 		return r.updateManifest(ctx, req, manifest, metrics.ManifestUpdateSyncedOCIRef)
 	}
 
+	// The rendered resources of a templated manifest change with its values, even if the OCI ref stays the same.
+	if requireUpdateSyncedTemplateValuesAnnotation(manifest) {
+		updateSyncedTemplateValuesAnnotation(manifest)
+		return r.updateManifest(ctx, req, manifest, metrics.ManifestUpdateSyncedTemplateValues)
+	}
+
+	// The configuration of the default CR is only applied when it changed, so that the default CR can be changed
+	// in the runtime as long as the changed fields are not configured.
+	if requireModuleConfigSync(manifest) {
+		if err := modulecr.NewClient(skrClient).SyncModuleConfig(ctx, manifest); err != nil {
+			manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+			return r.finishReconcile(ctx, manifest, metrics.ManifestSyncModuleConfig, manifestStatus, err)
+		}
+		updateSyncedModuleConfigAnnotation(manifest)
+		return r.updateManifest(ctx, req, manifest, metrics.ManifestUpdateSyncedModuleConfig)
+	}
+
 	if !manifest.GetDeletionTimestamp().IsZero() {
 		return r.cleanupManifest(ctx, req, manifest, manifestStatus, metrics.ManifestReconcileFinished, nil)
 	}
@@ -279,8 +341,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 // But when this happens, it might be the connection to the SKR is lost which prevents the manifest deletion,
 // so the next step is try to evict the cache and hope the next reconciliation can determine
 // if the skr kubeconfig secret is deleted or not. If the secret is deleted, then the manifest will be deleted as well.
-func manifestUnderDeletingButNoSyncedResources(manifest *v1beta2.Manifest, current ResourceList) bool {
-	return !manifest.GetDeletionTimestamp().IsZero() && len(current) == 0
+func manifestUnderDeletingButNoSyncedResources(manifest *v1beta2.Manifest) bool {
+	return !manifest.GetDeletionTimestamp().IsZero() && len(manifest.GetStatus().Synced) == 0
 }
 
 func recordMandatoryModuleState(manifest *v1beta2.Manifest, r *Reconciler) {
@@ -370,13 +432,22 @@ func (r *Reconciler) renderResources(ctx context.Context, skrClient skrclient.Cl
 	}
 
 	if !manifest.GetDeletionTimestamp().IsZero() {
-		allModuleCRsDeleted, err := ensureModuleCRsAllDeleted(ctx, skrClient, manifest)
+		allModuleCRsDeleted, blockers, err := r.ensureModuleCRsAllDeleted(ctx, skrClient, manifest)
 		switch {
 		case allModuleCRsDeleted:
-			return ResourceList{}, current, nil
+			manifest.SetStatus(manifest.GetStatus().WithDeletionBlockers(nil))
+			hookResources, err := r.renderUpgradeHookResources(ctx, converter, manifest)
+			if err != nil {
+				manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+				return nil, nil, err
+			}
+			return ResourceList{}, append(current, hookResources...), nil
 		case errors.Is(err, modulecr.ErrWaitingForModuleCRsDeletion):
+			if manifest.GetStatus().Operation != waitingForModuleCRsDeletion {
+				r.event.Warning(manifest, event.ModuleCRDeletionBlocked, err)
+			}
 			manifest.SetStatus(manifest.GetStatus().WithState(shared.StateDeleting).
-				WithOperation("waiting for module crs deletion"))
+				WithOperation(waitingForModuleCRsDeletion).WithDeletionBlockers(blockers))
 			return nil, nil, err
 		case err != nil:
 			manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
@@ -392,19 +463,76 @@ func (r *Reconciler) renderResources(ctx context.Context, skrClient skrclient.Cl
 	return target, current, nil
 }
 
-func ensureModuleCRsAllDeleted(ctx context.Context, skrClient skrclient.Client, manifest *v1beta2.Manifest) (
-	bool,
-	error,
-) {
-	if err := modulecr.NewClient(skrClient).CheckModuleCRsDeletion(ctx, manifest); err != nil {
-		return false, err
+// detectDrift records the module resources that drifted from the desired state in the Drift condition
+// and returns the drifted resources that must not be corrected.
+// A failed detection sets the Drift condition to Unknown, but does not block the synchronization of the resources.
+// Within the drift detection interval, the result of the last detection is reused without fetching the resources.
+func (r *Reconciler) detectDrift(ctx context.Context, skrClient skrclient.Client, manifest *v1beta2.Manifest,
+	target []*resource.Info,
+) []shared.Resource {
+	if r.driftDetectionMode == skrresources.DriftDetectionDisabled {
+		return nil
+	}
+	if !manifest.GetDeletionTimestamp().IsZero() {
+		r.driftCheckCache.Delete(manifest)
+		return nil
+	}
+
+	drifted, cached := r.driftCheckCache.Get(manifest)
+	if !cached {
+		var err error
+		drifted, err = skrresources.NewDriftDetector(skrClient, fieldowners.DeclarativeApplier).
+			Detect(ctx, target, manifest.GetStatus().Synced)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "failed to detect drift of module resources")
+			status.SetDriftConditionUnknown(manifest, err.Error())
+			return nil
+		}
+		r.driftCheckCache.Set(manifest, drifted)
+		status.SetDriftCondition(manifest, len(drifted) > 0, skrresources.DriftMessage(drifted))
+		r.manifestMetrics.RecordDriftedResources(manifest.GetName(), manifest.GetLabels()[shared.KymaName],
+			manifest.GetLabels()[shared.ModuleName], len(drifted))
+	}
+
+	if r.driftDetectionMode != skrresources.DriftDetectionReportOnly {
+		return nil
+	}
+	excluded := make([]shared.Resource, 0, len(drifted))
+	for _, driftedResource := range drifted {
+		excluded = append(excluded, driftedResource.Resource)
+	}
+	return excluded
+}
+
+// ensureModuleCRsAllDeleted reports whether all module CRs are deleted. As long as module CRs created by users
+// exist, they are returned as deletion blockers together with ErrWaitingForModuleCRsDeletion.
+// If the deletion is forced by the Manifest, the blocking module CRs are deleted first.
+func (r *Reconciler) ensureModuleCRsAllDeleted(ctx context.Context, skrClient skrclient.Client,
+	manifest *v1beta2.Manifest,
+) (bool, *shared.DeletionBlockers, error) {
+	moduleCRClient := modulecr.NewClient(skrClient)
+	if manifest.IsForceDeleteConfirmed() {
+		deleted, err := moduleCRClient.DeleteModuleCRsExcludingDefaultCR(ctx, manifest)
+		if deleted > 0 {
+			r.event.Normal(manifest, event.ModuleCRsForceDeleted,
+				fmt.Sprintf("deletion of %d module CRs requested by annotation %s", deleted,
+					shared.ForceDeleteAnnotation))
+		}
+		if err != nil {
+			return false, nil, err
+		}
+	}
+
+	if blockers, err := moduleCRClient.CheckModuleCRsDeletion(ctx, manifest); err != nil {
+		return false, blockers, err
 	}
 
-	return modulecr.NewClient(skrClient).CheckDefaultCRDeletion(ctx, manifest)
+	deleted, err := moduleCRClient.CheckDefaultCRDeletion(ctx, manifest)
+	return deleted, nil, err
 }
 
 func (r *Reconciler) syncManifestState(ctx context.Context, skrClient skrclient.Client, manifest *v1beta2.Manifest,
-	target []*resource.Info,
+	target []*resource.Info, upgrading bool,
 ) error {
 	manifestStatus := manifest.GetStatus()
 
@@ -414,6 +542,7 @@ func (r *Reconciler) syncManifestState(ctx context.Context, skrClient skrclient.
 			return err
 		}
 		status.SetModuleCRInstallConditionTrue(manifest)
+		r.event.Normal(manifest, event.DefaultCRCreated, defaultCRCreatedMessage(manifest))
 	}
 
 	if err := finalizer.EnsureCRFinalizer(ctx, r.kcpClient, manifest); err != nil {
@@ -421,39 +550,61 @@ func (r *Reconciler) syncManifestState(ctx context.Context, skrClient skrclient.
 	}
 
 	if !manifest.GetDeletionTimestamp().IsZero() {
-		if status.RequireManifestStateUpdateAfterSyncResource(manifest, shared.StateDeleting) {
+		if status.RequireManifestStateUpdateAfterSyncResource(manifest, shared.StateDeleting, "") {
 			return fmt.Errorf("%w: from %s to %s", errStateRequireUpdate,
 				manifestStatus.State, shared.StateDeleting)
 		}
 		return nil
 	}
 
-	managerState, err := r.checkManagerState(ctx, skrClient, target)
+	managerState, err := r.checkManagerState(ctx, skrClient, manifest, target)
 	if err != nil {
 		manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
 		return err
 	}
 
-	if status.RequireManifestStateUpdateAfterSyncResource(manifest, managerState) {
+	// The post-upgrade hook runs once the new version of the module is ready and determines the state until it completed.
+	if upgrading && managerState.State == shared.StateReady {
+		if completed, err := r.runUpgradeHook(ctx, skrClient, manifest, upgradehook.PostUpgrade); err != nil {
+			return err
+		} else if !completed {
+			return nil
+		}
+	}
+
+	if status.RequireManifestStateUpdateAfterSyncResource(manifest, managerState.State, managerState.Message) {
 		return fmt.Errorf("%w: from %s to %s", errStateRequireUpdate,
-			manifestStatus.State, managerState)
+			manifestStatus.State, managerState.State)
 	}
 	return nil
 }
 
-func (r *Reconciler) checkManagerState(ctx context.Context, clnt skrclient.Client,
+func defaultCRCreatedMessage(manifest *v1beta2.Manifest) string {
+	resource := manifest.Spec.Resource
+	name := resource.GetName()
+	if resource.GetNamespace() != "" {
+		name = resource.GetNamespace() + "/" + name
+	}
+	return fmt.Sprintf("default CR %s %s of module %s with version %s created", resource.GetKind(), name,
+		manifest.GetLabels()[shared.ModuleName], manifest.Spec.Version)
+}
+
+func (r *Reconciler) checkManagerState(ctx context.Context, clnt skrclient.Client, manifest *v1beta2.Manifest,
 	target []*resource.Info,
 ) (
-	shared.State,
+	statecheck.Result,
 	error,
 ) {
 	managerReadyCheck := r.customStateCheck
-	managerState, err := managerReadyCheck.GetState(ctx, clnt, target)
+	managerState, err := managerReadyCheck.GetState(ctx, clnt, manifest.Spec.Manager, target)
 	if err != nil {
-		return shared.StateError, err
+		return statecheck.Result{State: shared.StateError}, err
 	}
-	if managerState == shared.StateError {
-		return shared.StateError, ErrManagerInErrorState
+	if managerState.State == shared.StateError {
+		if managerState.Message != "" {
+			return managerState, fmt.Errorf("%w: %s", ErrManagerInErrorState, managerState.Message)
+		}
+		return managerState, ErrManagerInErrorState
 	}
 	return managerState, nil
 }
@@ -468,6 +619,13 @@ func (r *Reconciler) renderTargetResources(ctx context.Context,
 		return nil, err
 	}
 
+	if manifest.Spec.TemplateManifest {
+		if err := templating.Render(targetResources.Items, templating.NewValues(manifest)); err != nil {
+			manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+			return nil, err
+		}
+	}
+
 	for _, transform := range r.resourceTransforms {
 		if err := transform(ctx, manifest, targetResources.Items); err != nil {
 			return nil, err
@@ -516,7 +674,50 @@ func (r *Reconciler) pruneDiff(ctx context.Context, clnt skrclient.Client, manif
 func manifestNotInDeletingAndOciRefNotChangedButDiffDetected(diff []*resource.Info, manifest *v1beta2.Manifest,
 	spec *Spec,
 ) bool {
-	return len(diff) > 0 && ociRefNotChanged(manifest, spec.OCIRef) && manifest.GetDeletionTimestamp().IsZero()
+	return len(diff) > 0 && ociRefNotChanged(manifest, spec.OCIRef) && templateValuesNotChanged(manifest) &&
+		manifest.GetDeletionTimestamp().IsZero()
+}
+
+func templateValuesNotChanged(manifest *v1beta2.Manifest) bool {
+	if !manifest.Spec.TemplateManifest {
+		return true
+	}
+	syncedValues, found := manifest.GetAnnotations()[SyncedTemplateValuesAnnotation]
+	return found && syncedValues == templateValuesHash(manifest)
+}
+
+func requireUpdateSyncedTemplateValuesAnnotation(manifest *v1beta2.Manifest) bool {
+	if !manifest.GetDeletionTimestamp().IsZero() {
+		return false
+	}
+	syncedValues, found := manifest.GetAnnotations()[SyncedTemplateValuesAnnotation]
+	if !manifest.Spec.TemplateManifest {
+		return found
+	}
+	return syncedValues != templateValuesHash(manifest)
+}
+
+func updateSyncedTemplateValuesAnnotation(manifest *v1beta2.Manifest) {
+	annotations := manifest.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if manifest.Spec.TemplateManifest {
+		annotations[SyncedTemplateValuesAnnotation] = templateValuesHash(manifest)
+	} else {
+		delete(annotations, SyncedTemplateValuesAnnotation)
+	}
+	manifest.SetAnnotations(annotations)
+}
+
+func templateValuesHash(manifest *v1beta2.Manifest) string {
+	// the keys of maps are sorted when marshalled, so that equal values result in the same hash
+	values, err := json.Marshal(templating.NewValues(manifest))
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(values)
+	return hex.EncodeToString(sum[:])
 }
 
 func ociRefNotChanged(manifest *v1beta2.Manifest, ref string) bool {
@@ -546,6 +747,35 @@ func updateSyncedOCIRefAnnotation(manifest *v1beta2.Manifest, ref string) {
 	manifest.SetAnnotations(annotations)
 }
 
+func requireModuleConfigSync(manifest *v1beta2.Manifest) bool {
+	if !manifest.GetDeletionTimestamp().IsZero() || !manifest.ShouldCreateDefaultModuleCR() ||
+		!status.IsModuleCRInstallConditionTrue(manifest.GetStatus()) {
+		return false
+	}
+	return manifest.GetAnnotations()[SyncedModuleConfigAnnotation] != moduleConfigHash(manifest)
+}
+
+func updateSyncedModuleConfigAnnotation(manifest *v1beta2.Manifest) {
+	annotations := manifest.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if hash := moduleConfigHash(manifest); hash != "" {
+		annotations[SyncedModuleConfigAnnotation] = hash
+	} else {
+		delete(annotations, SyncedModuleConfigAnnotation)
+	}
+	manifest.SetAnnotations(annotations)
+}
+
+func moduleConfigHash(manifest *v1beta2.Manifest) string {
+	if manifest.Spec.ResourceOverride == nil || len(manifest.Spec.ResourceOverride.Raw) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(manifest.Spec.ResourceOverride.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
 func pruneResource(diff []*resource.Info, resourceType string, resourceName string) ([]*resource.Info, error) {
 	for index, info := range diff {
 		obj, ok := info.Object.(client.Object)
@@ -9,7 +9,15 @@ import (
 	apicorev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/cli-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
 )
 
 func TestPruneResource(t *testing.T) {
@@ -98,3 +106,87 @@ func TestPruneResource(t *testing.T) {
 		require.Contains(t, result, deployment)
 	})
 }
+
+func TestPruneDiff_WhenTemplateValuesChangedInSameOCILayer_DeletesStaleResources(t *testing.T) {
+	t.Parallel()
+	manifest := templatedManifest("europe-west1")
+	updateSyncedTemplateValuesAnnotation(manifest)
+	manifest.Labels[shared.RegionLabel] = "us-east1"
+	parser := &cachedManifestParserStub{}
+	reconciler := &Reconciler{cachedManifestParser: parser}
+
+	err := reconciler.pruneDiff(t.Context(), &skrClientStub{Client: fake.NewClientBuilder().Build()}, manifest,
+		[]*resource.Info{staleConfigMap()}, nil, &Spec{OCIRef: "sha256:abc"})
+
+	require.NoError(t, err)
+	require.Zero(t, parser.evictions)
+}
+
+func TestPruneDiff_WhenTemplateValuesUnchangedInSameOCILayer_BlocksPruning(t *testing.T) {
+	t.Parallel()
+	manifest := templatedManifest("europe-west1")
+	updateSyncedTemplateValuesAnnotation(manifest)
+	parser := &cachedManifestParserStub{}
+	reconciler := &Reconciler{cachedManifestParser: parser}
+
+	err := reconciler.pruneDiff(t.Context(), &skrClientStub{Client: fake.NewClientBuilder().Build()}, manifest,
+		[]*resource.Info{staleConfigMap()}, nil, &Spec{OCIRef: "sha256:abc"})
+
+	require.ErrorIs(t, err, ErrResourceSyncDiffInSameOCILayer)
+	require.Equal(t, 1, parser.evictions)
+}
+
+func TestRequireUpdateSyncedTemplateValuesAnnotation_WhenLabelChanged_ReturnsTrue(t *testing.T) {
+	t.Parallel()
+	manifest := templatedManifest("europe-west1")
+	require.True(t, requireUpdateSyncedTemplateValuesAnnotation(manifest))
+
+	updateSyncedTemplateValuesAnnotation(manifest)
+	require.False(t, requireUpdateSyncedTemplateValuesAnnotation(manifest))
+
+	manifest.Labels[shared.RegionLabel] = "us-east1"
+	require.True(t, requireUpdateSyncedTemplateValuesAnnotation(manifest))
+}
+
+func templatedManifest(region string) *v1beta2.Manifest {
+	return &v1beta2.Manifest{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:        "test-manifest",
+			Labels:      map[string]string{shared.RegionLabel: region},
+			Annotations: map[string]string{SyncedOCIRefAnnotation: "sha256:abc"},
+		},
+		Spec: v1beta2.ManifestSpec{TemplateManifest: true},
+	}
+}
+
+func staleConfigMap() *resource.Info {
+	return &resource.Info{
+		Name: "stale-config",
+		Object: &apicorev1.ConfigMap{
+			ObjectMeta: apimetav1.ObjectMeta{Name: "stale-config", Namespace: "kyma-system"},
+			TypeMeta:   apimetav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		},
+	}
+}
+
+type cachedManifestParserStub struct {
+	resources []*unstructured.Unstructured
+	evictions int
+}
+
+func (s *cachedManifestParserStub) Parse(_ *Spec) (internal.ManifestResources, error) {
+	items := make([]*unstructured.Unstructured, 0, len(s.resources))
+	for _, obj := range s.resources {
+		items = append(items, obj.DeepCopy())
+	}
+	return internal.ManifestResources{Items: items}, nil
+}
+
+func (s *cachedManifestParserStub) EvictCache(_ *Spec) {
+	s.evictions++
+}
+
+type skrClientStub struct {
+	skrresources.ResourceInfoConverter
+	client.Client
+}
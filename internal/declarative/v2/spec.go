@@ -8,6 +8,8 @@ import (
 
 type SpecResolver interface {
 	GetSpec(ctx context.Context, manifest *v1beta2.Manifest) (*Spec, error)
+	GetUpgradeHookSpec(ctx context.Context, manifest *v1beta2.Manifest, hookName string,
+		hook *v1beta2.ManifestUpgradeHook) (*Spec, error)
 }
 
 type Spec struct {
@@ -9,12 +9,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
 )
 
 var ErrNotValidClientObject = errors.New("object in resource info is not a valid client object")
 
 type StateCheck interface {
-	GetState(ctx context.Context, clnt client.Client, resources []*resource.Info) (shared.State, error)
+	GetState(ctx context.Context, clnt client.Client, manager *v1beta2.Manager,
+		resources []*resource.Info) (statecheck.Result, error)
 }
 
 type ExistsStateCheck struct{}
@@ -26,16 +29,17 @@ func NewExistsStateCheck() *ExistsStateCheck {
 func (c *ExistsStateCheck) GetState(
 	ctx context.Context,
 	clnt client.Client,
+	_ *v1beta2.Manager,
 	resources []*resource.Info,
-) (shared.State, error) {
+) (statecheck.Result, error) {
 	for i := range resources {
 		obj, ok := resources[i].Object.(client.Object)
 		if !ok {
-			return shared.StateError, ErrNotValidClientObject
+			return statecheck.Result{State: shared.StateError}, ErrNotValidClientObject
 		}
 		if err := clnt.Get(ctx, client.ObjectKeyFromObject(obj), obj); client.IgnoreNotFound(err) != nil {
-			return shared.StateError, fmt.Errorf("failed to fetch object by key: %w", err)
+			return statecheck.Result{State: shared.StateError}, fmt.Errorf("failed to fetch object by key: %w", err)
 		}
 	}
-	return shared.StateReady, nil
+	return statecheck.Result{State: shared.StateReady}, nil
 }
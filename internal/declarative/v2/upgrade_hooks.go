@@ -0,0 +1,171 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/event"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/status"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/templating"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/upgradehook"
+)
+
+// runUpgradeHook runs the upgrade hook of the Manifest and reports whether the upgrade may proceed.
+// Manifests without the hook proceed right away. While the hook Job runs, the Manifest is Processing,
+// and a failed hook puts the Manifest into the Error state.
+func (r *Reconciler) runUpgradeHook(ctx context.Context, skrClient client.Client, manifest *v1beta2.Manifest,
+	hook upgradehook.Hook,
+) (bool, error) {
+	manifestHook := getUpgradeHook(manifest, hook)
+	if manifestHook == nil {
+		return true, nil
+	}
+
+	completed, err := r.runUpgradeHookJob(ctx, skrClient, manifest, hook, manifestHook)
+	switch {
+	case err != nil:
+		if !status.IsUpgradeHookFailed(manifest.GetStatus()) {
+			r.event.Warning(manifest, event.UpgradeHookFailed, err)
+		}
+		status.SetUpgradeHooksCondition(manifest, status.ConditionReasonUpgradeHookFailed,
+			fmt.Sprintf("%s hook failed: %s", hook, err))
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+		return false, err
+	case !completed:
+		message := fmt.Sprintf("waiting for %s hook Job to complete", hook)
+		status.SetUpgradeHooksCondition(manifest, status.ConditionReasonUpgradeHookRunning, message)
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateProcessing).WithOperation(message))
+		return false, nil
+	}
+
+	status.SetUpgradeHooksCondition(manifest, status.ConditionReasonUpgradeHookCompleted,
+		fmt.Sprintf("%s hook completed for version %s", hook, manifest.Spec.Version))
+	return true, nil
+}
+
+// runPreUpgradeHook runs the pre-upgrade hook once per installation layer. The hook Job may be removed after it
+// finished, so its completion is recorded in the CompletedPreUpgradeHookAnnotation, and the hook is not run again
+// while the rest of the upgrade proceeds.
+func (r *Reconciler) runPreUpgradeHook(ctx context.Context, skrClient client.Client, manifest *v1beta2.Manifest,
+	ref string,
+) (bool, error) {
+	if !preUpgradeHookCompleted(manifest, ref) {
+		return r.runUpgradeHook(ctx, skrClient, manifest, upgradehook.PreUpgrade)
+	}
+	// a post-upgrade hook reports its own progress in the condition
+	if getUpgradeHook(manifest, upgradehook.PostUpgrade) == nil {
+		status.SetUpgradeHooksCondition(manifest, status.ConditionReasonUpgradeHookCompleted,
+			fmt.Sprintf("%s hook completed for version %s", upgradehook.PreUpgrade, manifest.Spec.Version))
+	}
+	return true, nil
+}
+
+func (r *Reconciler) runUpgradeHookJob(ctx context.Context, skrClient client.Client, manifest *v1beta2.Manifest,
+	hook upgradehook.Hook, manifestHook *v1beta2.ManifestUpgradeHook,
+) (bool, error) {
+	resources, err := r.renderUpgradeHook(ctx, manifest, hook, manifestHook)
+	if err != nil {
+		return false, err
+	}
+	completed, err := upgradehook.Run(ctx, skrClient, resources, manifest.Spec.Version,
+		manifestHook.Timeout.Duration)
+	if err != nil {
+		return false, fmt.Errorf("%s hook: %w", hook, err)
+	}
+	return completed, nil
+}
+
+// renderUpgradeHook renders the resources of the upgrade hook the same way as the resources of the module,
+// so that they carry the managed-by label and the owned-by annotation of the Manifest.
+func (r *Reconciler) renderUpgradeHook(ctx context.Context, manifest *v1beta2.Manifest, hook upgradehook.Hook,
+	manifestHook *v1beta2.ManifestUpgradeHook,
+) ([]*unstructured.Unstructured, error) {
+	hookSpec, err := r.specResolver.GetUpgradeHookSpec(ctx, manifest, string(hook), manifestHook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s hook: %w", hook, err)
+	}
+	resources, err := r.cachedManifestParser.Parse(hookSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s hook: %w", hook, err)
+	}
+	if manifest.Spec.TemplateManifest {
+		if err := templating.Render(resources.Items, templating.NewValues(manifest)); err != nil {
+			return nil, fmt.Errorf("failed to template %s hook: %w", hook, err)
+		}
+	}
+	for _, transform := range r.resourceTransforms {
+		if err := transform(ctx, manifest, resources.Items); err != nil {
+			return nil, fmt.Errorf("failed to transform %s hook: %w", hook, err)
+		}
+	}
+	return resources.Items, nil
+}
+
+// renderUpgradeHookResources returns the resources of the upgrade hooks of the Manifest. They are not part of
+// the synced resources, so they are added to the resources that are removed when the Manifest is deleted.
+func (r *Reconciler) renderUpgradeHookResources(ctx context.Context, converter skrresources.ResourceToInfoConverter,
+	manifest *v1beta2.Manifest,
+) ([]*resource.Info, error) {
+	var infos []*resource.Info
+	for _, hook := range []upgradehook.Hook{upgradehook.PreUpgrade, upgradehook.PostUpgrade} {
+		manifestHook := getUpgradeHook(manifest, hook)
+		if manifestHook == nil {
+			continue
+		}
+		resources, err := r.renderUpgradeHook(ctx, manifest, hook, manifestHook)
+		if err != nil {
+			return nil, err
+		}
+		hookInfos, err := converter.UnstructuredToInfos(resources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s hook: %w", hook, err)
+		}
+		infos = append(infos, hookInfos...)
+	}
+	return infos, nil
+}
+
+// awaitsPostUpgradeHook reports whether the upgrade must not be finished yet, as the Manifest declares
+// a post-upgrade hook, which only runs once the module is ready.
+func awaitsPostUpgradeHook(manifest *v1beta2.Manifest) bool {
+	return getUpgradeHook(manifest, upgradehook.PostUpgrade) != nil &&
+		manifest.GetStatus().State != shared.StateReady
+}
+
+func preUpgradeHookCompleted(manifest *v1beta2.Manifest, ref string) bool {
+	completedRef, found := manifest.GetAnnotations()[CompletedPreUpgradeHookAnnotation]
+	return found && completedRef == ref
+}
+
+func requireUpdateCompletedPreUpgradeHookAnnotation(manifest *v1beta2.Manifest, ref string) bool {
+	return getUpgradeHook(manifest, upgradehook.PreUpgrade) != nil && !preUpgradeHookCompleted(manifest, ref)
+}
+
+func updateCompletedPreUpgradeHookAnnotation(manifest *v1beta2.Manifest, ref string) {
+	annotations := manifest.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[CompletedPreUpgradeHookAnnotation] = ref
+	manifest.SetAnnotations(annotations)
+}
+
+func getUpgradeHook(manifest *v1beta2.Manifest, hook upgradehook.Hook) *v1beta2.ManifestUpgradeHook {
+	if manifest.Spec.Hooks == nil {
+		return nil
+	}
+	switch hook {
+	case upgradehook.PreUpgrade:
+		return manifest.Spec.Hooks.PreUpgrade
+	case upgradehook.PostUpgrade:
+		return manifest.Spec.Hooks.PostUpgrade
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+//nolint:testpackage // test private functions
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/resources"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+func TestRenderUpgradeHookResources_WhenManifestDeleted_HookResourcesArePruned(t *testing.T) {
+	t.Parallel()
+	hookJob := &batchv1.Job{ObjectMeta: apimetav1.ObjectMeta{Name: "migrate", Namespace: "kyma-system"}}
+	clnt := fake.NewClientBuilder().WithObjects(hookJob).Build()
+	reconciler := &Reconciler{
+		specResolver:         specResolverStub{},
+		cachedManifestParser: &cachedManifestParserStub{resources: []*unstructured.Unstructured{unstructuredJob()}},
+		resourceTransforms:   GetDefaultResourceTransforms(),
+	}
+	manifest := manifestWithPreUpgradeHook()
+
+	current, err := reconciler.renderUpgradeHookResources(t.Context(), converterStub{}, manifest)
+	require.NoError(t, err)
+	require.Len(t, current, 1)
+	require.Equal(t, shared.ManagedByLabelValue,
+		current[0].Object.(client.Object).GetLabels()[shared.ManagedBy])
+
+	err = reconciler.pruneDiff(t.Context(), &skrClientStub{Client: clnt}, manifest, current, nil, &Spec{})
+
+	require.ErrorIs(t, err, resources.ErrDeletionNotFinished)
+	err = clnt.Get(t.Context(), client.ObjectKeyFromObject(hookJob), &batchv1.Job{})
+	require.True(t, util.IsNotFound(err))
+}
+
+func TestRenderUpgradeHookResources_WithoutHooks_ReturnsNoResources(t *testing.T) {
+	t.Parallel()
+	reconciler := &Reconciler{cachedManifestParser: &cachedManifestParserStub{}}
+	manifest := manifestWithPreUpgradeHook()
+	manifest.Spec.Hooks = nil
+
+	current, err := reconciler.renderUpgradeHookResources(t.Context(), converterStub{}, manifest)
+
+	require.NoError(t, err)
+	require.Empty(t, current)
+}
+
+func TestRequireUpdateCompletedPreUpgradeHookAnnotation(t *testing.T) {
+	t.Parallel()
+	manifest := manifestWithPreUpgradeHook()
+	require.True(t, requireUpdateCompletedPreUpgradeHookAnnotation(manifest, "sha256:new"))
+
+	updateCompletedPreUpgradeHookAnnotation(manifest, "sha256:new")
+	require.False(t, requireUpdateCompletedPreUpgradeHookAnnotation(manifest, "sha256:new"))
+	require.True(t, requireUpdateCompletedPreUpgradeHookAnnotation(manifest, "sha256:next"))
+
+	manifest.Spec.Hooks = nil
+	require.False(t, requireUpdateCompletedPreUpgradeHookAnnotation(manifest, "sha256:next"))
+}
+
+func manifestWithPreUpgradeHook() *v1beta2.Manifest {
+	now := apimetav1.Now()
+	return &v1beta2.Manifest{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "test-manifest", Namespace: "kcp-system", DeletionTimestamp: &now},
+		Spec: v1beta2.ManifestSpec{
+			Hooks: &v1beta2.ManifestUpgradeHooks{PreUpgrade: &v1beta2.ManifestUpgradeHook{}},
+		},
+	}
+}
+
+func unstructuredJob() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]any{"name": "migrate", "namespace": "kyma-system"},
+	}}
+}
+
+type specResolverStub struct{}
+
+func (specResolverStub) GetSpec(_ context.Context, _ *v1beta2.Manifest) (*Spec, error) {
+	return &Spec{}, nil
+}
+
+func (specResolverStub) GetUpgradeHookSpec(_ context.Context, _ *v1beta2.Manifest, _ string,
+	_ *v1beta2.ManifestUpgradeHook,
+) (*Spec, error) {
+	return &Spec{}, nil
+}
+
+type converterStub struct{}
+
+func (converterStub) ResourcesToInfos(_ []shared.Resource) ([]*resource.Info, error) {
+	return nil, nil
+}
+
+func (converterStub) UnstructuredToInfos(objs []*unstructured.Unstructured) ([]*resource.Info, error) {
+	infos := make([]*resource.Info, 0, len(objs))
+	for _, obj := range objs {
+		infos = append(infos, &resource.Info{Name: obj.GetName(), Namespace: obj.GetNamespace(), Object: obj})
+	}
+	return infos, nil
+}
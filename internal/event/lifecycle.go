@@ -0,0 +1,26 @@
+package event
+
+// Reasons of the events recorded on the Kyma and the Manifest for the lifecycle of a module.
+// Alerts and audit pipelines rely on them, so they must not be changed.
+const (
+	// ModuleEnabled is recorded on the Kyma when the first version of a module is installed.
+	ModuleEnabled Reason = "ModuleEnabled"
+	// ModuleUpgradeStarted is recorded on the Kyma when the version of a module changes.
+	ModuleUpgradeStarted Reason = "ModuleUpgradeStarted"
+	// ModuleUpgradeBlocked is recorded on the Kyma when the upgrade of a module waits for a maintenance window.
+	ModuleUpgradeBlocked Reason = "ModuleUpgradeBlocked"
+	// ModuleUpgradeCompleted is recorded on the Kyma when a module becomes ready after its version changed.
+	ModuleUpgradeCompleted Reason = "ModuleUpgradeCompleted"
+	// ModuleRemoved is recorded on the Kyma when a module is removed.
+	ModuleRemoved Reason = "ModuleRemoved"
+	// DefaultCRCreated is recorded on the Manifest when the default CR of a module is created.
+	DefaultCRCreated Reason = "DefaultCRCreated"
+	// ModuleCRDeletionBlocked is recorded on the Manifest when its deletion waits for module CRs created by users.
+	ModuleCRDeletionBlocked Reason = "ModuleCRDeletionBlocked"
+	// ModuleCRsForceDeleted is recorded on the Manifest when the module CRs blocking its deletion are deleted
+	// because the deletion was forced.
+	ModuleCRsForceDeleted Reason = "ModuleCRsForceDeleted"
+	// UpgradeHookFailed is recorded on the Manifest when the Job of a pre-upgrade or post-upgrade hook
+	// failed or did not complete within its timeout.
+	UpgradeHookFailed Reason = "UpgradeHookFailed"
+)
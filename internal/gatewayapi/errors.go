@@ -0,0 +1,13 @@
+package gatewayapi
+
+import "errors"
+
+var (
+	ErrFailedToConvertLabelSelector = errors.New("failed to convert label selector to selector")
+	ErrFailedToListGateways         = errors.New("failed to list gateways")
+	ErrFailedToApplyHTTPRoute       = errors.New("failed to apply HTTPRoute")
+	ErrFailedToDeleteHTTPRoute      = errors.New("failed to delete HTTPRoute")
+	ErrFailedToAddOwnerReference    = errors.New("failed to add owner reference")
+	ErrCantFindMatchingGateway      = errors.New("can't find matching Gateway API Gateway")
+	ErrInvalidArgument              = errors.New("invalid argument")
+)
@@ -0,0 +1,143 @@
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
+)
+
+const (
+	minPort = 1
+	maxPort = 65535
+)
+
+// NewHTTPRoute builds the HTTPRoute that attaches to the gateways and routes the events with the path prefix
+// of the manager of the Watcher to the service of the Watcher.
+// The service must be in the namespace of the Watcher, as a reference to a service in another namespace would
+// require a ReferenceGrant in the namespace of the service.
+func NewHTTPRoute(watcher *v1beta2.Watcher, gateways []gatewayv1.Gateway,
+	scheme *machineryruntime.Scheme,
+) (*gatewayv1.HTTPRoute, error) {
+	if err := validateArgumentsForNewHTTPRoute(watcher, gateways); err != nil {
+		return nil, err
+	}
+
+	port := gatewayv1.PortNumber(watcher.Spec.ServiceInfo.Port) //nolint:gosec // port range is validated above
+
+	httpRoute := &gatewayv1.HTTPRoute{}
+	httpRoute.SetGroupVersionKind(gatewayv1.SchemeGroupVersion.WithKind("HTTPRoute"))
+	httpRoute.SetName(watcher.GetName())
+	httpRoute.SetNamespace(watcher.GetNamespace())
+	httpRoute.Spec.ParentRefs = getParentRefs(gateways)
+	httpRoute.Spec.Hostnames = getHostnames(gateways)
+	httpRoute.Spec.Rules = []gatewayv1.HTTPRouteRule{
+		{
+			Matches: []gatewayv1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+						Value: ptr.To(routing.PathPrefix(watcher)),
+					},
+				},
+			},
+			BackendRefs: []gatewayv1.HTTPBackendRef{
+				{
+					BackendRef: gatewayv1.BackendRef{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Name:      gatewayv1.ObjectName(watcher.Spec.ServiceInfo.Name),
+							Namespace: ptr.To(gatewayv1.Namespace(watcher.Spec.ServiceInfo.Namespace)),
+							Port:      ptr.To(port),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := controllerutil.SetOwnerReference(watcher, httpRoute, scheme); err != nil {
+		return nil, errors.Join(ErrFailedToAddOwnerReference, err)
+	}
+
+	return httpRoute, nil
+}
+
+func getParentRefs(gateways []gatewayv1.Gateway) []gatewayv1.ParentReference {
+	parentRefs := make([]gatewayv1.ParentReference, 0, len(gateways))
+	for _, gateway := range gateways {
+		parentRefs = append(parentRefs, gatewayv1.ParentReference{
+			Group:     ptr.To(gatewayv1.Group(gatewayv1.GroupName)),
+			Kind:      ptr.To(gatewayv1.Kind("Gateway")),
+			Namespace: ptr.To(gatewayv1.Namespace(gateway.GetNamespace())),
+			Name:      gatewayv1.ObjectName(gateway.GetName()),
+		})
+	}
+	return parentRefs
+}
+
+// getHostnames returns the hostnames of the listeners of the gateways. Listeners without a hostname accept
+// all hostnames, so the HTTPRoute only restricts the hostnames if all listeners declare one.
+func getHostnames(gateways []gatewayv1.Gateway) []gatewayv1.Hostname {
+	hostnames := make([]gatewayv1.Hostname, 0)
+	seen := make(map[gatewayv1.Hostname]bool)
+	for _, gateway := range gateways {
+		for _, listener := range gateway.Spec.Listeners {
+			if listener.Hostname == nil {
+				return nil
+			}
+			if !seen[*listener.Hostname] {
+				seen[*listener.Hostname] = true
+				hostnames = append(hostnames, *listener.Hostname)
+			}
+		}
+	}
+	return hostnames
+}
+
+func validateArgumentsForNewHTTPRoute(watcher *v1beta2.Watcher, gateways []gatewayv1.Gateway) error {
+	if watcher == nil {
+		return fmt.Errorf("watcher must not be nil: %w", ErrInvalidArgument)
+	}
+
+	if watcher.GetName() == "" {
+		return fmt.Errorf("watcher.Name must not be empty: %w", ErrInvalidArgument)
+	}
+
+	if watcher.GetNamespace() == "" {
+		return fmt.Errorf("watcher.Namespace must not be empty: %w", ErrInvalidArgument)
+	}
+
+	if watcher.GetManagerName() == "" {
+		return fmt.Errorf("unable to GetManagerName(): %w", ErrInvalidArgument)
+	}
+
+	if watcher.Spec.ServiceInfo.Name == "" {
+		return fmt.Errorf("watcher.Spec.ServiceInfo.Name must not be empty: %w", ErrInvalidArgument)
+	}
+
+	if watcher.Spec.ServiceInfo.Namespace == "" {
+		return fmt.Errorf("watcher.Spec.ServiceInfo.Namespace must not be empty: %w", ErrInvalidArgument)
+	}
+
+	if watcher.Spec.ServiceInfo.Namespace != watcher.GetNamespace() {
+		return fmt.Errorf("watcher.Spec.ServiceInfo.Namespace %q must be the namespace of the watcher %q: %w",
+			watcher.Spec.ServiceInfo.Namespace, watcher.GetNamespace(), ErrInvalidArgument)
+	}
+
+	if watcher.Spec.ServiceInfo.Port < minPort || watcher.Spec.ServiceInfo.Port > maxPort {
+		return fmt.Errorf("watcher.Spec.ServiceInfo.Port must be between %d and %d: %w", minPort, maxPort,
+			ErrInvalidArgument)
+	}
+
+	if len(gateways) == 0 {
+		return fmt.Errorf("gateways must not be empty: %w", ErrInvalidArgument)
+	}
+
+	return nil
+}
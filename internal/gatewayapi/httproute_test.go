@@ -0,0 +1,142 @@
+package gatewayapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	machineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api"
+	"github.com/kyma-project/lifecycle-manager/internal/gatewayapi"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/builder"
+)
+
+func Test_NewHTTPRoute_ReturnsError_WhenWatcherIsNil(t *testing.T) {
+	// nil watcher is intentional: testing argument validation.
+	httpRoute, err := gatewayapi.NewHTTPRoute(nil, createGateways(), createScheme())
+
+	assert.Nil(t, httpRoute)
+	require.ErrorIs(t, err, gatewayapi.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "watcher")
+}
+
+func Test_NewHTTPRoute_ReturnsError_WhenManagerNameIsEmpty(t *testing.T) {
+	watcher := builder.NewWatcherBuilder().WithManager("").Build()
+
+	httpRoute, err := gatewayapi.NewHTTPRoute(watcher, createGateways(), createScheme())
+
+	assert.Nil(t, httpRoute)
+	require.ErrorIs(t, err, gatewayapi.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "GetManagerName")
+}
+
+func Test_NewHTTPRoute_ReturnsError_WhenPortIsOutOfRange(t *testing.T) {
+	watcher := builder.NewWatcherBuilder().WithServiceInfoPort(70000).Build()
+
+	httpRoute, err := gatewayapi.NewHTTPRoute(watcher, createGateways(), createScheme())
+
+	assert.Nil(t, httpRoute)
+	require.ErrorIs(t, err, gatewayapi.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "watcher.Spec.ServiceInfo.Port")
+}
+
+func Test_NewHTTPRoute_ReturnsError_WhenServiceIsInAnotherNamespace(t *testing.T) {
+	watcher := builder.NewWatcherBuilder().WithNamespace("kcp-system").WithServiceInfoNamespace("other").Build()
+
+	httpRoute, err := gatewayapi.NewHTTPRoute(watcher, createGateways(), createScheme())
+
+	assert.Nil(t, httpRoute)
+	require.ErrorIs(t, err, gatewayapi.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "watcher.Spec.ServiceInfo.Namespace")
+}
+
+func Test_NewHTTPRoute_ReturnsError_WhenGatewaysAreEmpty(t *testing.T) {
+	watcher := builder.NewWatcherBuilder().Build()
+
+	httpRoute, err := gatewayapi.NewHTTPRoute(watcher, nil, createScheme())
+
+	assert.Nil(t, httpRoute)
+	require.ErrorIs(t, err, gatewayapi.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "gateways")
+}
+
+func Test_NewHTTPRoute_ReturnsHTTPRoute(t *testing.T) {
+	watcher := builder.NewWatcherBuilder().WithManager("lifecycle-manager").Build()
+
+	httpRoute, err := gatewayapi.NewHTTPRoute(watcher, createGateways(), createScheme())
+
+	require.NoError(t, err)
+	assert.Equal(t, watcher.GetName(), httpRoute.GetName())
+	assert.Equal(t, watcher.GetNamespace(), httpRoute.GetNamespace())
+	assert.Equal(t, []gatewayv1.ParentReference{
+		{
+			Group:     ptr.To(gatewayv1.Group(gatewayv1.GroupName)),
+			Kind:      ptr.To(gatewayv1.Kind("Gateway")),
+			Namespace: ptr.To(gatewayv1.Namespace("kcp-system")),
+			Name:      "klm-watcher",
+		},
+	}, httpRoute.Spec.ParentRefs)
+	assert.Equal(t, []gatewayv1.Hostname{"listener.kyma.example.com"}, httpRoute.Spec.Hostnames)
+	require.Len(t, httpRoute.Spec.Rules, 1)
+	assert.Equal(t, []gatewayv1.HTTPRouteMatch{
+		{
+			Path: &gatewayv1.HTTPPathMatch{
+				Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+				Value: ptr.To("/v2/lifecycle-manager/event"),
+			},
+		},
+	}, httpRoute.Spec.Rules[0].Matches)
+	require.Len(t, httpRoute.Spec.Rules[0].BackendRefs, 1)
+	backendRef := httpRoute.Spec.Rules[0].BackendRefs[0].BackendObjectReference
+	assert.Equal(t, gatewayv1.ObjectName(watcher.Spec.ServiceInfo.Name), backendRef.Name)
+	assert.Equal(t, gatewayv1.Namespace(watcher.Spec.ServiceInfo.Namespace), *backendRef.Namespace)
+	assert.Equal(t, gatewayv1.PortNumber(watcher.Spec.ServiceInfo.Port), *backendRef.Port)
+	require.Len(t, httpRoute.GetOwnerReferences(), 1)
+	assert.Equal(t, watcher.GetName(), httpRoute.GetOwnerReferences()[0].Name)
+}
+
+func Test_NewHTTPRoute_OmitsHostnames_WhenListenerAcceptsAllHostnames(t *testing.T) {
+	watcher := builder.NewWatcherBuilder().Build()
+	gateways := createGateways()
+	gateways[0].Spec.Listeners = append(gateways[0].Spec.Listeners, gatewayv1.Listener{Name: "any"})
+
+	httpRoute, err := gatewayapi.NewHTTPRoute(watcher, gateways, createScheme())
+
+	require.NoError(t, err)
+	assert.Empty(t, httpRoute.Spec.Hostnames)
+}
+
+func createGateways() []gatewayv1.Gateway {
+	return []gatewayv1.Gateway{
+		{
+			ObjectMeta: apimetav1.ObjectMeta{
+				Name:      "klm-watcher",
+				Namespace: "kcp-system",
+				Labels:    map[string]string{"operator.kyma-project.io/watcher-gateway": "default"},
+			},
+			Spec: gatewayv1.GatewaySpec{
+				GatewayClassName: "kyma",
+				Listeners: []gatewayv1.Listener{
+					{
+						Name:     "https",
+						Hostname: ptr.To(gatewayv1.Hostname("listener.kyma.example.com")),
+						Port:     443,
+						Protocol: gatewayv1.HTTPSProtocolType,
+					},
+				},
+			},
+		},
+	}
+}
+
+func createScheme() *machineryruntime.Scheme {
+	scheme := machineryruntime.NewScheme()
+	machineryutilruntime.Must(api.AddToScheme(scheme))
+	machineryutilruntime.Must(gatewayv1.Install(scheme))
+	return scheme
+}
@@ -0,0 +1,89 @@
+package gatewayapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// RoutingBackend routes the events of a Watcher with an HTTPRoute attached to the Gateway API Gateways
+// selected by the Watcher.
+type RoutingBackend struct {
+	client           client.Client
+	scheme           *machineryruntime.Scheme
+	gatewayNamespace string
+}
+
+func NewRoutingBackend(clnt client.Client, scheme *machineryruntime.Scheme, gatewayNamespace string,
+) (*RoutingBackend, error) {
+	if clnt == nil {
+		return nil, fmt.Errorf("client must not be nil: %w", ErrInvalidArgument)
+	}
+	if scheme == nil {
+		return nil, fmt.Errorf("scheme must not be nil: %w", ErrInvalidArgument)
+	}
+
+	return &RoutingBackend{
+		client:           clnt,
+		scheme:           scheme,
+		gatewayNamespace: gatewayNamespace,
+	}, nil
+}
+
+func (b *RoutingBackend) ConfigureRoute(ctx context.Context, watcher *v1beta2.Watcher) error {
+	gateways, err := b.listGatewaysByLabelSelector(ctx, &watcher.Spec.Gateway.LabelSelector)
+	if err != nil {
+		return errors.Join(routing.ErrGatewayNotFound, err)
+	}
+	if len(gateways) == 0 {
+		return fmt.Errorf("%w: %w", routing.ErrGatewayNotFound, ErrCantFindMatchingGateway)
+	}
+
+	httpRoute, err := NewHTTPRoute(watcher, gateways, b.scheme)
+	if err != nil {
+		return err
+	}
+
+	//nolint: staticcheck // issues: #2706, #2707
+	err = b.client.Patch(ctx, httpRoute, client.Apply, client.ForceOwnership, fieldowners.LifecycleManager)
+	if err != nil {
+		return errors.Join(ErrFailedToApplyHTTPRoute, err)
+	}
+	return nil
+}
+
+func (b *RoutingBackend) DeleteRoute(ctx context.Context, watcher *v1beta2.Watcher) error {
+	httpRoute := &gatewayv1.HTTPRoute{}
+	httpRoute.SetName(watcher.GetName())
+	httpRoute.SetNamespace(watcher.GetNamespace())
+	if err := b.client.Delete(ctx, httpRoute); err != nil && !util.IsNotFound(err) {
+		return errors.Join(ErrFailedToDeleteHTTPRoute, err)
+	}
+	return nil
+}
+
+func (b *RoutingBackend) listGatewaysByLabelSelector(ctx context.Context, labelSelector *apimetav1.LabelSelector,
+) ([]gatewayv1.Gateway, error) {
+	selector, err := apimetav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToConvertLabelSelector, err)
+	}
+
+	gateways := &gatewayv1.GatewayList{}
+	if err = b.client.List(ctx, gateways, client.InNamespace(b.gatewayNamespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Join(fmt.Errorf("%w, %q", ErrFailedToListGateways, selector.String()), err)
+	}
+
+	return gateways.Items, nil
+}
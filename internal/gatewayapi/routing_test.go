@@ -0,0 +1,90 @@
+package gatewayapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/gatewayapi"
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/builder"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+const gatewayNamespace = "kcp-system"
+
+func Test_NewRoutingBackend_ReturnsError_WhenSchemeIsNil(t *testing.T) {
+	backend, err := gatewayapi.NewRoutingBackend(fake.NewClientBuilder().Build(), nil, gatewayNamespace)
+
+	assert.Nil(t, backend)
+	require.ErrorIs(t, err, gatewayapi.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "scheme")
+}
+
+func Test_ConfigureRoute_CreatesHTTPRoute(t *testing.T) {
+	watcher := createWatcher()
+	gateway := createGateways()[0]
+	clnt := fakeClient(watcher, &gateway)
+	backend, err := gatewayapi.NewRoutingBackend(clnt, createScheme(), gatewayNamespace)
+	require.NoError(t, err)
+
+	err = backend.ConfigureRoute(t.Context(), watcher)
+
+	require.NoError(t, err)
+	httpRoute := &gatewayv1.HTTPRoute{}
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKeyFromObject(watcher), httpRoute))
+	assert.Equal(t, "klm-watcher", string(httpRoute.Spec.ParentRefs[0].Name))
+}
+
+func Test_ConfigureRoute_ReturnsGatewayNotFound_WhenNoGatewayMatches(t *testing.T) {
+	watcher := createWatcher()
+	gateway := createGateways()[0]
+	gateway.SetLabels(map[string]string{"operator.kyma-project.io/watcher-gateway": "other"})
+	backend, err := gatewayapi.NewRoutingBackend(fakeClient(watcher, &gateway), createScheme(), gatewayNamespace)
+	require.NoError(t, err)
+
+	err = backend.ConfigureRoute(t.Context(), watcher)
+
+	require.ErrorIs(t, err, routing.ErrGatewayNotFound)
+	require.ErrorIs(t, err, gatewayapi.ErrCantFindMatchingGateway)
+}
+
+func Test_DeleteRoute_DeletesHTTPRoute(t *testing.T) {
+	watcher := createWatcher()
+	httpRoute, err := gatewayapi.NewHTTPRoute(watcher, createGateways(), createScheme())
+	require.NoError(t, err)
+	clnt := fakeClient(watcher, httpRoute)
+	backend, err := gatewayapi.NewRoutingBackend(clnt, createScheme(), gatewayNamespace)
+	require.NoError(t, err)
+
+	err = backend.DeleteRoute(t.Context(), watcher)
+
+	require.NoError(t, err)
+	err = clnt.Get(t.Context(), client.ObjectKeyFromObject(watcher), &gatewayv1.HTTPRoute{})
+	assert.True(t, util.IsNotFound(err))
+}
+
+func Test_DeleteRoute_IgnoresMissingHTTPRoute(t *testing.T) {
+	watcher := createWatcher()
+	backend, err := gatewayapi.NewRoutingBackend(fakeClient(watcher), createScheme(), gatewayNamespace)
+	require.NoError(t, err)
+
+	err = backend.DeleteRoute(t.Context(), watcher)
+
+	require.NoError(t, err)
+}
+
+func createWatcher() *v1beta2.Watcher {
+	watcher := builder.NewWatcherBuilder().WithNamespace(gatewayNamespace).Build()
+	watcher.Spec.Gateway.LabelSelector = v1beta2.DefaultIstioGatewaySelector()
+	return watcher
+}
+
+func fakeClient(objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().WithScheme(createScheme()).WithObjects(objs...).Build()
+}
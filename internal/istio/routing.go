@@ -0,0 +1,69 @@
+package istio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// RoutingBackend routes the events of a Watcher with a VirtualService bound to the Istio Gateways selected
+// by the Watcher.
+type RoutingBackend struct {
+	client                *Client
+	virtualServiceFactory VirtualServiceFactory
+	gatewayNamespace      string
+}
+
+func NewRoutingBackend(client *Client, virtualServiceFactory VirtualServiceFactory,
+	gatewayNamespace string,
+) *RoutingBackend {
+	return &RoutingBackend{
+		client:                client,
+		virtualServiceFactory: virtualServiceFactory,
+		gatewayNamespace:      gatewayNamespace,
+	}
+}
+
+func (b *RoutingBackend) ConfigureRoute(ctx context.Context, watcher *v1beta2.Watcher) error {
+	gateways, err := b.client.ListGatewaysByLabelSelector(ctx, &watcher.Spec.Gateway.LabelSelector,
+		b.gatewayNamespace)
+	if err != nil {
+		return errors.Join(routing.ErrGatewayNotFound, err)
+	}
+	if len(gateways.Items) == 0 {
+		return fmt.Errorf("%w: %w", routing.ErrGatewayNotFound, ErrCantFindMatchingGateway)
+	}
+
+	virtualSvc, err := b.virtualServiceFactory.NewVirtualService(watcher, gateways)
+	if err != nil {
+		return err
+	}
+
+	virtualSvcRemote, err := b.client.GetVirtualService(ctx, watcher.GetName(), watcher.GetNamespace())
+	if err != nil && !util.IsNotFound(err) {
+		return err
+	}
+	if util.IsNotFound(err) {
+		if err = b.client.CreateVirtualService(ctx, virtualSvc); err != nil {
+			return fmt.Errorf("failed to create virtual service: %w", err)
+		}
+		return nil
+	}
+
+	if err = b.client.UpdateVirtualService(ctx, virtualSvc, virtualSvcRemote); err != nil {
+		return fmt.Errorf("failed to update virtual service: %w", err)
+	}
+	return nil
+}
+
+func (b *RoutingBackend) DeleteRoute(ctx context.Context, watcher *v1beta2.Watcher) error {
+	err := b.client.DeleteVirtualService(ctx, watcher.GetName(), watcher.GetNamespace())
+	if err != nil && !util.IsNotFound(err) {
+		return fmt.Errorf("failed to delete virtual service (config): %w", err)
+	}
+	return nil
+}
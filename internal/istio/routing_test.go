@@ -0,0 +1,110 @@
+package istio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istioapiv1beta1 "istio.io/api/networking/v1beta1"
+	istioclientapiv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/istio"
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/builder"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+const gatewayNamespace = "kcp-system"
+
+func Test_ConfigureRoute_CreatesVirtualService(t *testing.T) {
+	watcher := createWatcher()
+	istioClient := createIstioClient(t, createSelectedGateway())
+	backend := istio.NewRoutingBackend(istioClient, createVirtualServiceService(t), gatewayNamespace)
+
+	err := backend.ConfigureRoute(t.Context(), watcher)
+
+	require.NoError(t, err)
+	virtualService, err := istioClient.GetVirtualService(t.Context(), watcher.GetName(), watcher.GetNamespace())
+	require.NoError(t, err)
+	assert.Equal(t, []string{gatewayNamespace + "/klm-watcher"}, virtualService.Spec.GetGateways())
+}
+
+func Test_ConfigureRoute_UpdatesVirtualService(t *testing.T) {
+	watcher := createWatcher()
+	istioClient := createIstioClient(t, createSelectedGateway())
+	backend := istio.NewRoutingBackend(istioClient, createVirtualServiceService(t), gatewayNamespace)
+	require.NoError(t, backend.ConfigureRoute(t.Context(), watcher))
+	watcher.Spec.Manager = "updated-manager"
+
+	err := backend.ConfigureRoute(t.Context(), watcher)
+
+	require.NoError(t, err)
+	virtualService, err := istioClient.GetVirtualService(t.Context(), watcher.GetName(), watcher.GetNamespace())
+	require.NoError(t, err)
+	assert.Equal(t, routing.PathPrefix(watcher),
+		virtualService.Spec.GetHttp()[0].GetMatch()[0].GetUri().GetPrefix())
+}
+
+func Test_ConfigureRoute_ReturnsGatewayNotFound_WhenNoGatewayMatches(t *testing.T) {
+	watcher := createWatcher()
+	backend := istio.NewRoutingBackend(createIstioClient(t), createVirtualServiceService(t), gatewayNamespace)
+
+	err := backend.ConfigureRoute(t.Context(), watcher)
+
+	require.ErrorIs(t, err, routing.ErrGatewayNotFound)
+	require.ErrorIs(t, err, istio.ErrCantFindMatchingGateway)
+}
+
+func Test_DeleteRoute_DeletesVirtualService(t *testing.T) {
+	watcher := createWatcher()
+	istioClient := createIstioClient(t, createSelectedGateway())
+	backend := istio.NewRoutingBackend(istioClient, createVirtualServiceService(t), gatewayNamespace)
+	require.NoError(t, backend.ConfigureRoute(t.Context(), watcher))
+
+	err := backend.DeleteRoute(t.Context(), watcher)
+
+	require.NoError(t, err)
+	_, err = istioClient.GetVirtualService(t.Context(), watcher.GetName(), watcher.GetNamespace())
+	assert.True(t, util.IsNotFound(err))
+}
+
+func Test_DeleteRoute_IgnoresMissingVirtualService(t *testing.T) {
+	backend := istio.NewRoutingBackend(createIstioClient(t), createVirtualServiceService(t), gatewayNamespace)
+
+	err := backend.DeleteRoute(t.Context(), createWatcher())
+
+	require.NoError(t, err)
+}
+
+func createWatcher() *v1beta2.Watcher {
+	watcher := builder.NewWatcherBuilder().WithNamespace(gatewayNamespace).Build()
+	watcher.Spec.Gateway.LabelSelector = v1beta2.DefaultIstioGatewaySelector()
+	return watcher
+}
+
+func createSelectedGateway() *istioclientapiv1beta1.Gateway {
+	return &istioclientapiv1beta1.Gateway{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      "klm-watcher",
+			Namespace: gatewayNamespace,
+			Labels:    v1beta2.DefaultIstioGatewaySelector().MatchLabels,
+		},
+		Spec: istioapiv1beta1.Gateway{
+			Servers: []*istioapiv1beta1.Server{{Hosts: []string{"listener.kyma.example.com"}}},
+		},
+	}
+}
+
+func createIstioClient(t *testing.T, gateways ...*istioclientapiv1beta1.Gateway) *istio.Client {
+	t.Helper()
+	clientset := istiofake.NewSimpleClientset()
+	for _, gateway := range gateways {
+		_, err := clientset.NetworkingV1beta1().Gateways(gateway.GetNamespace()).
+			Create(t.Context(), gateway, apimetav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	return &istio.Client{Interface: clientset}
+}
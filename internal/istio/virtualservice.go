@@ -13,11 +13,6 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 )
 
-const (
-	contractVersion = "v2"
-	prefixFormat    = "/%s/%s/event"
-)
-
 type (
 	VirtualServiceFactory interface {
 		NewVirtualService(
@@ -0,0 +1,69 @@
+package maintenancewindows
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+)
+
+var ErrInvalidCustomerWindow = errors.New("invalid maintenance window annotations")
+
+var weekdays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// CustomerWindowOf reads the maintenance window chosen for the Kyma from its annotations and validates
+// that it is at least as long as the given minimum window size.
+// The second return value is false if the Kyma has no maintenance window annotations.
+func CustomerWindowOf(kyma *v1beta2.Kyma, minWindowSize time.Duration) (resolver.MaintenanceWindow, bool, error) {
+	begin, hasBegin := kyma.GetAnnotations()[shared.MaintenanceWindowBeginAnnotation]
+	end, hasEnd := kyma.GetAnnotations()[shared.MaintenanceWindowEndAnnotation]
+	if !hasBegin && !hasEnd {
+		return resolver.MaintenanceWindow{}, false, nil
+	}
+	if !hasBegin || !hasEnd {
+		return resolver.MaintenanceWindow{}, false, fmt.Errorf("%w: both %s and %s must be set",
+			ErrInvalidCustomerWindow, shared.MaintenanceWindowBeginAnnotation, shared.MaintenanceWindowEndAnnotation)
+	}
+
+	window := resolver.MaintenanceWindow{}
+	var err error
+	if window.Begin, err = resolver.ParseWindowTime(begin); err != nil {
+		return resolver.MaintenanceWindow{}, false, fmt.Errorf("%w: %s: %w",
+			ErrInvalidCustomerWindow, shared.MaintenanceWindowBeginAnnotation, err)
+	}
+	if window.End, err = resolver.ParseWindowTime(end); err != nil {
+		return resolver.MaintenanceWindow{}, false, fmt.Errorf("%w: %s: %w",
+			ErrInvalidCustomerWindow, shared.MaintenanceWindowEndAnnotation, err)
+	}
+	if days, hasDays := kyma.GetAnnotations()[shared.MaintenanceDaysAnnotation]; hasDays {
+		for day := range strings.SplitSeq(days, ",") {
+			day = strings.TrimSpace(day)
+			if !slices.Contains(weekdays, day) {
+				return resolver.MaintenanceWindow{}, false, fmt.Errorf("%w: %s: unknown weekday %q",
+					ErrInvalidCustomerWindow, shared.MaintenanceDaysAnnotation, day)
+			}
+			window.Days = append(window.Days, day)
+		}
+	}
+	if len(window.Days) == 0 && (isTimeOfDay(window.Begin) || isTimeOfDay(window.End)) {
+		return resolver.MaintenanceWindow{}, false, fmt.Errorf("%w: a window of times of the day requires %s",
+			ErrInvalidCustomerWindow, shared.MaintenanceDaysAnnotation)
+	}
+
+	if window.Duration() < minWindowSize {
+		return resolver.MaintenanceWindow{}, false, fmt.Errorf(
+			"%w: the window of %s is shorter than the minimum maintenance window size of %s",
+			ErrInvalidCustomerWindow, window.Duration(), minWindowSize)
+	}
+	return window, true, nil
+}
+
+// isTimeOfDay reports whether the window time was parsed from a time-only value, which has no date.
+func isTimeOfDay(windowTime resolver.WindowTime) bool {
+	return windowTime.T().Year() == 0
+}
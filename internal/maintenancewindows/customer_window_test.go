@@ -0,0 +1,128 @@
+package maintenancewindows_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/builder"
+)
+
+func TestCustomerWindowOf_WhenNoAnnotations_ReturnsFalse(t *testing.T) {
+	_, ok, err := maintenancewindows.CustomerWindowOf(builder.NewKymaBuilder().Build(), time.Hour)
+
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestCustomerWindowOf_WhenRecurringWindow_ReturnsWindow(t *testing.T) {
+	kyma := builder.NewKymaBuilder().
+		WithAnnotation(shared.MaintenanceWindowBeginAnnotation, "22:00:00+01:00").
+		WithAnnotation(shared.MaintenanceWindowEndAnnotation, "02:00:00+01:00").
+		WithAnnotation(shared.MaintenanceDaysAnnotation, "Sat, Sun").
+		Build()
+
+	window, ok, err := maintenancewindows.CustomerWindowOf(kyma, time.Hour)
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"Sat", "Sun"}, window.Days)
+	assert.Equal(t, 21, window.Begin.T().UTC().Hour())
+	assert.Equal(t, 4*time.Hour, window.Duration())
+}
+
+func TestCustomerWindowOf_WhenAbsoluteWindow_ReturnsWindow(t *testing.T) {
+	kyma := builder.NewKymaBuilder().
+		WithAnnotation(shared.MaintenanceWindowBeginAnnotation, "2026-01-10T02:00:00Z").
+		WithAnnotation(shared.MaintenanceWindowEndAnnotation, "2026-01-10T06:00:00Z").
+		Build()
+
+	window, ok, err := maintenancewindows.CustomerWindowOf(kyma, time.Hour)
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Empty(t, window.Days)
+	assert.Equal(t, time.Date(2026, time.January, 10, 2, 0, 0, 0, time.UTC), window.Begin.T())
+}
+
+func TestCustomerWindowOf_WhenInvalid_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		errContains string
+	}{
+		{
+			name:        "end missing",
+			annotations: map[string]string{shared.MaintenanceWindowBeginAnnotation: "02:00:00Z"},
+			errContains: "must be set",
+		},
+		{
+			name: "invalid begin",
+			annotations: map[string]string{
+				shared.MaintenanceWindowBeginAnnotation: "tonight",
+				shared.MaintenanceWindowEndAnnotation:   "06:00:00Z",
+			},
+			errContains: shared.MaintenanceWindowBeginAnnotation,
+		},
+		{
+			name: "unknown weekday",
+			annotations: map[string]string{
+				shared.MaintenanceWindowBeginAnnotation: "02:00:00Z",
+				shared.MaintenanceWindowEndAnnotation:   "06:00:00Z",
+				shared.MaintenanceDaysAnnotation:        "Saturday",
+			},
+			errContains: "unknown weekday",
+		},
+		{
+			name: "times of the day without weekdays",
+			annotations: map[string]string{
+				shared.MaintenanceWindowBeginAnnotation: "02:00:00Z",
+				shared.MaintenanceWindowEndAnnotation:   "06:00:00Z",
+			},
+			errContains: "requires " + shared.MaintenanceDaysAnnotation,
+		},
+		{
+			name: "time of the day and timestamp without weekdays",
+			annotations: map[string]string{
+				shared.MaintenanceWindowBeginAnnotation: "2026-01-10T02:00:00Z",
+				shared.MaintenanceWindowEndAnnotation:   "06:00:00Z",
+			},
+			errContains: "requires " + shared.MaintenanceDaysAnnotation,
+		},
+		{
+			name: "shorter than the minimum window size",
+			annotations: map[string]string{
+				shared.MaintenanceWindowBeginAnnotation: "02:00:00Z",
+				shared.MaintenanceWindowEndAnnotation:   "02:30:00Z",
+				shared.MaintenanceDaysAnnotation:        "Sat",
+			},
+			errContains: "shorter than the minimum maintenance window size",
+		},
+		{
+			name: "absolute window ending before its begin",
+			annotations: map[string]string{
+				shared.MaintenanceWindowBeginAnnotation: "2026-01-10T06:00:00Z",
+				shared.MaintenanceWindowEndAnnotation:   "2026-01-10T02:00:00Z",
+			},
+			errContains: "shorter than the minimum maintenance window size",
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			kymaBuilder := builder.NewKymaBuilder()
+			for key, value := range testCase.annotations {
+				kymaBuilder = kymaBuilder.WithAnnotation(key, value)
+			}
+
+			_, ok, err := maintenancewindows.CustomerWindowOf(kymaBuilder.Build(), time.Hour)
+
+			assert.False(t, ok)
+			require.ErrorIs(t, err, maintenancewindows.ErrInvalidCustomerWindow)
+			assert.ErrorContains(t, err, testCase.errContains)
+		})
+	}
+}
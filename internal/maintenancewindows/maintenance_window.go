@@ -19,6 +19,7 @@ var (
 
 type MaintenanceWindowPolicy interface {
 	Resolve(runtime *resolver.Runtime, opts ...any) (*resolver.ResolvedWindow, error)
+	ActiveBlackout(runtime *resolver.Runtime, at time.Time) (resolver.ResolvedWindow, bool)
 }
 
 type MaintenanceWindow struct {
@@ -67,6 +68,14 @@ func InitializeMaintenanceWindow(log logr.Logger,
 	}, nil
 }
 
+// NewMaintenanceWindow creates a MaintenanceWindow resolving windows from the given policy.
+func NewMaintenanceWindow(policy MaintenanceWindowPolicy, minWindowSize time.Duration) MaintenanceWindow {
+	return MaintenanceWindow{
+		MaintenanceWindowPolicy: policy,
+		minDuration:             resolver.MinWindowSize(minWindowSize),
+	}
+}
+
 func MaintenancePolicyFileExists(policyFilePath string) bool {
 	if _, err := os.Stat(policyFilePath); os.IsNotExist(err) {
 		return false
@@ -95,22 +104,28 @@ func (MaintenanceWindow) IsRequired(moduleTemplate *v1beta2.ModuleTemplate, kyma
 	return moduleStatus.Version != moduleTemplate.Spec.Version
 }
 
-// IsActive determines if a maintenance window is currently active.
-func (mw MaintenanceWindow) IsActive(kyma *v1beta2.Kyma) (bool, error) {
-	if mw.MaintenanceWindowPolicy == nil {
-		return false, ErrNoMaintenanceWindowPolicyConfigured
+// IsVersionChange determines if the given module template changes the version of an installed module.
+// Blackout periods hold back such changes regardless of whether the module requires downtime.
+func (MaintenanceWindow) IsVersionChange(moduleTemplate *v1beta2.ModuleTemplate, kyma *v1beta2.Kyma) bool {
+	moduleStatus := kyma.Status.GetModuleStatus(moduleTemplate.Spec.ModuleName)
+	if moduleStatus == nil {
+		return false
 	}
+	return moduleStatus.Version != moduleTemplate.Spec.Version
+}
 
-	runtime := &resolver.Runtime{
-		GlobalAccountID: kyma.GetGlobalAccount(),
-		Region:          kyma.GetRegion(),
-		PlatformRegion:  kyma.GetPlatformRegion(),
-		Plan:            kyma.GetPlan(),
+// ActiveBlackout returns the blackout period the given Kyma is currently in.
+// The second return value is false if no blackout period is active or no policy is configured.
+func (mw MaintenanceWindow) ActiveBlackout(kyma *v1beta2.Kyma) (resolver.ResolvedWindow, bool) {
+	if mw.MaintenanceWindowPolicy == nil {
+		return resolver.ResolvedWindow{}, false
 	}
+	return mw.MaintenanceWindowPolicy.ActiveBlackout(RuntimeOf(kyma), time.Now())
+}
 
-	resolvedWindow, err := mw.MaintenanceWindowPolicy.Resolve(runtime,
-		resolver.OngoingWindow(true),
-		mw.minDuration)
+// IsActive determines if a maintenance window is currently active.
+func (mw MaintenanceWindow) IsActive(kyma *v1beta2.Kyma) (bool, error) {
+	resolvedWindow, err := mw.NextWindow(kyma)
 	if err != nil {
 		return false, err
 	}
@@ -122,3 +137,41 @@ func (mw MaintenanceWindow) IsActive(kyma *v1beta2.Kyma) (bool, error) {
 
 	return false, nil
 }
+
+// NextWindow resolves the next maintenance window for the given Kyma.
+// An ongoing window is returned if it is at least as long as the minimum window size.
+// The maintenance window chosen for the Kyma in its annotations is passed to the policy.
+func (mw MaintenanceWindow) NextWindow(kyma *v1beta2.Kyma) (*resolver.ResolvedWindow, error) {
+	if mw.MaintenanceWindowPolicy == nil {
+		return nil, ErrNoMaintenanceWindowPolicyConfigured
+	}
+
+	runtime := RuntimeOf(kyma)
+	customerWindow, ok, err := CustomerWindowOf(kyma, time.Duration(mw.minDuration))
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		runtime.MaintenanceWindowBegin = customerWindow.Begin.T()
+		runtime.MaintenanceWindowEnd = customerWindow.End.T()
+		runtime.MaintenanceDays = customerWindow.Days
+	}
+
+	resolvedWindow, err := mw.MaintenanceWindowPolicy.Resolve(runtime,
+		resolver.OngoingWindow(true),
+		mw.minDuration)
+	if err != nil {
+		return nil, err
+	}
+	return resolvedWindow, nil
+}
+
+// RuntimeOf returns the attributes of the Kyma that maintenance window policies match on.
+func RuntimeOf(kyma *v1beta2.Kyma) *resolver.Runtime {
+	return &resolver.Runtime{
+		GlobalAccountID: kyma.GetGlobalAccount(),
+		Region:          kyma.GetRegion(),
+		PlatformRegion:  kyma.GetPlatformRegion(),
+		Plan:            kyma.GetPlan(),
+	}
+}
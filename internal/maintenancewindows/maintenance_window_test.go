@@ -313,9 +313,146 @@ func Test_IsActive_Returns_False_And_Error_WhenNoPolicyConfigured(t *testing.T)
 	require.ErrorIs(t, err, maintenancewindows.ErrNoMaintenanceWindowPolicyConfigured)
 }
 
+func Test_NextWindow_Returns_ResolvedWindow(t *testing.T) {
+	maintenanceWindow := maintenancewindows.MaintenanceWindow{
+		MaintenanceWindowPolicy: maintenanceWindowInactiveStub{},
+	}
+
+	kyma := builder.NewKymaBuilder().Build()
+
+	result, err := maintenanceWindow.NextWindow(kyma)
+
+	require.NoError(t, err)
+	assert.True(t, result.Begin.After(time.Now()))
+	assert.True(t, result.End.After(result.Begin))
+}
+
+func Test_NextWindow_Returns_Error_WhenNoPolicyConfigured(t *testing.T) {
+	maintenanceWindow := maintenancewindows.MaintenanceWindow{
+		MaintenanceWindowPolicy: nil,
+	}
+
+	kyma := builder.NewKymaBuilder().Build()
+
+	result, err := maintenanceWindow.NextWindow(kyma)
+
+	assert.Nil(t, result)
+	require.ErrorIs(t, err, maintenancewindows.ErrNoMaintenanceWindowPolicyConfigured)
+}
+
+func Test_NextWindow_PassesCustomerWindowToPolicy(t *testing.T) {
+	receivedRuntime := resolver.Runtime{}
+	maintenanceWindow := maintenancewindows.NewMaintenanceWindow(maintenanceWindowRuntimeArgStub{
+		receivedRuntime: &receivedRuntime,
+	}, time.Hour)
+	kyma := builder.NewKymaBuilder().
+		WithAnnotation(shared.MaintenanceWindowBeginAnnotation, "02:00:00Z").
+		WithAnnotation(shared.MaintenanceWindowEndAnnotation, "06:00:00Z").
+		WithAnnotation(shared.MaintenanceDaysAnnotation, "Sat").
+		Build()
+
+	_, err := maintenanceWindow.NextWindow(kyma)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Sat"}, receivedRuntime.MaintenanceDays)
+	assert.Equal(t, 2, receivedRuntime.MaintenanceWindowBegin.Hour())
+	assert.Equal(t, 6, receivedRuntime.MaintenanceWindowEnd.Hour())
+}
+
+func Test_NextWindow_Returns_Error_WhenCustomerWindowIsTooShort(t *testing.T) {
+	maintenanceWindow := maintenancewindows.NewMaintenanceWindow(maintenanceWindowInactiveStub{}, 2*time.Hour)
+	kyma := builder.NewKymaBuilder().
+		WithAnnotation(shared.MaintenanceWindowBeginAnnotation, "02:00:00Z").
+		WithAnnotation(shared.MaintenanceWindowEndAnnotation, "03:00:00Z").
+		WithAnnotation(shared.MaintenanceDaysAnnotation, "Sat").
+		Build()
+
+	result, err := maintenanceWindow.NextWindow(kyma)
+
+	assert.Nil(t, result)
+	require.ErrorIs(t, err, maintenancewindows.ErrInvalidCustomerWindow)
+}
+
+func Test_IsVersionChange(t *testing.T) {
+	maintenanceWindow := maintenancewindows.MaintenanceWindow{}
+	template := builder.NewModuleTemplateBuilder().
+		WithModuleName("module").
+		WithVersion("2.0.0").
+		Build()
+
+	tests := []struct {
+		name string
+		kyma *v1beta2.Kyma
+		want bool
+	}{
+		{
+			name: "module not installed",
+			kyma: builder.NewKymaBuilder().Build(),
+			want: false,
+		},
+		{
+			name: "module installed in the same version",
+			kyma: builder.NewKymaBuilder().
+				WithModuleStatus(v1beta2.ModuleStatus{Name: "module", Version: "2.0.0"}).
+				Build(),
+			want: false,
+		},
+		{
+			name: "module installed in another version",
+			kyma: builder.NewKymaBuilder().
+				WithModuleStatus(v1beta2.ModuleStatus{Name: "module", Version: "1.0.0"}).
+				Build(),
+			want: true,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, maintenanceWindow.IsVersionChange(template, testCase.kyma))
+		})
+	}
+}
+
+func Test_ActiveBlackout_Returns_Blackout_FromPolicy(t *testing.T) {
+	blackout := resolver.ResolvedWindow{Begin: time.Now().Add(-time.Hour), End: time.Now().Add(time.Hour)}
+	maintenanceWindow := maintenancewindows.MaintenanceWindow{
+		MaintenanceWindowPolicy: maintenanceWindowInactiveStub{
+			noBlackoutStub: noBlackoutStub{blackout: &blackout},
+		},
+	}
+
+	result, active := maintenanceWindow.ActiveBlackout(builder.NewKymaBuilder().Build())
+
+	assert.True(t, active)
+	assert.Equal(t, blackout, result)
+}
+
+func Test_ActiveBlackout_Returns_False_WhenNoPolicyConfigured(t *testing.T) {
+	maintenanceWindow := maintenancewindows.MaintenanceWindow{
+		MaintenanceWindowPolicy: nil,
+	}
+
+	_, active := maintenanceWindow.ActiveBlackout(builder.NewKymaBuilder().Build())
+
+	assert.False(t, active)
+}
+
 // test stubs
 
-type maintenanceWindowInactiveStub struct{}
+// noBlackoutStub reports the configured blackout, or none if it is nil.
+type noBlackoutStub struct {
+	blackout *resolver.ResolvedWindow
+}
+
+func (s noBlackoutStub) ActiveBlackout(_ *resolver.Runtime, _ time.Time) (resolver.ResolvedWindow, bool) {
+	if s.blackout == nil {
+		return resolver.ResolvedWindow{}, false
+	}
+	return *s.blackout, true
+}
+
+type maintenanceWindowInactiveStub struct {
+	noBlackoutStub
+}
 
 func (s maintenanceWindowInactiveStub) Resolve(_ *resolver.Runtime,
 	_ ...any,
@@ -326,7 +463,9 @@ func (s maintenanceWindowInactiveStub) Resolve(_ *resolver.Runtime,
 	}, nil
 }
 
-type maintenanceWindowActiveStub struct{}
+type maintenanceWindowActiveStub struct {
+	noBlackoutStub
+}
 
 func (s maintenanceWindowActiveStub) Resolve(_ *resolver.Runtime, _ ...any) (
 	*resolver.ResolvedWindow,
@@ -338,7 +477,9 @@ func (s maintenanceWindowActiveStub) Resolve(_ *resolver.Runtime, _ ...any) (
 	}, nil
 }
 
-type maintenanceWindowErrorStub struct{}
+type maintenanceWindowErrorStub struct {
+	noBlackoutStub
+}
 
 func (s maintenanceWindowErrorStub) Resolve(_ *resolver.Runtime, _ ...any) (
 	*resolver.ResolvedWindow,
@@ -348,6 +489,8 @@ func (s maintenanceWindowErrorStub) Resolve(_ *resolver.Runtime, _ ...any) (
 }
 
 type maintenanceWindowRuntimeArgStub struct {
+	noBlackoutStub
+
 	receivedRuntime *resolver.Runtime
 }
 
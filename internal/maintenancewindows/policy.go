@@ -0,0 +1,108 @@
+package maintenancewindows
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+)
+
+var ErrInvalidPolicy = errors.New("invalid maintenance window policy")
+
+// NoMatchingRule is returned by MatchingRule if no rule of the policy matches the runtime.
+const NoMatchingRule = -1
+
+// NewPolicyFromResource converts the spec of a MaintenanceWindowPolicy resource into a resolvable policy.
+// All invalid regular expressions and times are reported in the returned error.
+func NewPolicyFromResource(spec v1beta2.MaintenanceWindowPolicySpec) (*resolver.MaintenanceWindowPolicy, error) {
+	var errs []error
+	policy := &resolver.MaintenanceWindowPolicy{
+		Rules: make([]resolver.MaintenancePolicyRule, 0, len(spec.Rules)),
+	}
+
+	for ruleIdx, rule := range spec.Rules {
+		path := fmt.Sprintf("spec.rules[%d]", ruleIdx)
+		policyRule := resolver.MaintenancePolicyRule{
+			Match:           parseMatch(rule.Match, path+".match", &errs),
+			Windows:         make(resolver.MaintenanceWindows, 0, len(rule.Windows)),
+			CustomerWindows: resolver.CustomerWindowMode(rule.CustomerWindows),
+		}
+		for windowIdx, window := range rule.Windows {
+			policyRule.Windows = append(policyRule.Windows,
+				parseWindow(window, fmt.Sprintf("%s.windows[%d]", path, windowIdx), &errs))
+		}
+		policy.Rules = append(policy.Rules, policyRule)
+	}
+	policy.Default = parseWindow(spec.Default, "spec.default", &errs)
+
+	for blackoutIdx, blackout := range spec.Blackouts {
+		path := fmt.Sprintf("spec.blackouts[%d]", blackoutIdx)
+		policyBlackout := resolver.MaintenancePolicyBlackout{
+			Windows: make(resolver.MaintenanceWindows, 0, len(blackout.Windows)),
+		}
+		if blackout.Match != nil {
+			match := parseMatch(*blackout.Match, path+".match", &errs)
+			policyBlackout.Match = &match
+		}
+		for windowIdx, window := range blackout.Windows {
+			policyBlackout.Windows = append(policyBlackout.Windows,
+				parseWindow(window, fmt.Sprintf("%s.windows[%d]", path, windowIdx), &errs))
+		}
+		policy.Blackouts = append(policy.Blackouts, policyBlackout)
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPolicy, errors.Join(errs...))
+	}
+	return policy, nil
+}
+
+// MatchingRule returns the index of the first rule of the policy matching the runtime,
+// or NoMatchingRule if the runtime falls back to the default window.
+func MatchingRule(policy *resolver.MaintenanceWindowPolicy, runtime *resolver.Runtime) int {
+	for idx, rule := range policy.Rules {
+		if rule.Match.Match(runtime) {
+			return idx
+		}
+	}
+	return NoMatchingRule
+}
+
+func parseMatch(match v1beta2.MaintenancePolicyMatch, path string, errs *[]error) resolver.MaintenancePolicyMatch {
+	return resolver.MaintenancePolicyMatch{
+		GlobalAccountID: parseRegexp(match.GlobalAccountID, path+".globalAccountID", errs),
+		Plan:            parseRegexp(match.Plan, path+".plan", errs),
+		Region:          parseRegexp(match.Region, path+".region", errs),
+		PlatformRegion:  parseRegexp(match.PlatformRegion, path+".platformRegion", errs),
+	}
+}
+
+func parseRegexp(pattern, path string, errs *[]error) *resolver.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("%s: %w", path, err))
+		return nil
+	}
+	return &resolver.Regexp{Str: pattern, Regexp: compiled}
+}
+
+func parseWindow(window v1beta2.MaintenancePolicyWindow, path string, errs *[]error) resolver.MaintenanceWindow {
+	begin, err := resolver.ParseWindowTime(window.Begin)
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("%s.begin: %w", path, err))
+	}
+	end, err := resolver.ParseWindowTime(window.End)
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("%s.end: %w", path, err))
+	}
+	return resolver.MaintenanceWindow{
+		Days:  window.Days,
+		Begin: begin,
+		End:   end,
+	}
+}
@@ -0,0 +1,59 @@
+package maintenancewindows
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+)
+
+// PolicySource resolves maintenance windows from the policy loaded from the MaintenanceWindowPolicy resource.
+// If no policy is loaded, it falls back to the policy read from the JSON file on startup.
+// The loaded policy can be replaced at any time, so changes to the resource apply without a restart.
+type PolicySource struct {
+	mu       sync.RWMutex
+	policy   MaintenanceWindowPolicy
+	fallback MaintenanceWindowPolicy
+}
+
+// NewPolicySource creates a PolicySource with the given fallback policy, which may be nil.
+func NewPolicySource(fallback MaintenanceWindowPolicy) *PolicySource {
+	return &PolicySource{
+		fallback: fallback,
+	}
+}
+
+// SetPolicy replaces the loaded policy. A nil policy restores the fallback policy.
+func (s *PolicySource) SetPolicy(policy MaintenanceWindowPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy = policy
+}
+
+// Resolve resolves the maintenance window with the loaded policy, or with the fallback policy if none is loaded.
+func (s *PolicySource) Resolve(runtime *resolver.Runtime, opts ...any) (*resolver.ResolvedWindow, error) {
+	policy := s.current()
+	if policy == nil {
+		return nil, ErrNoMaintenanceWindowPolicyConfigured
+	}
+	return policy.Resolve(runtime, opts...)
+}
+
+// ActiveBlackout returns the blackout period of the loaded policy, or of the fallback policy if none is loaded,
+// the runtime is in at the given time.
+func (s *PolicySource) ActiveBlackout(runtime *resolver.Runtime, at time.Time) (resolver.ResolvedWindow, bool) {
+	policy := s.current()
+	if policy == nil {
+		return resolver.ResolvedWindow{}, false
+	}
+	return policy.ActiveBlackout(runtime, at)
+}
+
+func (s *PolicySource) current() MaintenanceWindowPolicy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.policy != nil {
+		return s.policy
+	}
+	return s.fallback
+}
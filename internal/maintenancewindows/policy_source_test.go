@@ -0,0 +1,90 @@
+package maintenancewindows_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+)
+
+type staticPolicy struct {
+	window *resolver.ResolvedWindow
+}
+
+func (p staticPolicy) Resolve(_ *resolver.Runtime, _ ...any) (*resolver.ResolvedWindow, error) {
+	return p.window, nil
+}
+
+func (p staticPolicy) ActiveBlackout(_ *resolver.Runtime, _ time.Time) (resolver.ResolvedWindow, bool) {
+	return *p.window, true
+}
+
+func TestPolicySource_WhenNoPolicyLoaded_UsesFallback(t *testing.T) {
+	fallback := staticPolicy{window: resolvedWindowAt(1)}
+	source := maintenancewindows.NewPolicySource(fallback)
+
+	got, err := source.Resolve(&resolver.Runtime{})
+
+	require.NoError(t, err)
+	assert.Equal(t, fallback.window, got)
+}
+
+func TestPolicySource_WhenPolicyLoaded_UsesLoadedPolicy(t *testing.T) {
+	loaded := staticPolicy{window: resolvedWindowAt(2)}
+	source := maintenancewindows.NewPolicySource(staticPolicy{window: resolvedWindowAt(1)})
+
+	source.SetPolicy(loaded)
+	got, err := source.Resolve(&resolver.Runtime{})
+
+	require.NoError(t, err)
+	assert.Equal(t, loaded.window, got)
+}
+
+func TestPolicySource_WhenPolicyReset_UsesFallbackAgain(t *testing.T) {
+	fallback := staticPolicy{window: resolvedWindowAt(1)}
+	source := maintenancewindows.NewPolicySource(fallback)
+	source.SetPolicy(staticPolicy{window: resolvedWindowAt(2)})
+
+	source.SetPolicy(nil)
+	got, err := source.Resolve(&resolver.Runtime{})
+
+	require.NoError(t, err)
+	assert.Equal(t, fallback.window, got)
+}
+
+func TestPolicySource_WhenNoPolicyAvailable_ReturnsError(t *testing.T) {
+	source := maintenancewindows.NewPolicySource(nil)
+
+	got, err := source.Resolve(&resolver.Runtime{})
+
+	require.Nil(t, got)
+	require.ErrorIs(t, err, maintenancewindows.ErrNoMaintenanceWindowPolicyConfigured)
+}
+
+func TestPolicySource_ActiveBlackout_UsesLoadedPolicy(t *testing.T) {
+	loaded := staticPolicy{window: resolvedWindowAt(2)}
+	source := maintenancewindows.NewPolicySource(staticPolicy{window: resolvedWindowAt(1)})
+	source.SetPolicy(loaded)
+
+	got, active := source.ActiveBlackout(&resolver.Runtime{}, time.Now())
+
+	assert.True(t, active)
+	assert.Equal(t, *loaded.window, got)
+}
+
+func TestPolicySource_ActiveBlackout_WhenNoPolicyAvailable_ReturnsFalse(t *testing.T) {
+	source := maintenancewindows.NewPolicySource(nil)
+
+	_, active := source.ActiveBlackout(&resolver.Runtime{}, time.Now())
+
+	assert.False(t, active)
+}
+
+func resolvedWindowAt(day int) *resolver.ResolvedWindow {
+	begin := time.Date(2026, time.January, day, 2, 0, 0, 0, time.UTC)
+	return &resolver.ResolvedWindow{Begin: begin, End: begin.Add(4 * time.Hour)}
+}
@@ -0,0 +1,116 @@
+package maintenancewindows_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+)
+
+func TestNewPolicyFromResource_WhenSpecIsValid_ReturnsPolicy(t *testing.T) {
+	policy, err := maintenancewindows.NewPolicyFromResource(validPolicySpec())
+
+	require.NoError(t, err)
+	require.Len(t, policy.Rules, 2)
+	assert.Equal(t, "trial", policy.Rules[0].Match.Plan.Str)
+	assert.Nil(t, policy.Rules[0].Match.Region)
+	assert.Equal(t, "europe-.*", policy.Rules[1].Match.Region.Str)
+	assert.Equal(t, resolver.CustomerWindowsIgnore, policy.Rules[0].CustomerWindows)
+	assert.Equal(t, resolver.CustomerWindowsIntersect, policy.Rules[1].CustomerWindows)
+	require.Len(t, policy.Rules[0].Windows, 1)
+	assert.Equal(t, []string{"Sat", "Sun"}, policy.Rules[0].Windows[0].Days)
+	assert.Equal(t, "02:00:00", policy.Rules[0].Windows[0].Begin.T().Format("15:04:05"))
+	assert.Equal(t, 2026, policy.Default.Begin.T().Year())
+	require.Len(t, policy.Blackouts, 2)
+	assert.Nil(t, policy.Blackouts[0].Match)
+	require.NotNil(t, policy.Blackouts[1].Match)
+	assert.Equal(t, "ga-1", policy.Blackouts[1].Match.GlobalAccountID.Str)
+	assert.Len(t, policy.Blackouts[1].Windows, 1)
+}
+
+func TestNewPolicyFromResource_WhenSpecIsInvalid_ReturnsAllErrorsWithPath(t *testing.T) {
+	spec := validPolicySpec()
+	spec.Rules[0].Match.Plan = "trial("
+	spec.Rules[1].Windows[0].End = "25:00"
+	spec.Default.Begin = "tomorrow"
+	spec.Blackouts[1].Match.GlobalAccountID = "ga-["
+
+	policy, err := maintenancewindows.NewPolicyFromResource(spec)
+
+	require.Nil(t, policy)
+	require.ErrorIs(t, err, maintenancewindows.ErrInvalidPolicy)
+	assert.ErrorContains(t, err, "spec.rules[0].match.plan")
+	assert.ErrorContains(t, err, "spec.rules[1].windows[0].end")
+	assert.ErrorContains(t, err, "spec.default.begin")
+	assert.ErrorContains(t, err, "spec.blackouts[1].match.globalAccountID")
+}
+
+func TestMatchingRule_ReturnsFirstMatchingRule(t *testing.T) {
+	policy, err := maintenancewindows.NewPolicyFromResource(validPolicySpec())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		runtime *resolver.Runtime
+		want    int
+	}{
+		{
+			name:    "first rule",
+			runtime: &resolver.Runtime{Plan: "trial", Region: "europe-west1"},
+			want:    0,
+		},
+		{
+			name:    "second rule",
+			runtime: &resolver.Runtime{Plan: "aws", Region: "europe-west1"},
+			want:    1,
+		},
+		{
+			name:    "no rule",
+			runtime: &resolver.Runtime{Plan: "aws", Region: "us-east1"},
+			want:    maintenancewindows.NoMatchingRule,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, maintenancewindows.MatchingRule(policy, testCase.runtime))
+		})
+	}
+}
+
+func validPolicySpec() v1beta2.MaintenanceWindowPolicySpec {
+	return v1beta2.MaintenanceWindowPolicySpec{
+		Rules: []v1beta2.MaintenancePolicyRule{
+			{
+				Match: v1beta2.MaintenancePolicyMatch{Plan: "trial"},
+				Windows: []v1beta2.MaintenancePolicyWindow{
+					{Days: []string{"Sat", "Sun"}, Begin: "02:00:00+00:00", End: "06:00:00+00:00"},
+				},
+			},
+			{
+				Match:           v1beta2.MaintenancePolicyMatch{Region: "europe-.*"},
+				CustomerWindows: "Intersect",
+				Windows: []v1beta2.MaintenancePolicyWindow{
+					{Begin: "2026-01-10T02:00:00Z", End: "2026-01-10T06:00:00Z"},
+				},
+			},
+		},
+		Default: v1beta2.MaintenancePolicyWindow{Begin: "2026-01-01T00:00:00Z", End: "2026-01-01T04:00:00Z"},
+		Blackouts: []v1beta2.MaintenancePolicyBlackout{
+			{
+				Windows: []v1beta2.MaintenancePolicyWindow{
+					{Begin: "2025-12-20T00:00:00Z", End: "2026-01-05T00:00:00Z"},
+				},
+			},
+			{
+				Match: &v1beta2.MaintenancePolicyMatch{GlobalAccountID: "ga-1"},
+				Windows: []v1beta2.MaintenancePolicyWindow{
+					{Days: []string{"Fri"}, Begin: "18:00:00Z", End: "06:00:00Z"},
+				},
+			},
+		},
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/filemutex"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
 )
 
 var (
@@ -32,10 +33,25 @@ var (
 
 type PathExtractor struct {
 	fileMutexCache *filemutex.MutexCache
+	layerCache     *layercache.Cache
 }
 
-func NewPathExtractor() *PathExtractor {
-	return &PathExtractor{fileMutexCache: filemutex.NewMutexCache(nil)}
+func NewPathExtractor(opts ...func(*PathExtractor) *PathExtractor) *PathExtractor {
+	pathExtractor := &PathExtractor{fileMutexCache: filemutex.NewMutexCache(nil)}
+	for _, opt := range opts {
+		pathExtractor = opt(pathExtractor)
+	}
+	return pathExtractor
+}
+
+// WithLayerCache stores the fetched layers in the managed layer cache instead of the temporary directory.
+// The PathExtractor shares the locks of the cache, so that layers are never evicted while they are written.
+func WithLayerCache(layerCache *layercache.Cache) func(*PathExtractor) *PathExtractor {
+	return func(p *PathExtractor) *PathExtractor {
+		p.layerCache = layerCache
+		p.fileMutexCache = layerCache.FileMutexCache()
+		return p
+	}
 }
 
 func (p PathExtractor) GetPathFromRawManifest(
@@ -65,12 +81,31 @@ func (p PathExtractor) GetPathForFetchedLayer(ctx context.Context,
 	imageSpec v1beta2.ImageSpec,
 	keyChain authn.Keychain,
 	filename string,
+) (string, error) {
+	if p.layerCache == nil {
+		return p.fetchLayer(ctx, imageSpec, keyChain, getFsChartPath(imageSpec), filename)
+	}
+
+	manifestPath, err := p.fetchLayer(ctx, imageSpec, keyChain,
+		p.layerCache.LayerDir(imageSpec.Name, imageSpec.Ref), filename)
+	if err != nil {
+		return "", err
+	}
+	// the lock of the layer is released, so that the eviction can lock the layers it removes
+	p.layerCache.Shrink()
+	return manifestPath, nil
+}
+
+func (p PathExtractor) fetchLayer(ctx context.Context,
+	imageSpec v1beta2.ImageSpec,
+	keyChain authn.Keychain,
+	installPath string,
+	filename string,
 ) (string, error) {
 	imageRef := fmt.Sprintf("%s/%s/%s@%s", imageSpec.Repo, componentmapping.ComponentDescriptorNamespace,
 		imageSpec.Name, imageSpec.Ref,
 	)
 
-	installPath := getFsChartPath(imageSpec)
 	manifestPath := path.Join(installPath, filename)
 
 	fileMutex, err := p.fileMutexCache.GetLocker(installPath)
@@ -80,12 +115,18 @@ func (p PathExtractor) GetPathForFetchedLayer(ctx context.Context,
 	fileMutex.Lock()
 	defer fileMutex.Unlock()
 
-	dir, err := os.Open(manifestPath)
-	if err != nil && !errors.Is(err, fs.ErrNotExist) {
-		return "", fmt.Errorf("opening dir for installs caused an error %s: %w", imageRef, err)
-	}
-	if dir != nil {
-		return manifestPath, nil
+	if p.layerCache != nil {
+		if p.layerCache.Lookup(installPath, filename) {
+			return manifestPath, nil
+		}
+	} else {
+		dir, err := os.Open(manifestPath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("opening dir for installs caused an error %s: %w", imageRef, err)
+		}
+		if dir != nil {
+			return manifestPath, nil
+		}
 	}
 
 	imgLayer, err := pullLayer(ctx, imageRef, keyChain)
@@ -93,6 +134,14 @@ func (p PathExtractor) GetPathForFetchedLayer(ctx context.Context,
 		return "", err
 	}
 
+	if p.layerCache != nil {
+		manifestPath, err := p.layerCache.Store(installPath, filename, imgLayer)
+		if err != nil {
+			return "", fmt.Errorf("failed to cache layer %s: %w", imageRef, err)
+		}
+		return manifestPath, nil
+	}
+
 	// copy uncompressed manifest to install path
 	blobReadCloser, err := imgLayer.Uncompressed()
 	if err != nil {
@@ -121,8 +170,10 @@ func (p PathExtractor) GetPathForFetchedLayer(ctx context.Context,
 	return manifestPath, nil
 }
 
+// ExtractLayer extracts the single file of the tar archive next to it. It locks the directory of the archive,
+// which is the same lock the layer cache takes to evict the layer.
 func (p PathExtractor) ExtractLayer(tarPath string) (string, error) {
-	fileMutex, err := p.fileMutexCache.GetLocker(tarPath)
+	fileMutex, err := p.fileMutexCache.GetLocker(filepath.Dir(tarPath))
 	if err != nil {
 		return "", fmt.Errorf("failed to load locker from cache: %w", err)
 	}
@@ -170,6 +221,9 @@ func (p PathExtractor) ExtractLayer(tarPath string) (string, error) {
 				// from managed resources, and the size is controlled, so it is safe from decompression bomb attacks.
 				return "", fmt.Errorf("failed to extract from tar: %w", err)
 			}
+			if p.layerCache != nil {
+				p.layerCache.Record(filepath.Dir(tarPath))
+			}
 			return extractedFilePath, nil
 		}
 	}
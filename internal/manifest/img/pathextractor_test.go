@@ -11,10 +11,14 @@ import (
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
 	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
 )
 
@@ -115,6 +119,51 @@ func TestPathExtractor_FetchLayerToFile(t *testing.T) {
 	}
 }
 
+func TestPathExtractor_WithLayerCache_ReturnsCachedLayerWithoutPull(t *testing.T) {
+	layerCache, err := layercache.NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+	imgLayer := static.NewLayer([]byte("content"), types.OCIUncompressedLayer)
+	digest, err := imgLayer.Digest()
+	require.NoError(t, err)
+	imageSpec := v1beta2.ImageSpec{
+		Repo: "unreachable.invalid",
+		Name: testutils.DefaultFQDN,
+		Ref:  digest.String(),
+		Type: v1beta2.OciRefType,
+	}
+	layerDir := layerCache.LayerDir(imageSpec.Name, imageSpec.Ref)
+	cachedPath, err := layerCache.Store(layerDir, "raw-manifest.yaml", imgLayer)
+	require.NoError(t, err)
+	pathExtractor := img.NewPathExtractor(img.WithLayerCache(layerCache))
+
+	extractedFilePath, err := pathExtractor.GetPathFromRawManifest(t.Context(), imageSpec, authn.DefaultKeychain)
+
+	require.NoError(t, err)
+	assert.Equal(t, cachedPath, extractedFilePath)
+}
+
+func TestPathExtractor_WithLayerCache_ExtractLayerRecordsSize(t *testing.T) {
+	layerCache, err := layercache.NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+	content, tarFilePath := generateDummyTarFile(t)
+	tarContent, err := os.ReadFile(tarFilePath)
+	require.NoError(t, err)
+	imgLayer := static.NewLayer(tarContent, types.OCIUncompressedLayer)
+	digest, err := imgLayer.Digest()
+	require.NoError(t, err)
+	layerDir := layerCache.LayerDir(testutils.DefaultFQDN, digest.String())
+	cachedTarPath, err := layerCache.Store(layerDir, "raw-manifest.tar", imgLayer)
+	require.NoError(t, err)
+	sizeBeforeExtraction := layerCache.Size()
+	pathExtractor := img.NewPathExtractor(img.WithLayerCache(layerCache))
+
+	extractedFilePath, err := pathExtractor.ExtractLayer(cachedTarPath)
+
+	require.NoError(t, err)
+	assert.Equal(t, layerDir, filepath.Dir(extractedFilePath))
+	assert.Equal(t, sizeBeforeExtraction+int64(len(content)), layerCache.Size())
+}
+
 func generateDummyTarFile(t *testing.T) ([]byte, string) {
 	t.Helper()
 	var buf bytes.Buffer
@@ -0,0 +1,544 @@
+package layercache
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/filemutex"
+)
+
+const (
+	EvictionReasonSize         = "size"
+	EvictionReasonUnreferenced = "unreferenced"
+	EvictionReasonCorrupted    = "corrupted"
+
+	// DefaultEvictionGracePeriod protects recently used layers from eviction, as the Manifest reconciliation
+	// reads the files of a layer after its path is resolved.
+	DefaultEvictionGracePeriod = time.Minute
+
+	blobFileSuffix   = ".blob"
+	digestFileSuffix = ".digest"
+	tempFileSuffix   = ".tmp"
+	unknownRefPrefix = "ref-"
+
+	// volumeSizeLimitPercent leaves headroom on the volume for the files written before the cache is shrunk.
+	volumeSizeLimitPercent = 80
+)
+
+var (
+	ErrDigestMismatch             = errors.New("digest of cached layer does not match")
+	ErrUnsupportedDigestAlgorithm = errors.New("unsupported digest algorithm of layer")
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+type Metrics interface {
+	SetSize(bytes int64)
+	SetLayers(count int)
+	RecordEviction(reason string)
+}
+
+// Cache manages the directories of the layers fetched by the PathExtractor within a size budget.
+// Each layer is stored in a directory named after its digest, so it survives restarts of the process.
+// The blob of a layer is kept as pulled from the registry together with its OCI layer digest, which is
+// verified against the digest the layer is referenced by when the cache is loaded from disk. All other files,
+// such as the uncompressed layer or the files extracted from a tar layer, are removed on load and recreated
+// from the blob on demand.
+//
+// Methods that take the directory of a layer expect the caller to hold the lock of this directory
+// in the MutexCache of the cache. Eviction locks the directories itself, so it must be triggered without
+// holding the lock of any layer.
+type Cache struct {
+	dir         string
+	sizeLimit   int64
+	gracePeriod time.Duration
+	lockers     *filemutex.MutexCache
+	metrics     Metrics
+	now         func() time.Time
+
+	mu     sync.Mutex
+	layers map[string]*layer
+	size   int64
+}
+
+type layer struct {
+	size       int64
+	lastAccess time.Time
+}
+
+// NewCache loads the layers stored in dir. A sizeLimit of 0 disables the size budget.
+func NewCache(dir string, sizeLimit int64, opts ...func(*Cache) *Cache) (*Cache, error) {
+	cache := &Cache{
+		dir:         dir,
+		sizeLimit:   sizeLimit,
+		gracePeriod: DefaultEvictionGracePeriod,
+		lockers:     filemutex.NewMutexCache(nil),
+		metrics:     noopMetrics{},
+		now:         time.Now,
+		layers:      map[string]*layer{},
+	}
+	for _, opt := range opts {
+		cache = opt(cache)
+	}
+
+	if err := os.MkdirAll(dir, fs.ModePerm); err != nil {
+		return nil, fmt.Errorf("failed to create layer cache directory %s: %w", dir, err)
+	}
+	if err := cache.load(); err != nil {
+		return nil, err
+	}
+	cache.Shrink()
+	return cache, nil
+}
+
+// VolumeSizeLimit returns the size budget of a cache in dir, which is derived from the capacity of the volume
+// holding dir.
+func VolumeSizeLimit(dir string) (int64, error) {
+	if err := os.MkdirAll(dir, fs.ModePerm); err != nil {
+		return 0, fmt.Errorf("failed to create layer cache directory %s: %w", dir, err)
+	}
+	size, err := volumeSize(dir)
+	if err != nil {
+		return 0, err
+	}
+	return size * volumeSizeLimitPercent / 100, nil
+}
+
+// WithEvictionGracePeriod sets the duration after its last use for which a layer is not evicted.
+func WithEvictionGracePeriod(gracePeriod time.Duration) func(*Cache) *Cache {
+	return func(c *Cache) *Cache {
+		c.gracePeriod = gracePeriod
+		return c
+	}
+}
+
+// WithMetrics records the size of the cache and its evictions.
+func WithMetrics(metrics Metrics) func(*Cache) *Cache {
+	return func(c *Cache) *Cache {
+		c.metrics = metrics
+		return c
+	}
+}
+
+// FileMutexCache returns the locks of the layer directories, which must be shared by all users of the cache.
+func (c *Cache) FileMutexCache() *filemutex.MutexCache {
+	return c.lockers
+}
+
+// LayerDir returns the directory of the layer with the given name and ref. Layers referenced by digest
+// are stored by their digest, others by a hash of their name and ref.
+func (c *Cache) LayerDir(name, ref string) string {
+	if hash, err := containerregistryv1.NewHash(ref); err == nil {
+		return filepath.Join(c.dir, fmt.Sprintf("%s-%s", hash.Algorithm, hash.Hex))
+	}
+	sum := sha256.Sum256([]byte(name + "@" + ref))
+	return filepath.Join(c.dir, unknownRefPrefix+hex.EncodeToString(sum[:]))
+}
+
+// Lookup reports whether the file of the layer is cached and marks the layer as recently used.
+// The caller must hold the lock of layerDir.
+func (c *Cache) Lookup(layerDir, filename string) bool {
+	filePath := filepath.Join(layerDir, filename)
+	if _, err := os.Stat(filePath); err != nil {
+		if _, err := os.Stat(filePath + blobFileSuffix); err != nil {
+			return false
+		}
+		if err := uncompressBlob(filePath); err != nil {
+			return false
+		}
+		c.Record(layerDir)
+	}
+	if !c.Has(layerDir) {
+		c.Record(layerDir)
+	}
+	c.touch(layerDir)
+	return true
+}
+
+// Store writes the blob of the layer together with its digest and uncompresses it into the file of the layer.
+// The blob must match the digest of the layer, and the digest must match the digest the layer directory
+// is named after. The blob is written atomically, so that an interrupted write never leaves a partial
+// blob behind. The caller must hold the lock of layerDir.
+func (c *Cache) Store(layerDir, filename string, imgLayer containerregistryv1.Layer) (string, error) {
+	digest, err := imgLayer.Digest()
+	if err != nil {
+		return "", fmt.Errorf("failed to get digest of layer: %w", err)
+	}
+	if expected, ok := layerDigest(layerDir); ok && expected != digest {
+		return "", fmt.Errorf("%w: layer %s has digest %s", ErrDigestMismatch, layerDir, digest)
+	}
+	blob, err := imgLayer.Compressed()
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch blob of layer %s: %w", digest, err)
+	}
+	defer blob.Close()
+
+	if err := os.MkdirAll(layerDir, fs.ModePerm); err != nil {
+		return "", fmt.Errorf("failed to create layer directory %s: %w", layerDir, err)
+	}
+	filePath := filepath.Join(layerDir, filename)
+	if err := writeBlob(filePath, digest, blob); err != nil {
+		return "", err
+	}
+	if err := uncompressBlob(filePath); err != nil {
+		return "", err
+	}
+
+	c.Record(layerDir)
+	return filePath, nil
+}
+
+// Record updates the size of the layer after files were added to its directory.
+// The caller must hold the lock of layerDir.
+func (c *Cache) Record(layerDir string) {
+	size, err := dirSize(layerDir)
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.layers[layerDir]; ok {
+		c.size -= existing.size
+	}
+	c.layers[layerDir] = &layer{size: size, lastAccess: c.now()}
+	c.size += size
+	c.updateMetrics()
+}
+
+// Shrink evicts the least recently used layers until the cache fits into its size budget.
+// Layers used within the grace period are kept, even if the cache exceeds its budget.
+func (c *Cache) Shrink() {
+	if c.sizeLimit <= 0 {
+		return
+	}
+	for _, layerDir := range c.evictionCandidates(nil) {
+		c.mu.Lock()
+		fits := c.size <= c.sizeLimit
+		c.mu.Unlock()
+		if fits {
+			return
+		}
+		c.evict(layerDir, EvictionReasonSize)
+	}
+}
+
+// RemoveUnreferenced evicts all layers that are not part of the referenced layer directories.
+func (c *Cache) RemoveUnreferenced(referenced map[string]struct{}) {
+	for _, layerDir := range c.evictionCandidates(referenced) {
+		c.evict(layerDir, EvictionReasonUnreferenced)
+	}
+}
+
+// Size returns the total size of the cached layers in bytes.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// Has reports whether the layer directory is cached.
+func (c *Cache) Has(layerDir string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.layers[layerDir]
+	return ok
+}
+
+func (c *Cache) touch(layerDir string) {
+	now := c.now()
+	_ = os.Chtimes(layerDir, now, now)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.layers[layerDir]; ok {
+		existing.lastAccess = now
+	}
+}
+
+// evictionCandidates returns the layers outside the grace period which are not referenced,
+// the least recently used first.
+func (c *Cache) evictionCandidates(referenced map[string]struct{}) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	candidates := make([]string, 0, len(c.layers))
+	for layerDir, cached := range c.layers {
+		if _, ok := referenced[layerDir]; ok || c.inGracePeriod(cached) {
+			continue
+		}
+		candidates = append(candidates, layerDir)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return c.layers[candidates[i]].lastAccess.Before(c.layers[candidates[j]].lastAccess)
+	})
+	return candidates
+}
+
+func (c *Cache) inGracePeriod(cached *layer) bool {
+	return c.now().Sub(cached.lastAccess) < c.gracePeriod
+}
+
+// evict removes the layer unless it was used since it was selected for eviction.
+func (c *Cache) evict(layerDir, reason string) {
+	fileMutex, err := c.lockers.GetLocker(layerDir)
+	if err != nil {
+		return
+	}
+	fileMutex.Lock()
+	defer fileMutex.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.layers[layerDir]
+	if !ok || c.inGracePeriod(cached) {
+		return
+	}
+	if err := os.RemoveAll(layerDir); err != nil {
+		return
+	}
+	c.remove(layerDir, reason)
+}
+
+func (c *Cache) remove(layerDir, reason string) {
+	c.size -= c.layers[layerDir].size
+	delete(c.layers, layerDir)
+	c.metrics.RecordEviction(reason)
+	c.updateMetrics()
+}
+
+func (c *Cache) updateMetrics() {
+	c.metrics.SetSize(c.size)
+	c.metrics.SetLayers(len(c.layers))
+}
+
+// load restores the layers from disk. The last use of a layer is restored from the modification time
+// of its directory. Layers with corrupted files are removed.
+func (c *Cache) load() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read layer cache directory %s: %w", c.dir, err)
+	}
+	for _, entry := range entries {
+		layerDir := filepath.Join(c.dir, entry.Name())
+		if !entry.IsDir() {
+			_ = os.Remove(layerDir)
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed to read layer directory %s: %w", layerDir, err)
+		}
+		if err := verifyLayer(layerDir); err != nil {
+			if err := os.RemoveAll(layerDir); err != nil {
+				return fmt.Errorf("failed to remove corrupted layer %s: %w", layerDir, err)
+			}
+			c.metrics.RecordEviction(EvictionReasonCorrupted)
+			continue
+		}
+		size, err := dirSize(layerDir)
+		if err != nil {
+			return err
+		}
+		c.layers[layerDir] = &layer{size: size, lastAccess: info.ModTime()}
+		c.size += size
+		// verifying the layer must not count as its use
+		_ = os.Chtimes(layerDir, info.ModTime(), info.ModTime())
+	}
+	c.updateMetrics()
+	return nil
+}
+
+// verifyLayer compares the blobs in the layer directory with their recorded OCI layer digests and the digest
+// the layer directory is named after. All other files are removed, as they are recreated from the blobs.
+func verifyLayer(layerDir string) error {
+	entries, err := os.ReadDir(layerDir)
+	if err != nil {
+		return fmt.Errorf("failed to read layer directory %s: %w", layerDir, err)
+	}
+	verified := map[string]struct{}{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), digestFileSuffix) {
+			continue
+		}
+		filePath := filepath.Join(layerDir, strings.TrimSuffix(entry.Name(), digestFileSuffix))
+		if err := verifyBlob(layerDir, filePath); err != nil {
+			return err
+		}
+		verified[filepath.Base(filePath)+digestFileSuffix] = struct{}{}
+		verified[filepath.Base(filePath)+blobFileSuffix] = struct{}{}
+	}
+	if len(verified) == 0 {
+		return fmt.Errorf("%w: no verified blob in %s", ErrDigestMismatch, layerDir)
+	}
+	for _, entry := range entries {
+		if _, ok := verified[entry.Name()]; ok {
+			continue
+		}
+		filePath := filepath.Join(layerDir, entry.Name())
+		if err := os.RemoveAll(filePath); err != nil {
+			return fmt.Errorf("failed to remove unverifiable file %s: %w", filePath, err)
+		}
+	}
+	return nil
+}
+
+func verifyBlob(layerDir, filePath string) error {
+	recorded, err := os.ReadFile(filePath + digestFileSuffix)
+	if err != nil {
+		return fmt.Errorf("failed to read digest of %s: %w", filePath, err)
+	}
+	digest, err := containerregistryv1.NewHash(string(recorded))
+	if err != nil {
+		return fmt.Errorf("%w: invalid digest of %s: %w", ErrDigestMismatch, filePath, err)
+	}
+	if expected, ok := layerDigest(layerDir); ok && expected != digest {
+		return fmt.Errorf("%w: %s was stored for digest %s", ErrDigestMismatch, layerDir, digest)
+	}
+	actual, err := fileDigest(filePath + blobFileSuffix)
+	if err != nil {
+		return err
+	}
+	if actual != digest.Hex {
+		return fmt.Errorf("%w: %s", ErrDigestMismatch, filePath)
+	}
+	return nil
+}
+
+// layerDigest returns the digest the layer directory is named after, if the layer is referenced by digest.
+func layerDigest(layerDir string) (containerregistryv1.Hash, bool) {
+	algorithm, hexDigest, found := strings.Cut(filepath.Base(layerDir), "-")
+	if !found || strings.HasPrefix(filepath.Base(layerDir), unknownRefPrefix) {
+		return containerregistryv1.Hash{}, false
+	}
+	digest, err := containerregistryv1.NewHash(algorithm + ":" + hexDigest)
+	if err != nil {
+		return containerregistryv1.Hash{}, false
+	}
+	return digest, true
+}
+
+// writeBlob writes the blob next to the file of the layer and verifies it against the digest of the layer
+// before it is moved into place.
+func writeBlob(filePath string, digest containerregistryv1.Hash, blob io.Reader) error {
+	if digest.Algorithm != "sha256" {
+		return fmt.Errorf("%w: %s", ErrUnsupportedDigestAlgorithm, digest)
+	}
+	blobPath := filePath + blobFileSuffix
+	tempFile, err := os.Create(blobPath + tempFileSuffix)
+	if err != nil {
+		return fmt.Errorf("failed to create layer blob %s: %w", blobPath, err)
+	}
+	defer os.Remove(tempFile.Name())
+
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tempFile, hasher), blob); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("failed to write layer blob %s: %w", blobPath, err)
+	}
+	if err := tempFile.Close(); err != nil {
+		return fmt.Errorf("failed to close layer blob %s: %w", blobPath, err)
+	}
+	if actual := hex.EncodeToString(hasher.Sum(nil)); actual != digest.Hex {
+		return fmt.Errorf("%w: blob of layer %s has digest sha256:%s", ErrDigestMismatch, digest, actual)
+	}
+	if err := os.WriteFile(filePath+digestFileSuffix, []byte(digest.String()), 0o600); err != nil {
+		return fmt.Errorf("failed to write digest of layer blob %s: %w", blobPath, err)
+	}
+	if err := os.Rename(tempFile.Name(), blobPath); err != nil {
+		return fmt.Errorf("failed to move layer blob %s: %w", blobPath, err)
+	}
+	return nil
+}
+
+// uncompressBlob writes the content of the blob of the layer to the file of the layer,
+// gunzipping it if the blob is compressed.
+func uncompressBlob(filePath string) error {
+	blob, err := os.Open(filePath + blobFileSuffix)
+	if err != nil {
+		return fmt.Errorf("failed to open layer blob %s: %w", filePath, err)
+	}
+	defer blob.Close()
+
+	blobReader := bufio.NewReader(blob)
+	var content io.Reader = blobReader
+	if magic, err := blobReader.Peek(len(gzipMagic)); err == nil && bytes.Equal(magic, gzipMagic) {
+		gzipReader, err := gzip.NewReader(blobReader)
+		if err != nil {
+			return fmt.Errorf("failed to uncompress layer blob %s: %w", filePath, err)
+		}
+		defer gzipReader.Close()
+		content = gzipReader
+	}
+
+	tempFile, err := os.Create(filePath + tempFileSuffix)
+	if err != nil {
+		return fmt.Errorf("failed to create layer file %s: %w", filePath, err)
+	}
+	defer os.Remove(tempFile.Name())
+	//nolint:gosec // The layer is verified against its digest, which is taken from managed resources.
+	if _, err := io.Copy(tempFile, content); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("failed to write layer file %s: %w", filePath, err)
+	}
+	if err := tempFile.Close(); err != nil {
+		return fmt.Errorf("failed to close layer file %s: %w", filePath, err)
+	}
+	if err := os.Rename(tempFile.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to move layer file %s: %w", filePath, err)
+	}
+	return nil
+}
+
+func fileDigest(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open layer blob %s: %w", filePath, err)
+	}
+	defer file.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", fmt.Errorf("failed to read layer blob %s: %w", filePath, err)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to determine size of layer %s: %w", dir, err)
+	}
+	return size, nil
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) SetSize(int64)         {}
+func (noopMetrics) SetLayers(int)         {}
+func (noopMetrics) RecordEviction(string) {}
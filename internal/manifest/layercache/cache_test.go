@@ -0,0 +1,299 @@
+package layercache_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
+)
+
+var (
+	contentA = strings.Repeat("a", 64)
+	contentB = strings.Repeat("b", 64)
+	contentC = strings.Repeat("c", 64)
+	digestA  = layerDigest(contentA)
+	digestB  = layerDigest(contentB)
+	digestC  = layerDigest(contentC)
+)
+
+func TestLayerDir_WhenRefIsDigest_UsesDigest(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := layercache.NewCache(dir, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(dir, strings.Replace(digestA, ":", "-", 1)), cache.LayerDir("module", digestA))
+	assert.Equal(t, cache.LayerDir("module", digestA), cache.LayerDir("other-module", digestA))
+	assert.NotEqual(t, cache.LayerDir("module", "1.0.0"), cache.LayerDir("other-module", "1.0.0"))
+}
+
+func TestVolumeSizeLimit_LeavesHeadroomOnVolume(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "layer-cache")
+
+	sizeLimit, err := layercache.VolumeSizeLimit(dir)
+
+	require.NoError(t, err)
+	assert.Positive(t, sizeLimit)
+	assert.DirExists(t, dir)
+}
+
+func TestStore_ThenLookup_ReturnsCachedFile(t *testing.T) {
+	metrics := &metricsStub{}
+	cache, err := layercache.NewCache(t.TempDir(), 0, layercache.WithMetrics(metrics))
+	require.NoError(t, err)
+	layerDir := cache.LayerDir("module", digestA)
+
+	assert.False(t, cache.Lookup(layerDir, "raw-manifest.yaml"))
+	path, err := cache.Store(layerDir, "raw-manifest.yaml", newLayer(contentA))
+	require.NoError(t, err)
+
+	assert.True(t, cache.Lookup(layerDir, "raw-manifest.yaml"))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, contentA, string(content))
+	assert.Equal(t, int64(2*len(contentA)+len(digestA)), cache.Size())
+	assert.Equal(t, 1, metrics.getLayers())
+	assert.NoFileExists(t, path+".tmp")
+	assert.NoFileExists(t, path+".blob.tmp")
+}
+
+func TestStore_WhenBlobCompressed_StoresUncompressedFile(t *testing.T) {
+	cache, err := layercache.NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	_, err = gzipWriter.Write([]byte(contentA))
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+	imgLayer := static.NewLayer(compressed.Bytes(), types.OCILayer)
+	digest, err := imgLayer.Digest()
+	require.NoError(t, err)
+
+	path, err := cache.Store(cache.LayerDir("module", digest.String()), "raw-manifest.yaml", imgLayer)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, contentA, string(content))
+}
+
+func TestStore_WhenLayerDoesNotMatchRef_ReturnsError(t *testing.T) {
+	cache, err := layercache.NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+	layerDir := cache.LayerDir("module", digestA)
+
+	_, err = cache.Store(layerDir, "raw-manifest.yaml", newLayer(contentB))
+
+	require.ErrorIs(t, err, layercache.ErrDigestMismatch)
+	assert.False(t, cache.Has(layerDir))
+	assert.NoFileExists(t, filepath.Join(layerDir, "raw-manifest.yaml"))
+}
+
+func TestShrink_EvictsLeastRecentlyUsedLayers(t *testing.T) {
+	metrics := &metricsStub{}
+	cache, err := layercache.NewCache(t.TempDir(), 400,
+		layercache.WithEvictionGracePeriod(0), layercache.WithMetrics(metrics))
+	require.NoError(t, err)
+	layerA, layerB, layerC := storeLayers(t, cache)
+	require.True(t, cache.Lookup(layerA, "raw-manifest.yaml"))
+
+	cache.Shrink()
+
+	assert.True(t, cache.Has(layerA))
+	assert.False(t, cache.Has(layerB))
+	assert.True(t, cache.Has(layerC))
+	assert.NoDirExists(t, layerB)
+	assert.Equal(t, 1, metrics.getEvictions(layercache.EvictionReasonSize))
+}
+
+func TestShrink_WhenLayersInGracePeriod_KeepsLayers(t *testing.T) {
+	cache, err := layercache.NewCache(t.TempDir(), 1)
+	require.NoError(t, err)
+	layerA, layerB, layerC := storeLayers(t, cache)
+
+	cache.Shrink()
+
+	assert.True(t, cache.Has(layerA))
+	assert.True(t, cache.Has(layerB))
+	assert.True(t, cache.Has(layerC))
+}
+
+func TestRemoveUnreferenced_EvictsUnreferencedLayers(t *testing.T) {
+	metrics := &metricsStub{}
+	cache, err := layercache.NewCache(t.TempDir(), 0,
+		layercache.WithEvictionGracePeriod(0), layercache.WithMetrics(metrics))
+	require.NoError(t, err)
+	layerA, layerB, layerC := storeLayers(t, cache)
+
+	cache.RemoveUnreferenced(map[string]struct{}{layerB: {}})
+
+	assert.False(t, cache.Has(layerA))
+	assert.True(t, cache.Has(layerB))
+	assert.False(t, cache.Has(layerC))
+	assert.Equal(t, 2, metrics.getEvictions(layercache.EvictionReasonUnreferenced))
+	assert.Equal(t, 1, metrics.getLayers())
+}
+
+func TestNewCache_RestoresLayersFromDisk(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := layercache.NewCache(dir, 0)
+	require.NoError(t, err)
+	layerA, layerB, _ := storeLayers(t, cache)
+	extractedFile := filepath.Join(layerB, "template-operator.yaml")
+	require.NoError(t, os.WriteFile(extractedFile, []byte("extracted"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(layerA, "raw-manifest.yaml"), []byte("tampered"), 0o600))
+	sizeBeforeRestore := cache.Size()
+
+	restored, err := layercache.NewCache(dir, 0)
+
+	require.NoError(t, err)
+	assert.True(t, restored.Has(layerB))
+	assert.NoFileExists(t, extractedFile)
+	assert.True(t, restored.Lookup(layerA, "raw-manifest.yaml"))
+	content, err := os.ReadFile(filepath.Join(layerA, "raw-manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, contentA, string(content))
+	assert.Less(t, restored.Size(), sizeBeforeRestore)
+}
+
+func TestNewCache_WhenLayerCorrupted_RemovesLayer(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := layercache.NewCache(dir, 0)
+	require.NoError(t, err)
+	layerA, layerB, layerC := storeLayers(t, cache)
+	require.NoError(t, os.WriteFile(filepath.Join(layerA, "raw-manifest.yaml.blob"), []byte("tampered"), 0o600))
+	require.NoError(t, os.Remove(filepath.Join(layerB, "raw-manifest.yaml.digest")))
+	wrongLayerDir := cache.LayerDir("module", layerDigest("other"))
+	require.NoError(t, os.Rename(layerC, wrongLayerDir))
+	metrics := &metricsStub{}
+
+	restored, err := layercache.NewCache(dir, 0, layercache.WithMetrics(metrics))
+
+	require.NoError(t, err)
+	assert.False(t, restored.Has(layerA))
+	assert.False(t, restored.Has(layerB))
+	assert.False(t, restored.Has(wrongLayerDir))
+	assert.NoDirExists(t, layerA)
+	assert.NoDirExists(t, layerB)
+	assert.NoDirExists(t, wrongLayerDir)
+	assert.Equal(t, 3, metrics.getEvictions(layercache.EvictionReasonCorrupted))
+	assert.Equal(t, 0, metrics.getLayers())
+}
+
+func TestNewCache_WhenOverBudget_EvictsLeastRecentlyUsedLayers(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := layercache.NewCache(dir, 0)
+	require.NoError(t, err)
+	layerA, layerB, layerC := storeLayers(t, cache)
+	lastAccess := time.Now().Add(-time.Hour)
+	for i, layerDir := range []string{layerB, layerA, layerC} {
+		accessTime := lastAccess.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, os.Chtimes(layerDir, accessTime, accessTime))
+	}
+
+	restored, err := layercache.NewCache(dir, 400)
+
+	require.NoError(t, err)
+	assert.False(t, restored.Has(layerB))
+	assert.True(t, restored.Has(layerA))
+	assert.True(t, restored.Has(layerC))
+}
+
+func TestStore_WhenEvictedConcurrently_StaysConsistent(t *testing.T) {
+	cache, err := layercache.NewCache(t.TempDir(), 1, layercache.WithEvictionGracePeriod(0))
+	require.NoError(t, err)
+	var waitGroup sync.WaitGroup
+	for range 10 {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for _, content := range []string{contentA, contentB, contentC} {
+				layerDir := cache.LayerDir("module", layerDigest(content))
+				locker, err := cache.FileMutexCache().GetLocker(layerDir)
+				assert.NoError(t, err)
+				locker.Lock()
+				if !cache.Lookup(layerDir, "raw-manifest.yaml") {
+					_, err = cache.Store(layerDir, "raw-manifest.yaml", newLayer(content))
+					assert.NoError(t, err)
+				}
+				locker.Unlock()
+				cache.Shrink()
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	cache.Shrink()
+	assert.Equal(t, int64(0), cache.Size())
+}
+
+func storeLayers(t *testing.T, cache *layercache.Cache) (string, string, string) {
+	t.Helper()
+	layerDirs := make([]string, 0, 3)
+	for _, content := range []string{contentA, contentB, contentC} {
+		layerDir := cache.LayerDir("module", layerDigest(content))
+		_, err := cache.Store(layerDir, "raw-manifest.yaml", newLayer(content))
+		require.NoError(t, err)
+		layerDirs = append(layerDirs, layerDir)
+		time.Sleep(10 * time.Millisecond)
+	}
+	return layerDirs[0], layerDirs[1], layerDirs[2]
+}
+
+//nolint:ireturn // the cache stores any layer
+func newLayer(content string) containerregistryv1.Layer {
+	return static.NewLayer([]byte(content), types.OCIUncompressedLayer)
+}
+
+func layerDigest(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+type metricsStub struct {
+	mu        sync.Mutex
+	layers    int
+	evictions map[string]int
+}
+
+func (m *metricsStub) SetSize(_ int64) {}
+
+func (m *metricsStub) SetLayers(count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.layers = count
+}
+
+func (m *metricsStub) RecordEviction(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.evictions == nil {
+		m.evictions = map[string]int{}
+	}
+	m.evictions[reason]++
+}
+
+func (m *metricsStub) getLayers() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.layers
+}
+
+func (m *metricsStub) getEvictions(reason string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.evictions[reason]
+}
@@ -0,0 +1,60 @@
+package layercache
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+type ManifestRepository interface {
+	List(ctx context.Context) ([]v1beta2.Manifest, error)
+}
+
+// GarbageCollector removes the layers from the Cache that no Manifest references anymore.
+type GarbageCollector struct {
+	manifestRepository ManifestRepository
+	cache              *Cache
+}
+
+func NewGarbageCollector(manifestRepository ManifestRepository, cache *Cache) *GarbageCollector {
+	return &GarbageCollector{
+		manifestRepository: manifestRepository,
+		cache:              cache,
+	}
+}
+
+// Collect evicts the layers that are neither the installation layer nor an upgrade hook layer of any Manifest.
+func (g *GarbageCollector) Collect(ctx context.Context) error {
+	manifests, err := g.manifestRepository.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to collect unreferenced layers: %w", err)
+	}
+
+	referenced := map[string]struct{}{}
+	for _, manifest := range manifests {
+		for _, imageSpec := range referencedImageSpecs(&manifest) {
+			referenced[g.cache.LayerDir(imageSpec.Name, imageSpec.Ref)] = struct{}{}
+		}
+	}
+	g.cache.RemoveUnreferenced(referenced)
+	return nil
+}
+
+func referencedImageSpecs(manifest *v1beta2.Manifest) []v1beta2.ImageSpec {
+	imageSpecs := make([]v1beta2.ImageSpec, 0, 1)
+	var installSpec v1beta2.ImageSpec
+	if err := yaml.Unmarshal(manifest.Spec.Install.Source.Raw, &installSpec); err == nil {
+		imageSpecs = append(imageSpecs, installSpec)
+	}
+	if hooks := manifest.Spec.Hooks; hooks != nil {
+		for _, hook := range []*v1beta2.ManifestUpgradeHook{hooks.PreUpgrade, hooks.PostUpgrade} {
+			if hook != nil {
+				imageSpecs = append(imageSpecs, hook.Image)
+			}
+		}
+	}
+	return imageSpecs
+}
@@ -0,0 +1,60 @@
+package layercache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
+)
+
+func TestCollect_RemovesLayersNotReferencedByManifests(t *testing.T) {
+	cache, err := layercache.NewCache(t.TempDir(), 0, layercache.WithEvictionGracePeriod(0))
+	require.NoError(t, err)
+	layerA, layerB, layerC := storeLayers(t, cache)
+	manifest := v1beta2.Manifest{Spec: v1beta2.ManifestSpec{
+		Install: v1beta2.InstallInfo{Source: machineryruntime.RawExtension{
+			Raw: []byte(`{"name":"module","ref":"` + digestA + `","type":"oci-ref"}`),
+		}},
+		Hooks: &v1beta2.ManifestUpgradeHooks{
+			PostUpgrade: &v1beta2.ManifestUpgradeHook{Image: v1beta2.ImageSpec{Name: "module", Ref: digestC}},
+		},
+	}}
+	collector := layercache.NewGarbageCollector(&manifestRepositoryStub{manifests: []v1beta2.Manifest{manifest}},
+		cache)
+
+	err = collector.Collect(t.Context())
+
+	require.NoError(t, err)
+	assert.True(t, cache.Has(layerA))
+	assert.False(t, cache.Has(layerB))
+	assert.True(t, cache.Has(layerC))
+}
+
+func TestCollect_WhenListFails_KeepsLayers(t *testing.T) {
+	cache, err := layercache.NewCache(t.TempDir(), 0, layercache.WithEvictionGracePeriod(0))
+	require.NoError(t, err)
+	layerDir := cache.LayerDir("module", digestA)
+	_, err = cache.Store(layerDir, "raw-manifest.yaml", newLayer(contentA))
+	require.NoError(t, err)
+	collector := layercache.NewGarbageCollector(&manifestRepositoryStub{err: errors.New("list error")}, cache)
+
+	err = collector.Collect(t.Context())
+
+	require.ErrorContains(t, err, "list error")
+	assert.True(t, cache.Has(layerDir))
+}
+
+type manifestRepositoryStub struct {
+	manifests []v1beta2.Manifest
+	err       error
+}
+
+func (s *manifestRepositoryStub) List(_ context.Context) ([]v1beta2.Manifest, error) {
+	return s.manifests, s.err
+}
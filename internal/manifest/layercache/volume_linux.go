@@ -0,0 +1,15 @@
+package layercache
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// volumeSize returns the capacity in bytes of the volume holding dir.
+func volumeSize(dir string) (int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, fmt.Errorf("failed to determine size of volume of %s: %w", dir, err)
+	}
+	return int64(stat.Blocks) * stat.Bsize, nil //nolint:gosec // block counts of a volume fit into int64
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package layercache
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrVolumeSizeUnsupported = errors.New("determining the volume size is not supported on this platform")
+
+// volumeSize returns the capacity in bytes of the volume holding dir.
+func volumeSize(dir string) (int64, error) {
+	return 0, fmt.Errorf("%w: %s", ErrVolumeSizeUnsupported, dir)
+}
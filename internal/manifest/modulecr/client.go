@@ -1,9 +1,12 @@
 package modulecr
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -12,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/finalizer"
@@ -79,20 +83,95 @@ func (c *Client) CheckDefaultCRDeletion(ctx context.Context, manifestCR *v1beta2
 	return c.noDefaultModuleCRExists(allModuleCRs, defaultModuleCR), nil
 }
 
-func (c *Client) CheckModuleCRsDeletion(ctx context.Context, manifestCR *v1beta2.Manifest) error {
+// CheckModuleCRsDeletion returns ErrWaitingForModuleCRsDeletion together with the blocking module CRs
+// as long as module CRs other than the default CR exist.
+func (c *Client) CheckModuleCRsDeletion(ctx context.Context, manifestCR *v1beta2.Manifest) (
+	*shared.DeletionBlockers,
+	error,
+) {
 	moduleCRs, err := c.GetAllModuleCRsExcludingDefaultCR(ctx, manifestCR)
 	if err != nil {
 		if util.IsNotFound(err) {
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("failed to fetch module CRs, %w", err)
+		return nil, fmt.Errorf("failed to fetch module CRs, %w", err)
 	}
 
-	if len(moduleCRs) == 0 {
-		return nil
+	blockers := shared.NewDeletionBlockers(toSortedResources(moduleCRs))
+	if blockers == nil {
+		return nil, nil
 	}
 
-	return ErrWaitingForModuleCRsDeletion
+	return blockers, fmt.Errorf("%w: %s", ErrWaitingForModuleCRsDeletion, DescribeDeletionBlockers(blockers))
+}
+
+// DeleteModuleCRsExcludingDefaultCR deletes the module CRs other than the default CR, which otherwise block
+// the deletion of the module. Module CRs already being deleted are skipped.
+// It returns the number of module CRs whose deletion was requested.
+func (c *Client) DeleteModuleCRsExcludingDefaultCR(ctx context.Context, manifestCR *v1beta2.Manifest) (int, error) {
+	moduleCRs, err := c.GetAllModuleCRsExcludingDefaultCR(ctx, manifestCR)
+	if err != nil {
+		if util.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to fetch module CRs, %w", err)
+	}
+
+	deleted := 0
+	var errs []error
+	for i := range moduleCRs {
+		if !moduleCRs[i].GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		err := c.Delete(ctx, &moduleCRs[i], client.PropagationPolicy(apimetav1.DeletePropagationBackground))
+		if util.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete module CR %s: %w",
+				objectName(moduleCRs[i].GetNamespace(), moduleCRs[i].GetName()), err))
+			continue
+		}
+		deleted++
+	}
+	return deleted, errors.Join(errs...)
+}
+
+// DescribeDeletionBlockers lists the blocking module CRs by namespace and name, followed by the number
+// of blocking module CRs not listed.
+func DescribeDeletionBlockers(blockers *shared.DeletionBlockers) string {
+	names := make([]string, 0, len(blockers.Resources))
+	for _, resource := range blockers.Resources {
+		names = append(names, objectName(resource.Namespace, resource.Name))
+	}
+	description := fmt.Sprintf("%d remaining: %s", blockers.Count, strings.Join(names, ", "))
+	if notListed := blockers.Count - len(blockers.Resources); notListed > 0 {
+		description += fmt.Sprintf(" and %d more", notListed)
+	}
+	return description
+}
+
+func toSortedResources(moduleCRs []unstructured.Unstructured) []shared.Resource {
+	resources := make([]shared.Resource, 0, len(moduleCRs))
+	for _, moduleCR := range moduleCRs {
+		gvk := moduleCR.GroupVersionKind()
+		resources = append(resources, shared.Resource{
+			GroupVersionKind: apimetav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+			Name:             moduleCR.GetName(),
+			Namespace:        moduleCR.GetNamespace(),
+		})
+	}
+	slices.SortFunc(resources, func(a, b shared.Resource) int {
+		return cmp.Or(strings.Compare(a.Namespace, b.Namespace), strings.Compare(a.Name, b.Name))
+	})
+	return resources
+}
+
+func objectName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
 }
 
 // RemoveDefaultModuleCR deletes the default module CR if available in the cluster.
@@ -117,13 +196,15 @@ func (c *Client) RemoveDefaultModuleCR(ctx context.Context, kcp client.Client, m
 // SyncDefaultModuleCR sync the manifest default custom resource status in the cluster,
 // if not available it created the resource.
 // It is used to provide the controller with default data in the Runtime.
+// The per-runtime configuration of an existing resource is synced by SyncModuleConfig.
 func (c *Client) SyncDefaultModuleCR(ctx context.Context, manifest *v1beta2.Manifest) error {
 	if !manifest.ShouldCreateDefaultModuleCR() {
 		return nil
 	}
 
 	resource := manifest.Spec.Resource.DeepCopy()
-	if err := c.Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil && util.IsNotFound(err) {
+	err := c.Get(ctx, client.ObjectKeyFromObject(resource), resource)
+	if err != nil && util.IsNotFound(err) {
 		if !manifest.GetDeletionTimestamp().IsZero() {
 			return nil
 		}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/finalizer"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
 	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
@@ -123,6 +124,177 @@ func TestClient_SyncDefaultModuleCR(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestClient_SyncDefaultModuleCR_WithResourceOverride_PropagatesConfigChanges(t *testing.T) {
+	// Given a manifest CR with a resource CR that exists in the cluster
+	testScheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(testScheme)
+	require.NoError(t, err)
+
+	kcpClient := fake.NewClientBuilder().WithScheme(testScheme).WithReturnManagedFields().Build()
+	skrClient := modulecr.NewClient(kcpClient)
+	manifest := testutils.NewTestManifest("test-manifest")
+	manifest.Spec.CustomResourcePolicy = v1beta2.CustomResourcePolicyCreateAndDelete
+	defaultData := &unstructured.Unstructured{}
+	defaultData.SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Group:   templatev1alpha1.GroupVersion.Group,
+			Version: templatev1alpha1.GroupVersion.Version,
+			Kind:    string(templatev1alpha1.SampleKind),
+		},
+	)
+	const moduleName = "test-resource"
+	defaultData.SetName(moduleName)
+	defaultData.SetNamespace(shared.DefaultRemoteNamespace)
+	require.NoError(t, unstructured.SetNestedField(defaultData.Object, "info", "spec", "logLevel"))
+	manifest.Spec.Resource = defaultData.DeepCopy()
+	err = skrClient.SyncDefaultModuleCR(t.Context(), manifest)
+	require.NoError(t, err)
+
+	// When the module is configured per runtime
+	manifest.Spec.ResourceOverride = &machineryruntime.RawExtension{Raw: []byte(`{"spec":{"logLevel":"debug"}}`)}
+	manifest.Spec.Resource, err = modulecr.MergeConfig(defaultData, manifest.Spec.ResourceOverride)
+	require.NoError(t, err)
+	err = skrClient.SyncModuleConfig(t.Context(), manifest)
+	require.NoError(t, err)
+
+	// Then the configuration is applied to the existing resource CR
+	assert.Equal(t, "debug", getLogLevel(t, skrClient, manifest))
+
+	// When the configuration is removed
+	manifest.Spec.ResourceOverride = nil
+	manifest.Spec.Resource = defaultData.DeepCopy()
+	err = skrClient.SyncModuleConfig(t.Context(), manifest)
+	require.NoError(t, err)
+
+	// Then the default data is restored
+	assert.Equal(t, "info", getLogLevel(t, skrClient, manifest))
+}
+
+func TestClient_SyncDefaultModuleCR_WhenConfiguredKeyRemoved_RemovesFieldFromResource(t *testing.T) {
+	skrClient, manifest, defaultData := syncConfiguredModuleCR(t, `{"spec":{"logLevel":"debug","tracing":"on"}}`)
+
+	// When a key is deleted with null from the configuration
+	applyResourceOverride(t, skrClient, manifest, defaultData, `{"spec":{"logLevel":"debug","tracing":null}}`)
+
+	// Then the field is removed from the resource CR
+	resource := getResource(t, skrClient, manifest)
+	_, found, err := unstructured.NestedString(resource.Object, "spec", "tracing")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, "debug", getLogLevel(t, skrClient, manifest))
+}
+
+func TestClient_SyncDefaultModuleCR_WhenConfigurationRemoved_RemovesConfiguredFields(t *testing.T) {
+	skrClient, manifest, defaultData := syncConfiguredModuleCR(t, `{"spec":{"logLevel":"debug","tracing":"on"}}`)
+
+	// When the whole configuration is removed
+	manifest.Spec.ResourceOverride = nil
+	manifest.Spec.Resource = defaultData.DeepCopy()
+	require.NoError(t, skrClient.SyncModuleConfig(t.Context(), manifest))
+
+	// Then the resource CR only contains the default data
+	resource := getResource(t, skrClient, manifest)
+	_, found, err := unstructured.NestedString(resource.Object, "spec", "tracing")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, "info", getLogLevel(t, skrClient, manifest))
+	// And the module config no longer owns any field of the resource CR
+	for _, managedField := range resource.GetManagedFields() {
+		assert.NotEqual(t, string(fieldowners.ModuleConfig), managedField.Manager)
+	}
+}
+
+func TestClient_SyncModuleConfig_WhenConfigurationRemoved_KeepsChangesInRuntime(t *testing.T) {
+	skrClient, manifest, defaultData := syncConfiguredModuleCR(t, `{"spec":{"logLevel":"debug","tracing":"on"}}`)
+	manifest.Spec.ResourceOverride = nil
+	manifest.Spec.Resource = defaultData.DeepCopy()
+	require.NoError(t, skrClient.SyncModuleConfig(t.Context(), manifest))
+
+	// When the resource CR is changed in the runtime
+	resource := getResource(t, skrClient, manifest)
+	require.NoError(t, unstructured.SetNestedField(resource.Object, "warn", "spec", "logLevel"))
+	require.NoError(t, skrClient.Update(t.Context(), resource))
+
+	// And the module config is synced again
+	require.NoError(t, skrClient.SyncModuleConfig(t.Context(), manifest))
+
+	// Then the change is kept
+	assert.Equal(t, "warn", getLogLevel(t, skrClient, manifest))
+}
+
+func TestClient_SyncModuleConfig_AppliesOnlyConfiguredFields(t *testing.T) {
+	skrClient, manifest, _ := syncConfiguredModuleCR(t, `{"spec":{"tracing":"on"}}`)
+
+	// When a field which is not configured is changed in the runtime
+	resource := getResource(t, skrClient, manifest)
+	require.NoError(t, unstructured.SetNestedField(resource.Object, "warn", "spec", "logLevel"))
+	require.NoError(t, skrClient.Update(t.Context(), resource))
+
+	// And the module config is synced again
+	require.NoError(t, skrClient.SyncModuleConfig(t.Context(), manifest))
+
+	// Then the change is kept
+	assert.Equal(t, "warn", getLogLevel(t, skrClient, manifest))
+}
+
+// syncConfiguredModuleCR syncs the default module CR with the default data, then applies the configuration.
+func syncConfiguredModuleCR(t *testing.T, config string) (*modulecr.Client, *v1beta2.Manifest,
+	*unstructured.Unstructured,
+) {
+	t.Helper()
+	testScheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(testScheme))
+	kcpClient := fake.NewClientBuilder().WithScheme(testScheme).WithReturnManagedFields().Build()
+	skrClient := modulecr.NewClient(kcpClient)
+	manifest := testutils.NewTestManifest("test-manifest")
+	manifest.Spec.CustomResourcePolicy = v1beta2.CustomResourcePolicyCreateAndDelete
+	defaultData := &unstructured.Unstructured{}
+	defaultData.SetGroupVersionKind(
+		schema.GroupVersionKind{
+			Group:   templatev1alpha1.GroupVersion.Group,
+			Version: templatev1alpha1.GroupVersion.Version,
+			Kind:    string(templatev1alpha1.SampleKind),
+		},
+	)
+	defaultData.SetName("test-resource")
+	defaultData.SetNamespace(shared.DefaultRemoteNamespace)
+	require.NoError(t, unstructured.SetNestedField(defaultData.Object, "info", "spec", "logLevel"))
+	manifest.Spec.Resource = defaultData.DeepCopy()
+	require.NoError(t, skrClient.SyncDefaultModuleCR(t.Context(), manifest))
+
+	applyResourceOverride(t, skrClient, manifest, defaultData, config)
+	tracing, _, err := unstructured.NestedString(getResource(t, skrClient, manifest).Object, "spec", "tracing")
+	require.NoError(t, err)
+	require.Equal(t, "on", tracing)
+	return skrClient, manifest, defaultData
+}
+
+func applyResourceOverride(t *testing.T, skrClient *modulecr.Client, manifest *v1beta2.Manifest,
+	defaultData *unstructured.Unstructured, config string,
+) {
+	t.Helper()
+	var err error
+	manifest.Spec.ResourceOverride = &machineryruntime.RawExtension{Raw: []byte(config)}
+	manifest.Spec.Resource, err = modulecr.MergeConfig(defaultData, manifest.Spec.ResourceOverride)
+	require.NoError(t, err)
+	require.NoError(t, skrClient.SyncModuleConfig(t.Context(), manifest))
+}
+
+func getResource(t *testing.T, skrClient *modulecr.Client, manifest *v1beta2.Manifest) *unstructured.Unstructured {
+	t.Helper()
+	resource := &unstructured.Unstructured{}
+	resource.SetGroupVersionKind(manifest.Spec.Resource.GroupVersionKind())
+	require.NoError(t, skrClient.Get(t.Context(), client.ObjectKeyFromObject(manifest.Spec.Resource), resource))
+	return resource
+}
+
+func getLogLevel(t *testing.T, skrClient *modulecr.Client, manifest *v1beta2.Manifest) string {
+	t.Helper()
+	logLevel, _, err := unstructured.NestedString(getResource(t, skrClient, manifest).Object, "spec", "logLevel")
+	require.NoError(t, err)
+	return logLevel
+}
+
 func TestClient_GetAllModuleCRsExcludingDefaultCR_WithCreateAndDeletePolicy(t *testing.T) {
 	// Given a manifest CR and two resource CRs deployed in the cluster
 	testScheme := machineryruntime.NewScheme()
@@ -428,3 +600,89 @@ func TestClient_GetAllModuleCRsExcludingDefaultCR_WithClusterScopedCR(t *testing
 		assert.False(t, isDefaultCR, "Default cluster-scoped CR should be excluded even with namespace mismatch")
 	}
 }
+
+func TestClient_CheckModuleCRsDeletion_ReturnsSortedDeletionBlockers(t *testing.T) {
+	// Given a manifest CR with a default CR and two user-created Module CRs
+	skrClient, manifest := setupManifestWithModuleCRs(t, "resource-b", "resource-a")
+
+	// When checking the deletion of the Module CRs
+	blockers, err := skrClient.CheckModuleCRsDeletion(t.Context(), manifest)
+
+	// Then the deletion waits for the user-created Module CRs, listed by namespace and name
+	require.ErrorIs(t, err, modulecr.ErrWaitingForModuleCRsDeletion)
+	assert.Contains(t, err.Error(), "2 remaining: default/resource-a, default/resource-b")
+	require.NotNil(t, blockers)
+	assert.Equal(t, 2, blockers.Count)
+	require.Len(t, blockers.Resources, 2)
+	assert.Equal(t, "resource-a", blockers.Resources[0].Name)
+	assert.Equal(t, "resource-b", blockers.Resources[1].Name)
+	assert.Equal(t, string(templatev1alpha1.SampleKind), blockers.Resources[0].Kind)
+}
+
+func TestClient_CheckModuleCRsDeletion_WhenOnlyDefaultCRExists_ReturnsNoBlockers(t *testing.T) {
+	// Given a manifest CR with only the default CR
+	skrClient, manifest := setupManifestWithModuleCRs(t)
+
+	// When checking the deletion of the Module CRs
+	blockers, err := skrClient.CheckModuleCRsDeletion(t.Context(), manifest)
+
+	// Then the deletion is not blocked
+	require.NoError(t, err)
+	assert.Nil(t, blockers)
+}
+
+func TestDescribeDeletionBlockers_WhenResourcesCapped_ReportsNotListedCount(t *testing.T) {
+	blockers := &shared.DeletionBlockers{
+		Count:     12,
+		Resources: []shared.Resource{{Name: "resource-a", Namespace: "default"}, {Name: "cluster-resource"}},
+	}
+
+	assert.Equal(t, "12 remaining: default/resource-a, cluster-resource and 10 more",
+		modulecr.DescribeDeletionBlockers(blockers))
+}
+
+func TestClient_DeleteModuleCRsExcludingDefaultCR_DeletesUserCreatedCRsOnly(t *testing.T) {
+	// Given a manifest CR with a default CR and two user-created Module CRs
+	skrClient, manifest := setupManifestWithModuleCRs(t, "resource-a", "resource-b")
+
+	// When force deleting the Module CRs
+	deleted, err := skrClient.DeleteModuleCRsExcludingDefaultCR(t.Context(), manifest)
+
+	// Then the user-created Module CRs are deleted and the default CR is kept
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	moduleCRs, err := skrClient.GetAllModuleCRsExcludingDefaultCR(t.Context(), manifest)
+	require.NoError(t, err)
+	assert.Empty(t, moduleCRs)
+	defaultCR := &unstructured.Unstructured{}
+	defaultCR.SetGroupVersionKind(manifest.Spec.Resource.GroupVersionKind())
+	require.NoError(t, skrClient.Get(t.Context(), client.ObjectKeyFromObject(manifest.Spec.Resource), defaultCR))
+}
+
+func setupManifestWithModuleCRs(t *testing.T, moduleCRNames ...string) (*modulecr.Client, *v1beta2.Manifest) {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	skrClient := modulecr.NewClient(fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(getRestMapper()).Build())
+
+	manifest := testutils.NewTestManifest("test-manifest")
+	defaultCR := &unstructured.Unstructured{}
+	defaultCR.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   templatev1alpha1.GroupVersion.Group,
+		Version: templatev1alpha1.GroupVersion.Version,
+		Kind:    string(templatev1alpha1.SampleKind),
+	})
+	defaultCR.SetName("default-resource")
+	defaultCR.SetNamespace(shared.DefaultRemoteNamespace)
+	manifest.Spec.Resource = defaultCR
+	require.NoError(t, skrClient.Create(t.Context(), defaultCR.DeepCopy()))
+
+	for _, name := range moduleCRNames {
+		moduleCR := &unstructured.Unstructured{}
+		moduleCR.SetGroupVersionKind(defaultCR.GroupVersionKind())
+		moduleCR.SetName(name)
+		moduleCR.SetNamespace("default")
+		require.NoError(t, skrClient.Create(t.Context(), moduleCR))
+	}
+	return skrClient, manifest
+}
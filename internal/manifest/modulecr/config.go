@@ -0,0 +1,182 @@
+package modulecr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/json"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+)
+
+const fieldsV1FieldPrefix = "f:"
+
+var ErrInvalidModuleConfig = errors.New("invalid module config")
+
+// MergeConfig merges the per-runtime module configuration over the default data of the ModuleTemplate
+// as JSON merge patch (RFC 7396). Objects are merged recursively, while lists and values in the configuration
+// replace the defaults. A null value removes the field from the defaults. The identity of the default data,
+// i.e. apiVersion, kind, name and namespace, can not be changed by the configuration.
+func MergeConfig(data *unstructured.Unstructured, config *machineryruntime.RawExtension,
+) (*unstructured.Unstructured, error) {
+	merged := data.DeepCopy()
+	if config == nil || len(config.Raw) == 0 {
+		return merged, nil
+	}
+
+	if err := json.Unmarshal(config.Raw, &map[string]any{}); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidModuleConfig, err)
+	}
+	dataJSON, err := json.Marshal(data.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal default data: %w", err)
+	}
+	mergedJSON, err := jsonpatch.MergePatch(dataJSON, config.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidModuleConfig, err)
+	}
+	merged.Object = map[string]any{}
+	if err := json.Unmarshal(mergedJSON, &merged.Object); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal merged module config: %w", err)
+	}
+
+	merged.SetAPIVersion(data.GetAPIVersion())
+	merged.SetKind(data.GetKind())
+	merged.SetName(data.GetName())
+	merged.SetNamespace(data.GetNamespace())
+	return merged, nil
+}
+
+// SyncModuleConfig applies the per-runtime configuration to the existing default module CR.
+// Only the configured fields are applied and owned by the module config field owner, so that server-side apply
+// removes the fields which are no longer configured, while all other fields can be changed in the runtime.
+// Once the configuration is removed, the fields owned by the module config are reverted to the default data
+// and their ownership is released.
+func (c *Client) SyncModuleConfig(ctx context.Context, manifest *v1beta2.Manifest) error {
+	if manifest.Spec.ResourceOverride == nil {
+		return c.releaseModuleConfig(ctx, manifest)
+	}
+
+	config := map[string]any{}
+	if err := json.Unmarshal(manifest.Spec.ResourceOverride.Raw, &config); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidModuleConfig, err)
+	}
+	return c.applyModuleConfig(ctx, manifest.Spec.Resource, withoutNullValues(config))
+}
+
+func (c *Client) releaseModuleConfig(ctx context.Context, manifest *v1beta2.Manifest) error {
+	resource := manifest.Spec.Resource.DeepCopy()
+	if err := c.Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	index, fields := managedFieldsOf(resource, fieldowners.ModuleConfig)
+	if index < 0 {
+		return nil
+	}
+	if err := c.applyModuleConfig(ctx, manifest.Spec.Resource,
+		ownedValues(manifest.Spec.Resource.Object, fields)); err != nil {
+		return err
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	if index, _ = managedFieldsOf(resource, fieldowners.ModuleConfig); index < 0 {
+		return nil
+	}
+	// the managed fields entry is tested first, so that a concurrent change of the managed fields is not removed
+	patch := fmt.Sprintf(`[{"op":"test","path":"/metadata/managedFields/%[1]d/manager","value":%[2]q},`+
+		`{"op":"remove","path":"/metadata/managedFields/%[1]d"}]`, index, fieldowners.ModuleConfig)
+	if err := c.Patch(ctx, resource, client.RawPatch(types.JSONPatchType, []byte(patch))); err != nil {
+		return fmt.Errorf("failed to release ownership of module config: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) applyModuleConfig(ctx context.Context, data *unstructured.Unstructured, fields map[string]any,
+) error {
+	resource := &unstructured.Unstructured{Object: fields}
+	resource.SetAPIVersion(data.GetAPIVersion())
+	resource.SetKind(data.GetKind())
+	resource.SetName(data.GetName())
+	resource.SetNamespace(data.GetNamespace())
+	if err := c.Patch(ctx, resource,
+		//nolint: staticcheck // issues: #2706, #2707
+		client.Apply,
+		fieldowners.ModuleConfig,
+		client.ForceOwnership,
+	); err != nil {
+		return fmt.Errorf("failed to apply module config to resource: %w", err)
+	}
+	return nil
+}
+
+// managedFieldsOf returns the index and the owned fields of the apply entry of the owner in the managed fields.
+func managedFieldsOf(resource *unstructured.Unstructured, owner client.FieldOwner) (int, map[string]any) {
+	for index, managedField := range resource.GetManagedFields() {
+		if managedField.Manager != string(owner) || managedField.Operation != apimetav1.ManagedFieldsOperationApply {
+			continue
+		}
+		fields := map[string]any{}
+		if managedField.FieldsV1 != nil {
+			_ = json.Unmarshal(managedField.FieldsV1.Raw, &fields)
+		}
+		return index, fields
+	}
+	return -1, nil
+}
+
+// ownedValues returns the values of data for the fields of a FieldsV1 set. Objects are descended into,
+// while lists are owned as a whole.
+func ownedValues(data map[string]any, fields map[string]any) map[string]any {
+	values := map[string]any{}
+	for key, children := range fields {
+		name, isField := strings.CutPrefix(key, fieldsV1FieldPrefix)
+		if !isField || name == "metadata" || name == "apiVersion" || name == "kind" {
+			continue
+		}
+		value, found := data[name]
+		if !found {
+			continue
+		}
+		childValue, isObject := value.(map[string]any)
+		childFields, hasChildren := children.(map[string]any)
+		if isObject && hasChildren && hasOnlyFieldChildren(childFields) && len(childFields) > 0 {
+			values[name] = ownedValues(childValue, childFields)
+			continue
+		}
+		values[name] = value
+	}
+	return values
+}
+
+func hasOnlyFieldChildren(fields map[string]any) bool {
+	for key := range fields {
+		if key != "." && !strings.HasPrefix(key, fieldsV1FieldPrefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// withoutNullValues removes the null values of a JSON merge patch, which only remove fields from the default data.
+func withoutNullValues(config map[string]any) map[string]any {
+	for key, value := range config {
+		switch typedValue := value.(type) {
+		case nil:
+			delete(config, key)
+		case map[string]any:
+			config[key] = withoutNullValues(typedValue)
+		}
+	}
+	return config
+}
@@ -0,0 +1,89 @@
+package modulecr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
+)
+
+func TestMergeConfig_WhenConfigIsNil_ReturnsCopyOfData(t *testing.T) {
+	data := newDefaultData()
+
+	merged, err := modulecr.MergeConfig(data, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, data, merged)
+	assert.NotSame(t, data, merged)
+}
+
+func TestMergeConfig_MergesConfigOverData(t *testing.T) {
+	data := newDefaultData()
+	config := &machineryruntime.RawExtension{Raw: []byte(`{
+		"metadata": {"name": "other-name", "labels": {"size": "large"}},
+		"spec": {"replicas": 3, "resources": {"cpu": "2"}, "zones": ["a"], "debug": null, "extra": {"x": 1, "y": null}}
+	}`)}
+
+	merged, err := modulecr.MergeConfig(data, config)
+
+	require.NoError(t, err)
+	assert.Equal(t, "default-cr", merged.GetName())
+	assert.Equal(t, "kyma-system", merged.GetNamespace())
+	assert.Equal(t, "operator.kyma-project.io/v1alpha1", merged.GetAPIVersion())
+	assert.Equal(t, "Sample", merged.GetKind())
+	assert.Equal(t, map[string]string{"size": "large"}, merged.GetLabels())
+	assert.Equal(t, map[string]any{
+		"replicas":  int64(3),
+		"resources": map[string]any{"cpu": "2", "memory": "1Gi"},
+		"zones":     []any{"a"},
+		"extra":     map[string]any{"x": int64(1)},
+	}, merged.Object["spec"])
+	assert.Equal(t, newDefaultData(), data, "default data must not be modified")
+}
+
+func TestMergeConfig_WhenKeyRemovedWithNull_RemovesDefault(t *testing.T) {
+	config := &machineryruntime.RawExtension{Raw: []byte(`{"spec": {"resources": {"memory": null}, "zones": null}}`)}
+
+	merged, err := modulecr.MergeConfig(newDefaultData(), config)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"replicas":  int64(1),
+		"debug":     true,
+		"resources": map[string]any{"cpu": "1"},
+	}, merged.Object["spec"])
+}
+
+func TestMergeConfig_WhenConfigIsEmpty_ReturnsData(t *testing.T) {
+	merged, err := modulecr.MergeConfig(newDefaultData(), &machineryruntime.RawExtension{Raw: []byte(`{}`)})
+
+	require.NoError(t, err)
+	assert.Equal(t, newDefaultData(), merged)
+}
+
+func TestMergeConfig_WhenConfigIsInvalid_ReturnsError(t *testing.T) {
+	_, err := modulecr.MergeConfig(newDefaultData(), &machineryruntime.RawExtension{Raw: []byte(`[1, 2]`)})
+
+	require.ErrorIs(t, err, modulecr.ErrInvalidModuleConfig)
+}
+
+func newDefaultData() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "operator.kyma-project.io/v1alpha1",
+		"kind":       "Sample",
+		"metadata": map[string]any{
+			"name":      "default-cr",
+			"namespace": "kyma-system",
+		},
+		"spec": map[string]any{
+			"replicas":  int64(1),
+			"debug":     true,
+			"resources": map[string]any{"cpu": "1", "memory": "1Gi"},
+			"zones":     []any{"a", "b"},
+		},
+	}}
+}
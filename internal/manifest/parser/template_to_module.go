@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"ocm.software/ocm/api/ocm"
 	"ocm.software/ocm/api/ocm/extensions/accessmethods/ociartifact"
@@ -15,6 +17,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
 	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 )
@@ -22,8 +25,11 @@ import (
 var (
 	ErrConvertingToOCIAccessSpec = errors.New("failed converting resource.AccessSpec to *ociartifact.AccessSpec")
 	ErrConvertingToImgOCI        = errors.New("failed converting layerRepresentation to *img.OCI")
+	ErrUpgradeHookNotFound       = errors.New("upgrade hook resource not found in descriptor")
 )
 
+const defaultUpgradeHookTimeout = 10 * time.Minute
+
 type Parser struct {
 	client.Client
 
@@ -157,7 +163,12 @@ func newManifestFromTemplate(
 
 	manifest.Spec.CustomResourcePolicy = module.CustomResourcePolicy
 	if template.Spec.Data != nil {
-		manifest.Spec.Resource = template.Spec.Data.DeepCopy()
+		resource, err := modulecr.MergeConfig(template.Spec.Data, module.Config)
+		if err != nil {
+			return nil, fmt.Errorf("could not merge config of module %s: %w", module.Name, err)
+		}
+		manifest.Spec.Resource = resource
+		manifest.Spec.ResourceOverride = module.Config.DeepCopy()
 	}
 
 	var layers img.Layers
@@ -178,6 +189,12 @@ func newManifestFromTemplate(
 	if template.Spec.Manager != nil {
 		manifest.Spec.Manager = template.Spec.Manager.DeepCopy()
 	}
+	manifest.Spec.TemplateManifest = template.Spec.TemplateManifest
+	if template.Spec.Hooks != nil {
+		if manifest.Spec.Hooks, err = translateUpgradeHooks(template.Spec.Hooks, layers, ociRegistry); err != nil {
+			return nil, fmt.Errorf("could not translate upgrade hooks: %w", err)
+		}
+	}
 	return manifest, nil
 }
 
@@ -259,3 +276,43 @@ func insertLayerIntoManifest(manifest *v1beta2.Manifest, layer img.Layer, ociReg
 
 	return nil
 }
+
+func translateUpgradeHooks(hooks *v1beta2.UpgradeHooks, layers img.Layers,
+	ociRegistry string,
+) (*v1beta2.ManifestUpgradeHooks, error) {
+	manifestHooks := &v1beta2.ManifestUpgradeHooks{}
+	var err error
+	if hooks.PreUpgrade != nil {
+		if manifestHooks.PreUpgrade, err = translateUpgradeHook(hooks.PreUpgrade, layers, ociRegistry); err != nil {
+			return nil, fmt.Errorf("error in pre-upgrade hook: %w", err)
+		}
+	}
+	if hooks.PostUpgrade != nil {
+		if manifestHooks.PostUpgrade, err = translateUpgradeHook(hooks.PostUpgrade, layers, ociRegistry); err != nil {
+			return nil, fmt.Errorf("error in post-upgrade hook: %w", err)
+		}
+	}
+	return manifestHooks, nil
+}
+
+// translateUpgradeHook resolves the descriptor resource referenced by the hook into the OCI layer
+// with the manifest of the hook Job.
+func translateUpgradeHook(hook *v1beta2.UpgradeHook, layers img.Layers,
+	ociRegistry string,
+) (*v1beta2.ManifestUpgradeHook, error) {
+	for _, layer := range layers {
+		if string(layer.LayerName) != hook.Resource {
+			continue
+		}
+		imageSpec, err := layer.ConvertToImageSpec(ociRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing layer %s: %w", layer.LayerName, err)
+		}
+		timeout := apimetav1.Duration{Duration: defaultUpgradeHookTimeout}
+		if hook.Timeout != nil {
+			timeout = *hook.Timeout
+		}
+		return &v1beta2.ManifestUpgradeHook{Image: *imageSpec, Timeout: timeout}, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUpgradeHookNotFound, hook.Resource)
+}
@@ -0,0 +1,481 @@
+package skrresources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// DriftDetectionMode configures how the manifest reconciler handles module resources that drifted on the SKR.
+type DriftDetectionMode string
+
+const (
+	// DriftDetectionDisabled skips the drift detection.
+	DriftDetectionDisabled DriftDetectionMode = "disabled"
+	// DriftDetectionCorrect reports drifted resources and corrects them by applying the desired state.
+	DriftDetectionCorrect DriftDetectionMode = "correct"
+	// DriftDetectionReportOnly reports drifted resources without applying the desired state to them.
+	DriftDetectionReportOnly DriftDetectionMode = "report-only"
+)
+
+const (
+	// MaxDriftedResourcesSample is the number of drifted resources listed in the drift report message.
+	MaxDriftedResourcesSample = 10
+	// MaxDriftedFieldsPerResource is the number of drifted fields recorded for a single resource.
+	MaxDriftedFieldsPerResource = 5
+	// DefaultDriftDetectionConcurrency is the number of module resources compared with the SKR at the same time.
+	DefaultDriftDetectionConcurrency = 10
+
+	statusSubresource = "status"
+	fieldPrefix       = "f:"
+	keyPrefix         = "k:"
+	indexPrefix       = "i:"
+	namePrefix        = "name="
+)
+
+var ErrDriftDetectionFailed = errors.New("drift detection of module resources failed")
+
+// DriftedResource is a module resource on the SKR whose live state differs from the desired state
+// in fields that were applied by Lifecycle Manager.
+type DriftedResource struct {
+	shared.Resource
+
+	// Missing is set if the resource was synced before but no longer exists.
+	Missing bool
+	// Fields are the paths of the drifted fields, limited to MaxDriftedFieldsPerResource.
+	Fields []string
+	// Managers are the field managers that changed the resource since it was last applied.
+	Managers []string
+}
+
+func (r DriftedResource) String() string {
+	name := r.Name
+	if r.Namespace != "" {
+		name = r.Namespace + "/" + name
+	}
+	var details string
+	if r.Missing {
+		details = "missing"
+	} else {
+		details = strings.Join(r.Fields, ", ")
+	}
+	if len(r.Managers) > 0 {
+		details += " (changed by " + strings.Join(r.Managers, ", ") + ")"
+	}
+	return fmt.Sprintf("%s %s: %s", r.Kind, name, details)
+}
+
+// DriftMessage describes the drifted resources, listing at most MaxDriftedResourcesSample of them.
+func DriftMessage(drifted []DriftedResource) string {
+	if len(drifted) == 0 {
+		return "no drift detected in module resources"
+	}
+	sample := make([]string, 0, MaxDriftedResourcesSample)
+	for _, driftedResource := range drifted[:min(len(drifted), MaxDriftedResourcesSample)] {
+		sample = append(sample, driftedResource.String())
+	}
+	message := fmt.Sprintf("%d module resources drifted from the desired state: %s",
+		len(drifted), strings.Join(sample, "; "))
+	if len(drifted) > MaxDriftedResourcesSample {
+		message += fmt.Sprintf("; and %d more", len(drifted)-MaxDriftedResourcesSample)
+	}
+	return message
+}
+
+// DriftDetector compares the live module resources on the SKR with their desired state.
+// Only fields set in the desired state are compared. The managed fields of the live object are used
+// to distinguish changes of other field managers from changes of the desired state, for example by an upgrade.
+type DriftDetector struct {
+	clnt        client.Reader
+	owner       client.FieldOwner
+	concurrency int
+}
+
+func NewDriftDetector(clnt client.Reader, owner client.FieldOwner,
+	opts ...func(*DriftDetector) *DriftDetector,
+) *DriftDetector {
+	detector := &DriftDetector{clnt: clnt, owner: owner, concurrency: DefaultDriftDetectionConcurrency}
+	for _, opt := range opts {
+		detector = opt(detector)
+	}
+	return detector
+}
+
+// WithConcurrency limits the number of module resources that are fetched from the SKR at the same time.
+func WithConcurrency(concurrency int) func(*DriftDetector) *DriftDetector {
+	return func(detector *DriftDetector) *DriftDetector {
+		detector.concurrency = concurrency
+		return detector
+	}
+}
+
+// Detect returns the drifted resources of the target, sorted by kind, namespace and name.
+// Resources that were not applied by the owner yet are not considered drifted,
+// resources that are missing are only considered drifted if they are part of the synced resources.
+// At most the configured concurrency of resources is fetched from the SKR at the same time.
+func (d *DriftDetector) Detect(ctx context.Context, target []*resource.Info, synced []shared.Resource,
+) ([]DriftedResource, error) {
+	type result struct {
+		drifted *DriftedResource
+		err     error
+	}
+	results := make([]result, len(target))
+	var group errgroup.Group
+	group.SetLimit(max(d.concurrency, 1))
+	for i := range target {
+		group.Go(func() error {
+			drifted, err := d.detect(ctx, target[i], synced)
+			results[i] = result{drifted: drifted, err: err}
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	var drifted []DriftedResource
+	var errs []error
+	for _, res := range results {
+		if res.err != nil {
+			errs = append(errs, res.err)
+			continue
+		}
+		if res.drifted != nil {
+			drifted = append(drifted, *res.drifted)
+		}
+	}
+	if errs != nil {
+		return nil, errors.Join(append(errs, ErrDriftDetectionFailed)...)
+	}
+
+	slices.SortFunc(drifted, func(a, b DriftedResource) int {
+		return strings.Compare(a.Kind+"/"+a.Namespace+"/"+a.Name, b.Kind+"/"+b.Namespace+"/"+b.Name)
+	})
+	return drifted, nil
+}
+
+func (d *DriftDetector) detect(ctx context.Context, info *resource.Info, synced []shared.Resource,
+) (*DriftedResource, error) {
+	desired, err := toUnstructured(info.Object)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", info.ObjectName(), err)
+	}
+	syncedResource := shared.Resource{
+		Name:             info.Name,
+		Namespace:        info.Namespace,
+		GroupVersionKind: apimetav1.GroupVersionKind(desired.GroupVersionKind()),
+	}
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := d.clnt.Get(ctx, client.ObjectKey{Namespace: info.Namespace, Name: info.Name}, live); err != nil {
+		if util.IsNotFound(err) {
+			if !containsResource(synced, syncedResource) {
+				return nil, nil
+			}
+			return &DriftedResource{Resource: syncedResource, Missing: true}, nil
+		}
+		return nil, fmt.Errorf("get for %s failed: %w", info.ObjectName(), supressLongClientErrors(err))
+	}
+
+	ownEntry := findApplyEntry(live.GetManagedFields(), string(d.owner))
+	if ownEntry == nil {
+		return nil, nil
+	}
+	ownFields := parseFieldsV1(ownEntry)
+	newerManagers := d.managersChangedSince(live.GetManagedFields(), ownEntry)
+
+	var fields, managers []string
+	for _, field := range compareObjects(desired.Object, live.Object) {
+		switch {
+		case !field.missing && !ownsField(ownFields, field.path):
+			// the field was updated by another field manager, which took over the ownership
+			managers = append(managers, ownersOfField(live.GetManagedFields(), field.path)...)
+		case field.missing && len(newerManagers) > 0:
+			// the field was removed by a field manager that changed the resource after it was applied
+			managers = append(managers, newerManagers...)
+		default:
+			// the desired state changed since the resource was applied, this is not a drift
+			continue
+		}
+		fields = append(fields, field.String())
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	slices.Sort(fields)
+	slices.Sort(managers)
+	return &DriftedResource{
+		Resource: syncedResource,
+		Fields:   fields[:min(len(fields), MaxDriftedFieldsPerResource)],
+		Managers: slices.Compact(managers),
+	}, nil
+}
+
+func (d *DriftDetector) managersChangedSince(entries []apimetav1.ManagedFieldsEntry,
+	ownEntry *apimetav1.ManagedFieldsEntry,
+) []string {
+	var managers []string
+	for _, entry := range entries {
+		if entry.Manager == string(d.owner) || entry.Subresource == statusSubresource {
+			continue
+		}
+		if entry.Time != nil && ownEntry.Time != nil && entry.Time.After(ownEntry.Time.Time) {
+			managers = append(managers, entry.Manager)
+		}
+	}
+	return managers
+}
+
+func toUnstructured(obj machineryruntime.Object) (*unstructured.Unstructured, error) {
+	if desired, ok := obj.(*unstructured.Unstructured); ok {
+		return desired, nil
+	}
+	content, err := machineryruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrClientObjectConversionFailed, err)
+	}
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+func containsResource(resources []shared.Resource, target shared.Resource) bool {
+	return slices.ContainsFunc(resources, func(synced shared.Resource) bool {
+		return synced.ID() == target.ID()
+	})
+}
+
+func findApplyEntry(entries []apimetav1.ManagedFieldsEntry, manager string) *apimetav1.ManagedFieldsEntry {
+	for i := range entries {
+		if entries[i].Manager == manager && entries[i].Operation == apimetav1.ManagedFieldsOperationApply &&
+			entries[i].Subresource == "" {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+func ownersOfField(entries []apimetav1.ManagedFieldsEntry, path []string) []string {
+	var owners []string
+	for i := range entries {
+		if entries[i].Subresource == statusSubresource {
+			continue
+		}
+		if ownsField(parseFieldsV1(&entries[i]), path) {
+			owners = append(owners, entries[i].Manager)
+		}
+	}
+	return owners
+}
+
+func parseFieldsV1(entry *apimetav1.ManagedFieldsEntry) map[string]any {
+	fields := map[string]any{}
+	if entry.FieldsV1 == nil {
+		return fields
+	}
+	if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+		return map[string]any{}
+	}
+	return fields
+}
+
+// ownsField checks if the path is part of the field set of a managed fields entry.
+// A path below a field without children is owned, as the field is managed atomically.
+// List items matched by name are considered owned if the list is keyed by other fields than the name.
+func ownsField(fields map[string]any, path []string) bool {
+	if len(fields) == 0 {
+		return false
+	}
+	node := fields
+	for i, element := range path {
+		if i > 0 && len(node) == 0 {
+			return true
+		}
+		name, isNamed := strings.CutPrefix(element, namePrefix)
+		if !isNamed {
+			child, found := node[element]
+			if !found {
+				return false
+			}
+			node, _ = child.(map[string]any)
+			continue
+		}
+
+		keyed, keyedByName := false, false
+		var match map[string]any
+		for key, child := range node {
+			keyJSON, isKey := strings.CutPrefix(key, keyPrefix)
+			if !isKey {
+				continue
+			}
+			keyed = true
+			keyFields := map[string]any{}
+			if err := json.Unmarshal([]byte(keyJSON), &keyFields); err != nil {
+				continue
+			}
+			if keyName, hasName := keyFields["name"]; hasName {
+				keyedByName = true
+				if keyName == name {
+					match, _ = child.(map[string]any)
+				}
+			}
+		}
+		switch {
+		case match != nil:
+			node = match
+		case keyed && !keyedByName:
+			return true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+type driftedField struct {
+	path    []string
+	missing bool
+}
+
+func (f driftedField) String() string {
+	var builder strings.Builder
+	for _, element := range f.path {
+		switch {
+		case strings.HasPrefix(element, fieldPrefix):
+			builder.WriteString("." + strings.TrimPrefix(element, fieldPrefix))
+		case strings.HasPrefix(element, namePrefix):
+			builder.WriteString("[" + element + "]")
+		default:
+			builder.WriteString("[" + strings.TrimPrefix(element, indexPrefix) + "]")
+		}
+	}
+	return builder.String()
+}
+
+// compareObjects returns the fields of the desired object that differ in the live object.
+// Identity and server-populated fields as well as status are not compared.
+func compareObjects(desired, live map[string]any) []driftedField {
+	var fields []driftedField
+	for key, desiredValue := range desired {
+		switch key {
+		case "apiVersion", "kind", "status", "stringData":
+			continue
+		case "metadata":
+			desiredMeta, _ := desiredValue.(map[string]any)
+			liveMeta, _ := live[key].(map[string]any)
+			for _, metaKey := range []string{"labels", "annotations"} {
+				fields = compareValues([]string{fieldPrefix + key, fieldPrefix + metaKey},
+					desiredMeta[metaKey], liveMeta[metaKey], fields)
+			}
+		default:
+			fields = compareValues([]string{fieldPrefix + key}, desiredValue, live[key], fields)
+		}
+	}
+	return fields
+}
+
+func compareValues(path []string, desired, live any, fields []driftedField) []driftedField {
+	switch desiredValue := desired.(type) {
+	case nil:
+		return fields
+	case map[string]any:
+		liveValue, isMap := live.(map[string]any)
+		if !isMap {
+			return appendDrift(path, len(desiredValue) == 0, live, fields)
+		}
+		for key, value := range desiredValue {
+			fields = compareValues(childPath(path, fieldPrefix+key), value, liveValue[key], fields)
+		}
+		return fields
+	case []any:
+		liveValue, isList := live.([]any)
+		if !isList {
+			return appendDrift(path, len(desiredValue) == 0, live, fields)
+		}
+		return compareLists(path, desiredValue, liveValue, fields)
+	default:
+		if scalarsEqual(desired, live) {
+			return fields
+		}
+		return append(fields, driftedField{path: path, missing: live == nil})
+	}
+}
+
+func compareLists(path []string, desired, live []any, fields []driftedField) []driftedField {
+	if names, named := itemNames(desired); named {
+		liveNames, _ := itemNames(live)
+		for i, name := range names {
+			itemPath := childPath(path, namePrefix+name)
+			liveIndex := slices.Index(liveNames, name)
+			if liveIndex < 0 {
+				fields = append(fields, driftedField{path: itemPath, missing: true})
+				continue
+			}
+			fields = compareValues(itemPath, desired[i], live[liveIndex], fields)
+		}
+		return fields
+	}
+	if len(desired) != len(live) {
+		return append(fields, driftedField{path: path, missing: len(live) < len(desired)})
+	}
+	for i := range desired {
+		fields = compareValues(childPath(path, indexPrefix+strconv.Itoa(i)), desired[i], live[i], fields)
+	}
+	return fields
+}
+
+// itemNames returns the names of the list items if all of them are objects with a name.
+func itemNames(items []any) ([]string, bool) {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		object, isObject := item.(map[string]any)
+		if !isObject {
+			return nil, false
+		}
+		name, hasName := object["name"].(string)
+		if !hasName {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, len(names) > 0
+}
+
+func appendDrift(path []string, emptyDesired bool, live any, fields []driftedField) []driftedField {
+	if live == nil && emptyDesired {
+		return fields
+	}
+	return append(fields, driftedField{path: path, missing: live == nil})
+}
+
+func childPath(path []string, element string) []string {
+	return append(slices.Clone(path), element)
+}
+
+// scalarsEqual compares scalar values, considering numbers and quantities equal independent of their notation.
+// Zero values are considered equal to absent values, as they are omitted by the API server.
+func scalarsEqual(desired, live any) bool {
+	if reflect.DeepEqual(desired, live) {
+		return true
+	}
+	if live == nil {
+		return reflect.ValueOf(desired).IsZero()
+	}
+	desiredQuantity, desiredErr := apiresource.ParseQuantity(fmt.Sprint(desired))
+	liveQuantity, liveErr := apiresource.ParseQuantity(fmt.Sprint(live))
+	return desiredErr == nil && liveErr == nil && desiredQuantity.Cmp(liveQuantity) == 0
+}
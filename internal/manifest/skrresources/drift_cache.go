@@ -0,0 +1,54 @@
+package skrresources
+
+import (
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DriftCheckCache keeps the result of the last drift detection per Manifest,
+// so that the module resources are fetched from the SKR at most once per interval.
+type DriftCheckCache struct {
+	interval time.Duration
+	checks   sync.Map
+}
+
+type driftCheck struct {
+	generation int64
+	checkedAt  time.Time
+	drifted    []DriftedResource
+}
+
+// NewDriftCheckCache creates a DriftCheckCache. With a zero interval, no result is reused.
+func NewDriftCheckCache(interval time.Duration) *DriftCheckCache {
+	return &DriftCheckCache{interval: interval}
+}
+
+// Get returns the drifted resources of the last detection for the Manifest
+// if it was done within the interval for the same generation of the Manifest.
+func (c *DriftCheckCache) Get(manifest client.Object) ([]DriftedResource, bool) {
+	value, ok := c.checks.Load(client.ObjectKeyFromObject(manifest))
+	if !ok {
+		return nil, false
+	}
+	check, ok := value.(driftCheck)
+	if !ok || check.generation != manifest.GetGeneration() || time.Since(check.checkedAt) >= c.interval {
+		return nil, false
+	}
+	return check.drifted, true
+}
+
+// Set stores the drifted resources detected for the Manifest.
+func (c *DriftCheckCache) Set(manifest client.Object, drifted []DriftedResource) {
+	c.checks.Store(client.ObjectKeyFromObject(manifest), driftCheck{
+		generation: manifest.GetGeneration(),
+		checkedAt:  time.Now(),
+		drifted:    drifted,
+	})
+}
+
+// Delete removes the result of the last detection for the Manifest.
+func (c *DriftCheckCache) Delete(manifest client.Object) {
+	c.checks.Delete(client.ObjectKeyFromObject(manifest))
+}
@@ -0,0 +1,62 @@
+package skrresources_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+)
+
+func TestDriftCheckCache_WithinInterval_ReturnsLastResult(t *testing.T) {
+	cache := skrresources.NewDriftCheckCache(time.Hour)
+	manifest := driftCheckManifest(1)
+	drifted := []skrresources.DriftedResource{{Resource: shared.Resource{Name: "config", Namespace: "default"}}}
+	cache.Set(manifest, drifted)
+
+	result, ok := cache.Get(manifest)
+
+	require.True(t, ok)
+	assert.Equal(t, drifted, result)
+}
+
+func TestDriftCheckCache_WhenGenerationChanged_ReturnsFalse(t *testing.T) {
+	cache := skrresources.NewDriftCheckCache(time.Hour)
+	cache.Set(driftCheckManifest(1), nil)
+
+	_, ok := cache.Get(driftCheckManifest(2))
+
+	assert.False(t, ok)
+}
+
+func TestDriftCheckCache_WithZeroInterval_ReturnsFalse(t *testing.T) {
+	cache := skrresources.NewDriftCheckCache(0)
+	manifest := driftCheckManifest(1)
+	cache.Set(manifest, nil)
+
+	_, ok := cache.Get(manifest)
+
+	assert.False(t, ok)
+}
+
+func TestDriftCheckCache_WhenDeleted_ReturnsFalse(t *testing.T) {
+	cache := skrresources.NewDriftCheckCache(time.Hour)
+	manifest := driftCheckManifest(1)
+	cache.Set(manifest, nil)
+
+	cache.Delete(manifest)
+
+	_, ok := cache.Get(manifest)
+	assert.False(t, ok)
+}
+
+func driftCheckManifest(generation int64) *v1beta2.Manifest {
+	return &v1beta2.Manifest{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "manifest", Namespace: "kcp-system", Generation: generation},
+	}
+}
@@ -0,0 +1,309 @@
+package skrresources_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+)
+
+const (
+	deploymentName      = "template-operator"
+	deploymentNamespace = "template-operator-system"
+	otherManager        = "kubectl-edit"
+	ownedFieldsJSON     = `{"f:metadata":{"f:labels":{"f:app":{}}},"f:spec":{"f:replicas":{},` +
+		`"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"manager\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`
+)
+
+var (
+	appliedAt = apimetav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	changedAt = apimetav1.NewTime(appliedAt.Add(time.Hour))
+)
+
+func TestDetect_WhenLiveMatchesDesired_ReportsNoDrift(t *testing.T) {
+	live := newDeployment(2, "manager:1.0.0")
+	live.SetManagedFields([]apimetav1.ManagedFieldsEntry{ownEntry(ownedFieldsJSON)})
+
+	drifted, err := newDetector(live).Detect(t.Context(), newTarget(2, "manager:1.0.0"), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+}
+
+func TestDetect_WhenFieldIsTakenOverByOtherManager_ReportsDrift(t *testing.T) {
+	live := newDeployment(5, "manager:1.0.0")
+	live.SetManagedFields([]apimetav1.ManagedFieldsEntry{
+		ownEntry(`{"f:metadata":{"f:labels":{"f:app":{}}},"f:spec":{"f:template":{"f:spec":{"f:containers":` +
+			`{"k:{\"name\":\"manager\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`),
+		updateEntry(`{"f:spec":{"f:replicas":{}}}`),
+	})
+
+	drifted, err := newDetector(live).Detect(t.Context(), newTarget(2, "manager:1.0.0"), nil)
+
+	require.NoError(t, err)
+	require.Len(t, drifted, 1)
+	assert.Equal(t, deploymentName, drifted[0].Name)
+	assert.Equal(t, "Deployment", drifted[0].Kind)
+	assert.Equal(t, []string{".spec.replicas"}, drifted[0].Fields)
+	assert.Equal(t, []string{otherManager}, drifted[0].Managers)
+	assert.False(t, drifted[0].Missing)
+}
+
+func TestDetect_WhenListItemIsTakenOverByOtherManager_ReportsDriftWithItemPath(t *testing.T) {
+	live := newDeployment(2, "manager:0.9.0")
+	live.SetManagedFields([]apimetav1.ManagedFieldsEntry{
+		ownEntry(`{"f:metadata":{"f:labels":{"f:app":{}}},"f:spec":{"f:replicas":{},"f:template":{"f:spec":` +
+			`{"f:containers":{"k:{\"name\":\"manager\"}":{".":{},"f:name":{}}}}}}}`),
+		updateEntry(`{"f:spec":{"f:template":{"f:spec":{"f:containers":` +
+			`{"k:{\"name\":\"manager\"}":{"f:image":{}}}}}}}`),
+	})
+
+	drifted, err := newDetector(live).Detect(t.Context(), newTarget(2, "manager:1.0.0"), nil)
+
+	require.NoError(t, err)
+	require.Len(t, drifted, 1)
+	assert.Equal(t, []string{".spec.template.spec.containers[name=manager].image"}, drifted[0].Fields)
+}
+
+func TestDetect_WhenDesiredStateChanged_ReportsNoDrift(t *testing.T) {
+	live := newDeployment(2, "manager:0.9.0")
+	live.SetManagedFields([]apimetav1.ManagedFieldsEntry{ownEntry(ownedFieldsJSON)})
+
+	drifted, err := newDetector(live).Detect(t.Context(), newTarget(3, "manager:1.0.0"), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+}
+
+func TestDetect_WhenFieldIsRemovedByOtherManager_ReportsDrift(t *testing.T) {
+	live := newDeployment(2, "manager:1.0.0")
+	live.SetLabels(nil)
+	live.SetManagedFields([]apimetav1.ManagedFieldsEntry{
+		ownEntry(`{"f:spec":{"f:replicas":{}}}`),
+		updateEntry(`{"f:metadata":{"f:annotations":{"f:note":{}}}}`),
+	})
+
+	drifted, err := newDetector(live).Detect(t.Context(), newTarget(2, "manager:1.0.0"), nil)
+
+	require.NoError(t, err)
+	require.Len(t, drifted, 1)
+	assert.Equal(t, []string{".metadata.labels"}, drifted[0].Fields)
+	assert.Equal(t, []string{otherManager}, drifted[0].Managers)
+}
+
+func TestDetect_WhenFieldIsAddedByUpgrade_ReportsNoDrift(t *testing.T) {
+	live := newDeployment(2, "manager:1.0.0")
+	live.SetLabels(nil)
+	live.SetManagedFields([]apimetav1.ManagedFieldsEntry{ownEntry(`{"f:spec":{"f:replicas":{}}}`)})
+
+	drifted, err := newDetector(live).Detect(t.Context(), newTarget(2, "manager:1.0.0"), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+}
+
+func TestDetect_WhenResourceWasNotAppliedByOwner_ReportsNoDrift(t *testing.T) {
+	live := newDeployment(5, "manager:0.1.0")
+	live.SetManagedFields([]apimetav1.ManagedFieldsEntry{updateEntry(ownedFieldsJSON)})
+
+	drifted, err := newDetector(live).Detect(t.Context(), newTarget(2, "manager:1.0.0"), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+}
+
+func TestDetect_WhenSyncedResourceIsMissing_ReportsMissingResource(t *testing.T) {
+	target := newTarget(2, "manager:1.0.0")
+	synced := skrresources.NewDefaultInfoToResourceConverter().InfosToResources(target)
+
+	drifted, err := newDetector(nil).Detect(t.Context(), target, synced)
+
+	require.NoError(t, err)
+	require.Len(t, drifted, 1)
+	assert.True(t, drifted[0].Missing)
+	assert.Equal(t, "Deployment template-operator-system/template-operator: missing", drifted[0].String())
+}
+
+func TestDetect_WhenNewResourceIsMissing_ReportsNoDrift(t *testing.T) {
+	drifted, err := newDetector(nil).Detect(t.Context(), newTarget(2, "manager:1.0.0"), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+}
+
+func TestDetect_WhenGetFails_ReturnsError(t *testing.T) {
+	detector := skrresources.NewDriftDetector(&readerStub{err: assert.AnError}, fieldowners.DeclarativeApplier)
+
+	_, err := detector.Detect(t.Context(), newTarget(2, "manager:1.0.0"), nil)
+
+	require.ErrorIs(t, err, assert.AnError)
+	require.ErrorIs(t, err, skrresources.ErrDriftDetectionFailed)
+}
+
+func TestDetect_WithConcurrency_BoundsConcurrentGets(t *testing.T) {
+	reader := &concurrencyReaderStub{}
+	detector := skrresources.NewDriftDetector(reader, fieldowners.DeclarativeApplier, skrresources.WithConcurrency(2))
+	target := make([]*resource.Info, 0, 10)
+	for range 10 {
+		target = append(target, newInfo(newDeployment(2, "manager:1.0.0")))
+	}
+
+	drifted, err := detector.Detect(t.Context(), target, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+	assert.Equal(t, int32(10), reader.gets.Load())
+	assert.LessOrEqual(t, reader.maxInFlight.Load(), int32(2))
+}
+
+func TestDetect_WithQuantitiesInDifferentNotation_ReportsNoDrift(t *testing.T) {
+	desired := newDeployment(2, "manager:1.0.0")
+	require.NoError(t, unstructured.SetNestedField(desired.Object, "0.5", "spec", "template", "metadata",
+		"annotations", "cpu"))
+	live := desired.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(live.Object, "500m", "spec", "template", "metadata",
+		"annotations", "cpu"))
+	live.SetManagedFields([]apimetav1.ManagedFieldsEntry{
+		ownEntry(`{"f:metadata":{"f:labels":{"f:app":{}}}}`),
+		updateEntry(`{"f:spec":{"f:replicas":{},"f:template":{}}}`),
+	})
+
+	drifted, err := newDetector(live).Detect(t.Context(), []*resource.Info{newInfo(desired)}, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+}
+
+func TestDriftMessage_ListsBoundedSample(t *testing.T) {
+	drifted := make([]skrresources.DriftedResource, skrresources.MaxDriftedResourcesSample+2)
+	for i := range drifted {
+		drifted[i] = skrresources.DriftedResource{
+			Resource: shared.Resource{
+				Name:             "config",
+				GroupVersionKind: apimetav1.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			},
+			Fields:   []string{".data.key"},
+			Managers: []string{otherManager},
+		}
+	}
+
+	message := skrresources.DriftMessage(drifted)
+
+	assert.Contains(t, message, "12 module resources drifted from the desired state: "+
+		"ConfigMap config: .data.key (changed by kubectl-edit); ")
+	assert.Contains(t, message, "; and 2 more")
+}
+
+func TestDriftMessage_WithoutDrift_ReturnsNoDriftMessage(t *testing.T) {
+	assert.Equal(t, "no drift detected in module resources", skrresources.DriftMessage(nil))
+}
+
+type readerStub struct {
+	object *unstructured.Unstructured
+	err    error
+}
+
+func (r *readerStub) Get(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.object == nil {
+		return apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, deploymentName)
+	}
+	r.object.DeepCopyInto(obj.(*unstructured.Unstructured))
+	return nil
+}
+
+func (r *readerStub) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	return nil
+}
+
+// concurrencyReaderStub records the maximum number of concurrent Get calls.
+type concurrencyReaderStub struct {
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+	gets        atomic.Int32
+}
+
+func (r *concurrencyReaderStub) Get(_ context.Context, _ client.ObjectKey, _ client.Object,
+	_ ...client.GetOption,
+) error {
+	r.gets.Add(1)
+	inFlight := r.inFlight.Add(1)
+	defer r.inFlight.Add(-1)
+	for {
+		current := r.maxInFlight.Load()
+		if inFlight <= current || r.maxInFlight.CompareAndSwap(current, inFlight) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, deploymentName)
+}
+
+func (r *concurrencyReaderStub) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	return nil
+}
+
+func newDetector(live *unstructured.Unstructured) *skrresources.DriftDetector {
+	return skrresources.NewDriftDetector(&readerStub{object: live}, fieldowners.DeclarativeApplier)
+}
+
+func newTarget(replicas int64, image string) []*resource.Info {
+	return []*resource.Info{newInfo(newDeployment(replicas, image))}
+}
+
+func newInfo(obj *unstructured.Unstructured) *resource.Info {
+	return &resource.Info{Name: obj.GetName(), Namespace: obj.GetNamespace(), Object: obj}
+}
+
+func newDeployment(replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      deploymentName,
+			"namespace": deploymentNamespace,
+			"labels":    map[string]any{"app": "template-operator"},
+		},
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"name": "manager", "image": image}},
+				},
+			},
+		},
+	}}
+}
+
+func ownEntry(fields string) apimetav1.ManagedFieldsEntry {
+	return apimetav1.ManagedFieldsEntry{
+		Manager:   string(fieldowners.DeclarativeApplier),
+		Operation: apimetav1.ManagedFieldsOperationApply,
+		Time:      &appliedAt,
+		FieldsV1:  &apimetav1.FieldsV1{Raw: []byte(fields)},
+	}
+}
+
+func updateEntry(fields string) apimetav1.ManagedFieldsEntry {
+	return apimetav1.ManagedFieldsEntry{
+		Manager:   otherManager,
+		Operation: apimetav1.ManagedFieldsOperationUpdate,
+		Time:      &changedAt,
+		FieldsV1:  &apimetav1.FieldsV1{Raw: []byte(fields)},
+	}
+}
@@ -14,8 +14,10 @@ import (
 
 var ErrWarningResourceSyncStateDiff = errors.New("resource syncTarget state diff detected")
 
+// SyncResources applies the target resources to the SKR and tracks them as synced resources of the Manifest.
+// The excluded resources are tracked as synced, but are not applied.
 func SyncResources(ctx context.Context, skrClient client.Client, manifest *v1beta2.Manifest,
-	target []*resource.Info,
+	target []*resource.Info, excluded []shared.Resource,
 ) error {
 	manifestStatus := manifest.GetStatus()
 
@@ -24,7 +26,7 @@ func SyncResources(ctx context.Context, skrClient client.Client, manifest *v1bet
 	if err := ConcurrentSSA(skrClient,
 		fieldowners.DeclarativeApplier,
 		managedFieldsCollector,
-	).Run(ctx, target); err != nil {
+	).Run(ctx, withoutExcluded(target, excluded)); err != nil {
 		manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
 		return err
 	}
@@ -47,6 +49,24 @@ func SyncResources(ctx context.Context, skrClient client.Client, manifest *v1bet
 	return nil
 }
 
+func withoutExcluded(target []*resource.Info, excluded []shared.Resource) []*resource.Info {
+	if len(excluded) == 0 {
+		return target
+	}
+	excludedIDs := make(map[string]bool, len(excluded))
+	for _, res := range excluded {
+		excludedIDs[res.ID()] = true
+	}
+	resources := NewDefaultInfoToResourceConverter().InfosToResources(target)
+	filtered := make([]*resource.Info, 0, len(target))
+	for i, info := range target {
+		if !excludedIDs[resources[i].ID()] {
+			filtered = append(filtered, info)
+		}
+	}
+	return filtered
+}
+
 func HasDiff(oldResources []shared.Resource, newResources []shared.Resource) bool {
 	if len(oldResources) != len(newResources) {
 		return true
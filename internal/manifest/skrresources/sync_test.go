@@ -4,10 +4,18 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
 
 func Test_HasDiff(t *testing.T) {
@@ -64,3 +72,32 @@ func Test_HasDiff(t *testing.T) {
 		})
 	}
 }
+
+func Test_SyncResources_WithExcludedResources_TracksButDoesNotApplyThem(t *testing.T) {
+	skrClient := fake.NewClientBuilder().Build()
+	manifest := &v1beta2.Manifest{}
+	manifest.SetStatus(shared.Status{State: shared.StateReady, Synced: []shared.Resource{}})
+	applied := newConfigMapInfo("applied")
+	excluded := newConfigMapInfo("excluded")
+	target := []*resource.Info{applied, excluded}
+
+	err := skrresources.SyncResources(t.Context(), skrClient, manifest, target,
+		skrresources.NewDefaultInfoToResourceConverter().InfosToResources([]*resource.Info{excluded}))
+
+	require.ErrorIs(t, err, skrresources.ErrWarningResourceSyncStateDiff)
+	require.Len(t, manifest.GetStatus().Synced, 2)
+	require.NoError(t, skrClient.Get(t.Context(), client.ObjectKey{Namespace: "default", Name: "applied"},
+		&apicorev1.ConfigMap{}))
+	err = skrClient.Get(t.Context(), client.ObjectKey{Namespace: "default", Name: "excluded"}, &apicorev1.ConfigMap{})
+	require.True(t, util.IsNotFound(err))
+}
+
+func newConfigMapInfo(name string) *resource.Info {
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": name, "namespace": "default"},
+		"data":       map[string]any{"key": "value"},
+	}}
+	return &resource.Info{Name: name, Namespace: "default", Object: configMap}
+}
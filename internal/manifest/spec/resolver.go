@@ -41,24 +41,50 @@ func (s *Resolver) GetSpec(ctx context.Context, manifest *v1beta2.Manifest) (*de
 		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
 	}
 
+	rawManifestPath, err := s.extractRawManifest(ctx, manifest, imageSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &declarativev2.Spec{
+		ManifestName: manifest.Spec.Install.Name,
+		Path:         rawManifestPath,
+		OCIRef:       imageSpec.Ref,
+	}, nil
+}
+
+// GetUpgradeHookSpec resolves the manifest of an upgrade hook of the Manifest.
+func (s *Resolver) GetUpgradeHookSpec(ctx context.Context, manifest *v1beta2.Manifest, hookName string,
+	hook *v1beta2.ManifestUpgradeHook,
+) (*declarativev2.Spec, error) {
+	hookManifestPath, err := s.extractRawManifest(ctx, manifest, hook.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	return &declarativev2.Spec{
+		ManifestName: hookName,
+		Path:         hookManifestPath,
+		OCIRef:       hook.Image.Ref,
+	}, nil
+}
+
+func (s *Resolver) extractRawManifest(ctx context.Context, manifest *v1beta2.Manifest,
+	imageSpec v1beta2.ImageSpec,
+) (string, error) {
 	if imageSpec.Type != v1beta2.OciRefType && imageSpec.Type != v1beta2.OciDirType {
-		return nil, fmt.Errorf("could not determine render mode for %s: %w",
+		return "", fmt.Errorf("could not determine render mode for %s: %w",
 			client.ObjectKeyFromObject(manifest), ErrRenderModeInvalid)
 	}
 
 	keyChain, err := s.keyChainLookup.Get(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch keyChain: %w", err)
+		return "", fmt.Errorf("failed to fetch keyChain: %w", err)
 	}
 
 	rawManifestPath, err := s.manifestPathExtractor.GetPathFromRawManifest(ctx, imageSpec, keyChain)
 	if err != nil {
-		return nil, fmt.Errorf("failed to extract raw manifest from layer digest: %w", err)
+		return "", fmt.Errorf("failed to extract raw manifest from layer digest: %w", err)
 	}
-
-	return &declarativev2.Spec{
-		ManifestName: manifest.Spec.Install.Name,
-		Path:         rawManifestPath,
-		OCIRef:       imageSpec.Ref,
-	}, nil
+	return rawManifestPath, nil
 }
@@ -113,6 +113,49 @@ func Test_GetSpec(t *testing.T) {
 	})
 }
 
+func Test_GetUpgradeHookSpec(t *testing.T) {
+	t.Run("should return a Spec for the layer of the hook", func(t *testing.T) {
+		// given
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, &mockPathExtractor{})
+		mft := v1beta2.Manifest{}
+		require.NoError(t, yaml.Unmarshal([]byte(testManifest), &mft))
+		hook := &v1beta2.ManifestUpgradeHook{
+			Image: v1beta2.ImageSpec{
+				Name: "kyma-project.io/module/template-operator",
+				Ref:  "sha256:1f5e0c2c4bd0a4a4bb5b0b9a7e1e1fb7a2bb0e8be6a3d6a1fb2c0b84a0a23a38",
+				Repo: "http://k3d-registry.localhost:5000/component-descriptors",
+				Type: v1beta2.OciRefType,
+			},
+		}
+
+		// when
+		actual, err := specResolver.GetUpgradeHookSpec(t.Context(), &mft, "pre-upgrade", hook)
+		require.NoError(t, err)
+
+		// then
+		expected := &declarativev2.Spec{
+			ManifestName: "pre-upgrade",
+			Path:         testPath(),
+			OCIRef:       hook.Image.Ref,
+		}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("should return an error with incorrect render mode", func(t *testing.T) {
+		// given
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, &mockPathExtractor{})
+		mft := v1beta2.Manifest{}
+		require.NoError(t, yaml.Unmarshal([]byte(testManifest), &mft))
+		hook := &v1beta2.ManifestUpgradeHook{Image: v1beta2.ImageSpec{Type: "invalid-ref"}}
+
+		// when
+		_, err := specResolver.GetUpgradeHookSpec(t.Context(), &mft, "pre-upgrade", hook)
+
+		// then
+		require.ErrorIs(t, err, spec.ErrRenderModeInvalid)
+	})
+}
+
 type mockKeyChainLookup struct {
 	mockError error
 }
@@ -0,0 +1,112 @@
+package statecheck
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+const (
+	ConditionTypeReady       = "Ready"
+	ConditionTypeAvailable   = "Available"
+	ConditionTypeReconciling = "Reconciling"
+	ConditionTypeStalled     = "Stalled"
+)
+
+// ConditionsStateCheck evaluates the status of an arbitrary resource following the kstatus conventions:
+// a resource whose latest generation is not observed yet or that is Reconciling is processing,
+// and a Stalled resource is erroneous. Otherwise, the state is taken from the .status.state field
+// used by Kyma modules or, if not set, from the Ready or Available condition.
+// A resource without any of these is considered ready.
+type ConditionsStateCheck struct{}
+
+func NewConditionsStateCheck() *ConditionsStateCheck {
+	return &ConditionsStateCheck{}
+}
+
+func (c *ConditionsStateCheck) GetState(_ context.Context, _ client.Client,
+	manager *unstructured.Unstructured,
+) (Result, error) {
+	return GetConditionsState(manager), nil
+}
+
+func GetConditionsState(obj *unstructured.Unstructured) Result {
+	name := fmt.Sprintf("%s %s", obj.GetKind(), objectName(obj.GetNamespace(), obj.GetName()))
+
+	observedGeneration, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if found && observedGeneration < obj.GetGeneration() {
+		return Result{
+			State:   shared.StateProcessing,
+			Message: name + ": waiting for the latest generation to be observed",
+		}
+	}
+
+	conditions := conditionsOf(obj)
+	if condition := meta.FindStatusCondition(conditions, ConditionTypeStalled); isTrue(condition) {
+		return Result{
+			State:   shared.StateError,
+			Message: fmt.Sprintf("%s is stalled: %s", name, conditionDetails(condition.Reason, condition.Message)),
+		}
+	}
+	if condition := meta.FindStatusCondition(conditions, ConditionTypeReconciling); isTrue(condition) {
+		return Result{
+			State:   shared.StateProcessing,
+			Message: fmt.Sprintf("%s is reconciling: %s", name, conditionDetails(condition.Reason, condition.Message)),
+		}
+	}
+
+	if state, found, _ := unstructured.NestedString(obj.Object, "status", "state"); found {
+		if moduleState := shared.State(state); moduleState.IsSupportedState() {
+			return Result{
+				State:   moduleState,
+				Message: fmt.Sprintf("%s is in state %s", name, moduleState),
+			}
+		}
+	}
+
+	for _, conditionType := range []string{ConditionTypeReady, ConditionTypeAvailable} {
+		condition := meta.FindStatusCondition(conditions, conditionType)
+		if condition == nil {
+			continue
+		}
+		if isTrue(condition) {
+			return Result{State: shared.StateReady, Message: fmt.Sprintf("%s is %s", name, conditionType)}
+		}
+		return Result{
+			State: shared.StateProcessing,
+			Message: fmt.Sprintf("%s is not %s: %s", name, conditionType,
+				conditionDetails(condition.Reason, condition.Message)),
+		}
+	}
+
+	return Result{State: shared.StateReady, Message: name + " reports no readiness conditions"}
+}
+
+func conditionsOf(obj *unstructured.Unstructured) []apimetav1.Condition {
+	rawConditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	conditions := make([]apimetav1.Condition, 0, len(rawConditions))
+	for _, rawCondition := range rawConditions {
+		fields, ok := rawCondition.(map[string]any)
+		if !ok {
+			continue
+		}
+		condition := apimetav1.Condition{}
+		condition.Type, _, _ = unstructured.NestedString(fields, "type")
+		status, _, _ := unstructured.NestedString(fields, "status")
+		condition.Status = apimetav1.ConditionStatus(status)
+		condition.Reason, _, _ = unstructured.NestedString(fields, "reason")
+		condition.Message, _, _ = unstructured.NestedString(fields, "message")
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+func isTrue(condition *apimetav1.Condition) bool {
+	return condition != nil && condition.Status == apimetav1.ConditionTrue
+}
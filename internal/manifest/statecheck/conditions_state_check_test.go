@@ -0,0 +1,88 @@
+package statecheck_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
+)
+
+func TestGetConditionsState(t *testing.T) {
+	tests := []struct {
+		name            string
+		generation      int64
+		status          map[string]any
+		expectedState   shared.State
+		expectedMessage string
+	}{
+		{
+			name:            "no status",
+			expectedState:   shared.StateReady,
+			expectedMessage: "Sample kyma-system/default reports no readiness conditions",
+		},
+		{
+			name:            "latest generation not observed",
+			generation:      2,
+			status:          map[string]any{"observedGeneration": int64(1)},
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "Sample kyma-system/default: waiting for the latest generation to be observed",
+		},
+		{
+			name: "stalled",
+			status: map[string]any{"conditions": []any{
+				map[string]any{"type": "Stalled", "status": "True", "reason": "InvalidConfig", "message": "bad value"},
+				map[string]any{"type": "Ready", "status": "True"},
+			}},
+			expectedState:   shared.StateError,
+			expectedMessage: "Sample kyma-system/default is stalled: InvalidConfig: bad value",
+		},
+		{
+			name: "reconciling",
+			status: map[string]any{"conditions": []any{
+				map[string]any{"type": "Reconciling", "status": "True", "reason": "Progressing"},
+			}},
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "Sample kyma-system/default is reconciling: Progressing",
+		},
+		{
+			name: "module state",
+			status: map[string]any{
+				"state": "Warning",
+				"conditions": []any{
+					map[string]any{"type": "Ready", "status": "True"},
+				},
+			},
+			expectedState:   shared.StateWarning,
+			expectedMessage: "Sample kyma-system/default is in state Warning",
+		},
+		{
+			name: "ready condition true",
+			status: map[string]any{"conditions": []any{
+				map[string]any{"type": "Ready", "status": "True"},
+			}},
+			expectedState:   shared.StateReady,
+			expectedMessage: "Sample kyma-system/default is Ready",
+		},
+		{
+			name: "available condition false",
+			status: map[string]any{"conditions": []any{
+				map[string]any{"type": "Available", "status": "False", "message": "0 of 1 replicas ready"},
+			}},
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "Sample kyma-system/default is not Available: 0 of 1 replicas ready",
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			obj := newSample(testCase.status)
+			obj.SetGeneration(testCase.generation)
+
+			result := statecheck.GetConditionsState(obj)
+
+			require.Equal(t, testCase.expectedState, result.State)
+			require.Equal(t, testCase.expectedMessage, result.Message)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package statecheck
+
+import (
+	"context"
+	"fmt"
+
+	apiappsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+type DaemonSetStateCheck struct{}
+
+func NewDaemonSetStateCheck() *DaemonSetStateCheck {
+	return &DaemonSetStateCheck{}
+}
+
+func (c *DaemonSetStateCheck) GetState(_ context.Context, _ client.Client,
+	manager *unstructured.Unstructured,
+) (Result, error) {
+	daemonSet := &apiappsv1.DaemonSet{}
+	if err := fromUnstructured(manager, daemonSet); err != nil {
+		return Result{State: shared.StateError}, err
+	}
+	return GetDaemonSetState(daemonSet), nil
+}
+
+// GetDaemonSetState reports a DaemonSet as ready once its latest generation is rolled out
+// and available on all scheduled nodes.
+func GetDaemonSetState(daemonSet *apiappsv1.DaemonSet) Result {
+	name := objectName(daemonSet.GetNamespace(), daemonSet.GetName())
+	status := daemonSet.Status
+
+	if status.ObservedGeneration < daemonSet.GetGeneration() {
+		return Result{
+			State:   shared.StateProcessing,
+			Message: fmt.Sprintf("%s %s: waiting for the latest generation to be observed", DaemonSetKind, name),
+		}
+	}
+	if status.UpdatedNumberScheduled < status.DesiredNumberScheduled {
+		return Result{
+			State: shared.StateProcessing,
+			Message: fmt.Sprintf("%s %s: %d of %d pods updated", DaemonSetKind, name,
+				status.UpdatedNumberScheduled, status.DesiredNumberScheduled),
+		}
+	}
+	if status.NumberAvailable < status.DesiredNumberScheduled {
+		return Result{
+			State: shared.StateProcessing,
+			Message: fmt.Sprintf("%s %s: %d of %d pods available", DaemonSetKind, name,
+				status.NumberAvailable, status.DesiredNumberScheduled),
+		}
+	}
+	return Result{
+		State: shared.StateReady,
+		Message: fmt.Sprintf("%s %s: %d of %d pods available", DaemonSetKind, name,
+			status.NumberAvailable, status.DesiredNumberScheduled),
+	}
+}
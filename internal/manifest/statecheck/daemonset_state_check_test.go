@@ -0,0 +1,84 @@
+package statecheck_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiappsv1 "k8s.io/api/apps/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
+)
+
+func TestGetDaemonSetState(t *testing.T) {
+	tests := []struct {
+		name            string
+		generation      int64
+		status          apiappsv1.DaemonSetStatus
+		expectedState   shared.State
+		expectedMessage string
+	}{
+		{
+			name:       "all pods updated and available",
+			generation: 2,
+			status: apiappsv1.DaemonSetStatus{
+				ObservedGeneration:     2,
+				DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 3,
+				NumberAvailable:        3,
+			},
+			expectedState:   shared.StateReady,
+			expectedMessage: "DaemonSet kyma-system/agent: 3 of 3 pods available",
+		},
+		{
+			name:       "latest generation not observed",
+			generation: 3,
+			status: apiappsv1.DaemonSetStatus{
+				ObservedGeneration:     2,
+				DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 3,
+				NumberAvailable:        3,
+			},
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "DaemonSet kyma-system/agent: waiting for the latest generation to be observed",
+		},
+		{
+			name:       "rollout in progress",
+			generation: 2,
+			status: apiappsv1.DaemonSetStatus{
+				ObservedGeneration:     2,
+				DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 1,
+				NumberAvailable:        3,
+			},
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "DaemonSet kyma-system/agent: 1 of 3 pods updated",
+		},
+		{
+			name:       "pods not available",
+			generation: 2,
+			status: apiappsv1.DaemonSetStatus{
+				ObservedGeneration:     2,
+				DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 3,
+				NumberAvailable:        2,
+			},
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "DaemonSet kyma-system/agent: 2 of 3 pods available",
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			daemonSet := &apiappsv1.DaemonSet{
+				ObjectMeta: apimetav1.ObjectMeta{Name: "agent", Namespace: "kyma-system", Generation: testCase.generation},
+				Status:     testCase.status,
+			}
+
+			result := statecheck.GetDaemonSetState(daemonSet)
+
+			require.Equal(t, testCase.expectedState, result.State)
+			require.Equal(t, testCase.expectedMessage, result.Message)
+		})
+	}
+}
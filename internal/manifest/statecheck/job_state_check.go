@@ -0,0 +1,68 @@
+package statecheck
+
+import (
+	"context"
+	"fmt"
+
+	apibatchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+type JobStateCheck struct{}
+
+func NewJobStateCheck() *JobStateCheck {
+	return &JobStateCheck{}
+}
+
+func (c *JobStateCheck) GetState(_ context.Context, _ client.Client,
+	manager *unstructured.Unstructured,
+) (Result, error) {
+	job := &apibatchv1.Job{}
+	if err := fromUnstructured(manager, job); err != nil {
+		return Result{State: shared.StateError}, err
+	}
+	return GetJobState(job), nil
+}
+
+// GetJobState reports a Job as ready once it completed and as erroneous once it failed.
+// A Job that has not finished yet is processing.
+func GetJobState(job *apibatchv1.Job) Result {
+	name := objectName(job.GetNamespace(), job.GetName())
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != apicorev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case apibatchv1.JobComplete:
+			return Result{
+				State:   shared.StateReady,
+				Message: fmt.Sprintf("%s %s completed", JobKind, name),
+			}
+		case apibatchv1.JobFailed:
+			return Result{
+				State:   shared.StateError,
+				Message: fmt.Sprintf("%s %s failed: %s", JobKind, name, conditionDetails(condition.Reason, condition.Message)),
+			}
+		case apibatchv1.JobSuspended, apibatchv1.JobFailureTarget, apibatchv1.JobSuccessCriteriaMet:
+		}
+	}
+	return Result{
+		State: shared.StateProcessing,
+		Message: fmt.Sprintf("%s %s is running: %d active, %d succeeded, %d failed pods", JobKind, name,
+			job.Status.Active, job.Status.Succeeded, job.Status.Failed),
+	}
+}
+
+func conditionDetails(reason, message string) string {
+	switch {
+	case message == "":
+		return reason
+	case reason == "":
+		return message
+	}
+	return reason + ": " + message
+}
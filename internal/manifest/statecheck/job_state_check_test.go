@@ -0,0 +1,76 @@
+package statecheck_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apibatchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
+)
+
+func TestGetJobState(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          apibatchv1.JobStatus
+		expectedState   shared.State
+		expectedMessage string
+	}{
+		{
+			name: "completed job",
+			status: apibatchv1.JobStatus{
+				Succeeded: 1,
+				Conditions: []apibatchv1.JobCondition{
+					{Type: apibatchv1.JobComplete, Status: apicorev1.ConditionTrue},
+				},
+			},
+			expectedState:   shared.StateReady,
+			expectedMessage: "Job kyma-system/migration completed",
+		},
+		{
+			name: "failed job",
+			status: apibatchv1.JobStatus{
+				Failed: 6,
+				Conditions: []apibatchv1.JobCondition{
+					{
+						Type:    apibatchv1.JobFailed,
+						Status:  apicorev1.ConditionTrue,
+						Reason:  "BackoffLimitExceeded",
+						Message: "Job has reached the specified backoff limit",
+					},
+				},
+			},
+			expectedState: shared.StateError,
+			expectedMessage: "Job kyma-system/migration failed: " +
+				"BackoffLimitExceeded: Job has reached the specified backoff limit",
+		},
+		{
+			name: "running job",
+			status: apibatchv1.JobStatus{
+				Active: 1,
+				Failed: 1,
+				Conditions: []apibatchv1.JobCondition{
+					{Type: apibatchv1.JobComplete, Status: apicorev1.ConditionFalse},
+				},
+			},
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "Job kyma-system/migration is running: 1 active, 0 succeeded, 1 failed pods",
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			job := &apibatchv1.Job{
+				ObjectMeta: apimetav1.ObjectMeta{Name: "migration", Namespace: "kyma-system"},
+				Status:     testCase.status,
+			}
+
+			result := statecheck.GetJobState(job)
+
+			require.Equal(t, testCase.expectedState, result.State)
+			require.Equal(t, testCase.expectedMessage, result.Message)
+		})
+	}
+}
@@ -2,17 +2,43 @@ package statecheck
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	apiappsv1 "k8s.io/api/apps/v1"
+	apibatchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
 
+var ErrManagerConversion = errors.New("failed to convert manager")
+
+// Result is the state of a module's manager together with a message describing it.
+type Result struct {
+	State   shared.State
+	Message string
+}
+
+// ManagerChecker determines the state of a manager of a specific kind.
+type ManagerChecker interface {
+	GetState(ctx context.Context, clnt client.Client, manager *unstructured.Unstructured) (Result, error)
+}
+
+// ManagerStateCheck determines the state of a module by the manager declared in the Manifest.
+// The checker is selected by the group and kind of the manager. Kinds without a registered checker
+// are evaluated by their status conditions.
 type ManagerStateCheck struct {
 	statefulSetChecker     StatefulSetStateChecker
 	deploymentStateChecker DeploymentStateChecker
+	checkers               map[schema.GroupKind]ManagerChecker
+	fallbackChecker        ManagerChecker
 }
 
 type DeploymentStateChecker interface {
@@ -28,6 +54,8 @@ type ManagerKind string
 const (
 	DeploymentKind  ManagerKind = "Deployment"
 	StatefulSetKind ManagerKind = "StatefulSet"
+	DaemonSetKind   ManagerKind = "DaemonSet"
+	JobKind         ManagerKind = "Job"
 )
 
 type Manager struct {
@@ -36,36 +64,105 @@ type Manager struct {
 	statefulSet *apiappsv1.StatefulSet
 }
 
+// NewManagerStateCheck creates a ManagerStateCheck with checkers for Deployments, StatefulSets, DaemonSets and Jobs.
+// Further checkers can be added with Register.
 func NewManagerStateCheck(statefulSetChecker StatefulSetStateChecker,
 	deploymentChecker DeploymentStateChecker,
 ) *ManagerStateCheck {
-	return &ManagerStateCheck{
+	check := &ManagerStateCheck{
 		statefulSetChecker:     statefulSetChecker,
 		deploymentStateChecker: deploymentChecker,
+		checkers:               map[schema.GroupKind]ManagerChecker{},
+		fallbackChecker:        NewConditionsStateCheck(),
 	}
+	check.Register(apiappsv1.SchemeGroupVersion.WithKind(string(DeploymentKind)).GroupKind(),
+		&deploymentManagerChecker{checker: deploymentChecker})
+	check.Register(apiappsv1.SchemeGroupVersion.WithKind(string(StatefulSetKind)).GroupKind(),
+		&statefulSetManagerChecker{checker: statefulSetChecker})
+	check.Register(apiappsv1.SchemeGroupVersion.WithKind(string(DaemonSetKind)).GroupKind(),
+		NewDaemonSetStateCheck())
+	check.Register(apibatchv1.SchemeGroupVersion.WithKind(string(JobKind)).GroupKind(),
+		NewJobStateCheck())
+	return check
+}
+
+// Register sets the checker used for managers of the given group and kind, replacing any previously registered one.
+func (m *ManagerStateCheck) Register(groupKind schema.GroupKind, checker ManagerChecker) {
+	m.checkers[groupKind] = checker
 }
 
-// GetState determines the state based on the manager. The manager may either be a Deployment or a StatefulSet and
-// must be included in the provided resources.
-// Will be refactored with https://github.com/kyma-project/lifecycle-manager/issues/1831
+// GetState determines the state based on the manager. If the Manifest declares a manager, the manager is fetched
+// from the cluster and evaluated by the checker registered for its kind. Otherwise, the first Deployment or
+// StatefulSet in the provided resources is used.
 func (m *ManagerStateCheck) GetState(ctx context.Context,
 	clnt client.Client,
+	manager *v1beta2.Manager,
 	resources []*resource.Info,
-) (shared.State, error) {
+) (Result, error) {
+	if manager != nil {
+		return m.getDeclaredManagerState(ctx, clnt, manager, resources)
+	}
+
 	mgr := findManager(clnt, resources)
 	if mgr == nil {
-		return shared.StateReady, nil
+		return Result{State: shared.StateReady}, nil
 	}
 
 	switch mgr.kind {
 	case StatefulSetKind:
-		return m.statefulSetChecker.GetState(ctx, clnt, mgr.statefulSet)
+		state, err := m.statefulSetChecker.GetState(ctx, clnt, mgr.statefulSet)
+		return Result{State: state, Message: workloadMessage(mgr.kind, mgr.statefulSet, state)}, err
 	case DeploymentKind:
-		return m.deploymentStateChecker.GetState(mgr.deployment)
+		state, err := m.deploymentStateChecker.GetState(mgr.deployment)
+		return Result{State: state, Message: workloadMessage(mgr.kind, mgr.deployment, state)}, err
+	case DaemonSetKind, JobKind:
 	}
 
 	// fall through that should not be reached
-	return shared.StateReady, nil
+	return Result{State: shared.StateReady}, nil
+}
+
+func (m *ManagerStateCheck) getDeclaredManagerState(ctx context.Context,
+	clnt client.Client,
+	manager *v1beta2.Manager,
+	resources []*resource.Info,
+) (Result, error) {
+	gvk := schema.GroupVersionKind{Group: manager.Group, Version: manager.Version, Kind: manager.Kind}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	namespace := manager.Namespace
+	if namespace == "" {
+		namespace = findNamespace(gvk.GroupKind(), manager.Name, resources)
+	}
+	if err := clnt.Get(ctx, client.ObjectKey{Namespace: namespace, Name: manager.Name}, obj); err != nil {
+		if util.IsNotFound(err) {
+			return Result{
+				State:   shared.StateProcessing,
+				Message: fmt.Sprintf("%s %s does not exist yet", manager.Kind, objectName(namespace, manager.Name)),
+			}, nil
+		}
+		return Result{State: shared.StateError}, fmt.Errorf("failed to get manager %s %s: %w",
+			manager.Kind, objectName(namespace, manager.Name), err)
+	}
+
+	checker, found := m.checkers[gvk.GroupKind()]
+	if !found {
+		checker = m.fallbackChecker
+	}
+	return checker.GetState(ctx, clnt, obj)
+}
+
+func findNamespace(groupKind schema.GroupKind, name string, resources []*resource.Info) string {
+	for _, res := range resources {
+		if res.Object == nil || res.Name != name {
+			continue
+		}
+		if res.Object.GetObjectKind().GroupVersionKind().GroupKind() == groupKind {
+			return res.Namespace
+		}
+	}
+	return ""
 }
 
 func findManager(clt client.Client, resources []*resource.Info) *Manager {
@@ -90,3 +187,62 @@ func findManager(clt client.Client, resources []*resource.Info) *Manager {
 
 	return nil
 }
+
+type deploymentManagerChecker struct {
+	checker DeploymentStateChecker
+}
+
+func (c *deploymentManagerChecker) GetState(_ context.Context, _ client.Client,
+	manager *unstructured.Unstructured,
+) (Result, error) {
+	deploy := &apiappsv1.Deployment{}
+	if err := fromUnstructured(manager, deploy); err != nil {
+		return Result{State: shared.StateError}, err
+	}
+	state, err := c.checker.GetState(deploy)
+	return Result{State: state, Message: workloadMessage(DeploymentKind, deploy, state)}, err
+}
+
+type statefulSetManagerChecker struct {
+	checker StatefulSetStateChecker
+}
+
+func (c *statefulSetManagerChecker) GetState(ctx context.Context, clnt client.Client,
+	manager *unstructured.Unstructured,
+) (Result, error) {
+	statefulSet := &apiappsv1.StatefulSet{}
+	if err := fromUnstructured(manager, statefulSet); err != nil {
+		return Result{State: shared.StateError}, err
+	}
+	state, err := c.checker.GetState(ctx, clnt, statefulSet)
+	return Result{State: state, Message: workloadMessage(StatefulSetKind, statefulSet, state)}, err
+}
+
+func fromUnstructured(manager *unstructured.Unstructured, obj any) error {
+	if err := machineryruntime.DefaultUnstructuredConverter.FromUnstructured(manager.Object, obj); err != nil {
+		return fmt.Errorf("%w %s %s: %w", ErrManagerConversion, manager.GetKind(),
+			objectName(manager.GetNamespace(), manager.GetName()), err)
+	}
+	return nil
+}
+
+func workloadMessage(kind ManagerKind, obj client.Object, state shared.State) string {
+	name := objectName(obj.GetNamespace(), obj.GetName())
+	switch state {
+	case shared.StateReady:
+		return fmt.Sprintf("%s %s is ready", kind, name)
+	case shared.StateProcessing:
+		return fmt.Sprintf("%s %s is rolling out", kind, name)
+	case shared.StateError:
+		return fmt.Sprintf("%s %s is not available", kind, name)
+	case shared.StateDeleting, shared.StateWarning, shared.StateUnmanaged, "":
+	}
+	return fmt.Sprintf("%s %s is in state %s", kind, name, state)
+}
+
+func objectName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
@@ -7,12 +7,15 @@ import (
 	"github.com/stretchr/testify/require"
 	apiappsv1 "k8s.io/api/apps/v1"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
 )
 
@@ -67,7 +70,7 @@ func TestManagerStateCheck_GetState(t *testing.T) {
 			statefulsetChecker := &StatefulSetStateCheckerStub{}
 			deploymentChecker := &DeploymentStateCheckerStub{}
 			m := statecheck.NewManagerStateCheck(statefulsetChecker, deploymentChecker)
-			got, err := m.GetState(t.Context(), clnt, testCase.resources)
+			got, err := m.GetState(t.Context(), clnt, nil, testCase.resources)
 
 			if testCase.expectedError == nil {
 				require.NoError(t, err)
@@ -78,18 +81,142 @@ func TestManagerStateCheck_GetState(t *testing.T) {
 			if testCase.isDeployment {
 				require.True(t, deploymentChecker.called)
 				require.False(t, statefulsetChecker.called)
-				require.Equal(t, shared.StateProcessing, got)
+				require.Equal(t, shared.StateProcessing, got.State)
 			}
 
 			if testCase.isStateFulSet {
 				require.True(t, statefulsetChecker.called)
 				require.False(t, deploymentChecker.called)
-				require.Equal(t, shared.StateReady, got)
+				require.Equal(t, shared.StateReady, got.State)
 			}
 		})
 	}
 }
 
+func TestManagerStateCheck_GetState_DeclaredManager(t *testing.T) {
+	deploymentManager := &v1beta2.Manager{
+		GroupVersionKind: apimetav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Namespace:        "kyma-system",
+		Name:             "module-manager",
+	}
+	daemonSetManager := &v1beta2.Manager{
+		GroupVersionKind: apimetav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+		Name:             "module-agent",
+	}
+	customManager := &v1beta2.Manager{
+		GroupVersionKind: apimetav1.GroupVersionKind{Group: "operator.kyma-project.io", Version: "v1", Kind: "Sample"},
+		Namespace:        "kyma-system",
+		Name:             "default",
+	}
+
+	tests := []struct {
+		name             string
+		manager          *v1beta2.Manager
+		objects          []client.Object
+		resources        []*resource.Info
+		expectedState    shared.State
+		expectedMessage  string
+		deploymentCalled bool
+	}{
+		{
+			name:    "declared Deployment is checked by the deployment checker",
+			manager: deploymentManager,
+			objects: []client.Object{&apiappsv1.Deployment{
+				ObjectMeta: apimetav1.ObjectMeta{Name: "module-manager", Namespace: "kyma-system"},
+			}},
+			expectedState:    shared.StateProcessing,
+			expectedMessage:  "Deployment kyma-system/module-manager is rolling out",
+			deploymentCalled: true,
+		},
+		{
+			name:    "declared DaemonSet without namespace is found in the resources",
+			manager: daemonSetManager,
+			objects: []client.Object{&apiappsv1.DaemonSet{
+				ObjectMeta: apimetav1.ObjectMeta{Name: "module-agent", Namespace: "kyma-system"},
+				Status: apiappsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 2,
+					UpdatedNumberScheduled: 2,
+					NumberAvailable:        2,
+				},
+			}},
+			resources: []*resource.Info{
+				{
+					Name:      "module-agent",
+					Namespace: "kyma-system",
+					Object: &apiappsv1.DaemonSet{
+						TypeMeta: apimetav1.TypeMeta{APIVersion: "apps/v1", Kind: "DaemonSet"},
+					},
+				},
+			},
+			expectedState:   shared.StateReady,
+			expectedMessage: "DaemonSet kyma-system/module-agent: 2 of 2 pods available",
+		},
+		{
+			name:            "missing manager is processing",
+			manager:         deploymentManager,
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "Deployment kyma-system/module-manager does not exist yet",
+		},
+		{
+			name:    "manager of an unregistered kind is evaluated by its conditions",
+			manager: customManager,
+			objects: []client.Object{newSample(map[string]any{
+				"conditions": []any{
+					map[string]any{"type": "Ready", "status": "False", "reason": "Installing"},
+				},
+			})},
+			expectedState:   shared.StateProcessing,
+			expectedMessage: "Sample kyma-system/default is not Ready: Installing",
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			scheme := machineryruntime.NewScheme()
+			_ = apiappsv1.AddToScheme(scheme)
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithObjects(testCase.objects...).Build()
+
+			deploymentChecker := &DeploymentStateCheckerStub{}
+			m := statecheck.NewManagerStateCheck(&StatefulSetStateCheckerStub{}, deploymentChecker)
+			got, err := m.GetState(t.Context(), clnt, testCase.manager, testCase.resources)
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedState, got.State)
+			require.Equal(t, testCase.expectedMessage, got.Message)
+			require.Equal(t, testCase.deploymentCalled, deploymentChecker.called)
+		})
+	}
+}
+
+func TestManagerStateCheck_Register(t *testing.T) {
+	manager := &v1beta2.Manager{
+		GroupVersionKind: apimetav1.GroupVersionKind{Group: "operator.kyma-project.io", Version: "v1", Kind: "Sample"},
+		Namespace:        "kyma-system",
+		Name:             "default",
+	}
+	clnt := fake.NewClientBuilder().WithObjects(newSample(nil)).Build()
+
+	m := statecheck.NewManagerStateCheck(&StatefulSetStateCheckerStub{}, &DeploymentStateCheckerStub{})
+	checker := &ManagerCheckerStub{}
+	m.Register(schema.GroupKind{Group: "operator.kyma-project.io", Kind: "Sample"}, checker)
+	got, err := m.GetState(t.Context(), clnt, manager, nil)
+
+	require.NoError(t, err)
+	require.True(t, checker.called)
+	require.Equal(t, shared.StateWarning, got.State)
+}
+
+func newSample(status map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetAPIVersion("operator.kyma-project.io/v1")
+	obj.SetKind("Sample")
+	obj.SetNamespace("kyma-system")
+	obj.SetName("default")
+	if status != nil {
+		obj.Object["status"] = status
+	}
+	return obj
+}
+
 // Test Stubs.
 type DeploymentStateCheckerStub struct {
 	called bool
@@ -110,3 +237,14 @@ func (s *StatefulSetStateCheckerStub) GetState(_ context.Context, _ client.Clien
 	s.called = true
 	return shared.StateReady, nil
 }
+
+type ManagerCheckerStub struct {
+	called bool
+}
+
+func (m *ManagerCheckerStub) GetState(_ context.Context, _ client.Client,
+	_ *unstructured.Unstructured,
+) (statecheck.Result, error) {
+	m.called = true
+	return statecheck.Result{State: shared.StateWarning}, nil
+}
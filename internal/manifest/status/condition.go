@@ -14,6 +14,8 @@ const (
 	ConditionTypeResources    ConditionType = "Resources"
 	ConditionTypeModuleCR     ConditionType = "ModuleCR"
 	ConditionTypeInstallation ConditionType = "Installation"
+	ConditionTypeDrift        ConditionType = "Drift"
+	ConditionTypeUpgradeHooks ConditionType = "UpgradeHooks"
 )
 
 type ConditionReason string
@@ -22,6 +24,12 @@ const (
 	ConditionReasonResourcesAreAvailable ConditionReason = "ResourcesAvailable"
 	ConditionReasonModuleCRCreated       ConditionReason = "ModuleCRCreated"
 	ConditionReasonReady                 ConditionReason = "Ready"
+	ConditionReasonDriftDetected         ConditionReason = "DriftDetected"
+	ConditionReasonNoDrift               ConditionReason = "NoDrift"
+	ConditionReasonDriftDetectionFailed  ConditionReason = "DriftDetectionFailed"
+	ConditionReasonUpgradeHookRunning    ConditionReason = "UpgradeHookRunning"
+	ConditionReasonUpgradeHookFailed     ConditionReason = "UpgradeHookFailed"
+	ConditionReasonUpgradeHookCompleted  ConditionReason = "UpgradeHookCompleted"
 )
 
 func InitializeStatusConditions(manifest *v1beta2.Manifest) {
@@ -95,6 +103,64 @@ func SetModuleCRInstallConditionTrue(manifest *v1beta2.Manifest) {
 	setConditionToTrue(manifest, ConditionTypeModuleCR, "module CR was created")
 }
 
+// SetDriftCondition records the result of the drift detection of the module resources.
+// The condition is true if at least one resource drifted from the desired state.
+func SetDriftCondition(manifest *v1beta2.Manifest, drifted bool, message string) {
+	status := manifest.GetStatus()
+	condition := apimetav1.Condition{
+		Type:               string(ConditionTypeDrift),
+		Reason:             string(ConditionReasonNoDrift),
+		Status:             apimetav1.ConditionFalse,
+		Message:            message,
+		ObservedGeneration: manifest.GetGeneration(),
+	}
+	if drifted {
+		condition.Reason = string(ConditionReasonDriftDetected)
+		condition.Status = apimetav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	manifest.SetStatus(status)
+}
+
+// SetDriftConditionUnknown records a failed drift detection of the module resources,
+// as a drift reported by a previous detection can neither be confirmed nor ruled out.
+func SetDriftConditionUnknown(manifest *v1beta2.Manifest, message string) {
+	status := manifest.GetStatus()
+	meta.SetStatusCondition(&status.Conditions, apimetav1.Condition{
+		Type:               string(ConditionTypeDrift),
+		Reason:             string(ConditionReasonDriftDetectionFailed),
+		Status:             apimetav1.ConditionUnknown,
+		Message:            message,
+		ObservedGeneration: manifest.GetGeneration(),
+	})
+	manifest.SetStatus(status)
+}
+
+// SetUpgradeHooksCondition records the progress of the upgrade hook running during an upgrade of the module.
+// The condition is true once the hook completed.
+func SetUpgradeHooksCondition(manifest *v1beta2.Manifest, reason ConditionReason, message string) {
+	status := manifest.GetStatus()
+	conditionStatus := apimetav1.ConditionFalse
+	if reason == ConditionReasonUpgradeHookCompleted {
+		conditionStatus = apimetav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&status.Conditions, apimetav1.Condition{
+		Type:               string(ConditionTypeUpgradeHooks),
+		Reason:             string(reason),
+		Status:             conditionStatus,
+		Message:            message,
+		ObservedGeneration: manifest.GetGeneration(),
+	})
+	manifest.SetStatus(status)
+}
+
+// IsUpgradeHookFailed reports whether the UpgradeHooks condition records a failed upgrade hook.
+func IsUpgradeHookFailed(status shared.Status) bool {
+	condition := meta.FindStatusCondition(status.Conditions, string(ConditionTypeUpgradeHooks))
+
+	return condition != nil && condition.Reason == string(ConditionReasonUpgradeHookFailed)
+}
+
 func setConditionToTrue(manifest *v1beta2.Manifest, conditionType ConditionType, message string) {
 	status := manifest.GetStatus()
 	condition := meta.FindStatusCondition(status.Conditions, string(conditionType))
@@ -275,3 +275,84 @@ func testSetConditionTrue(
 		require.Equal(t, expectedOperation, manifest.GetStatus().Operation)
 	})
 }
+
+func TestSetDriftCondition(t *testing.T) {
+	t.Run("drift detected - sets condition true", func(t *testing.T) {
+		manifest := &v1beta2.Manifest{}
+		manifest.SetGeneration(4)
+
+		status.SetDriftCondition(manifest, true, "1 module resources drifted")
+
+		drift := meta.FindStatusCondition(manifest.GetStatus().Conditions, string(status.ConditionTypeDrift))
+		require.NotNil(t, drift)
+		require.Equal(t, apimetav1.ConditionTrue, drift.Status)
+		require.Equal(t, string(status.ConditionReasonDriftDetected), drift.Reason)
+		require.Equal(t, "1 module resources drifted", drift.Message)
+		require.Equal(t, manifest.GetGeneration(), drift.ObservedGeneration)
+		require.Empty(t, manifest.GetStatus().Operation)
+	})
+
+	t.Run("drift corrected - sets condition false", func(t *testing.T) {
+		manifest := &v1beta2.Manifest{}
+		status.SetDriftCondition(manifest, true, "1 module resources drifted")
+
+		status.SetDriftCondition(manifest, false, "no drift")
+
+		conds := manifest.GetStatus().Conditions
+		require.Len(t, conds, 1)
+		require.Equal(t, apimetav1.ConditionFalse, conds[0].Status)
+		require.Equal(t, string(status.ConditionReasonNoDrift), conds[0].Reason)
+		require.Equal(t, "no drift", conds[0].Message)
+	})
+
+	t.Run("drift detection failed - sets condition unknown", func(t *testing.T) {
+		manifest := &v1beta2.Manifest{}
+		status.SetDriftCondition(manifest, true, "1 module resources drifted")
+
+		status.SetDriftConditionUnknown(manifest, "drift detection of module resources failed")
+
+		conds := manifest.GetStatus().Conditions
+		require.Len(t, conds, 1)
+		require.Equal(t, apimetav1.ConditionUnknown, conds[0].Status)
+		require.Equal(t, string(status.ConditionReasonDriftDetectionFailed), conds[0].Reason)
+		require.Equal(t, "drift detection of module resources failed", conds[0].Message)
+	})
+}
+
+func TestSetUpgradeHooksCondition(t *testing.T) {
+	t.Run("hook running - sets condition false", func(t *testing.T) {
+		manifest := &v1beta2.Manifest{}
+		manifest.SetGeneration(2)
+
+		status.SetUpgradeHooksCondition(manifest, status.ConditionReasonUpgradeHookRunning,
+			"waiting for pre-upgrade hook Job to complete")
+
+		hooks := meta.FindStatusCondition(manifest.GetStatus().Conditions, string(status.ConditionTypeUpgradeHooks))
+		require.NotNil(t, hooks)
+		require.Equal(t, apimetav1.ConditionFalse, hooks.Status)
+		require.Equal(t, string(status.ConditionReasonUpgradeHookRunning), hooks.Reason)
+		require.Equal(t, manifest.GetGeneration(), hooks.ObservedGeneration)
+		require.False(t, status.IsUpgradeHookFailed(manifest.GetStatus()))
+	})
+
+	t.Run("hook failed - reported as failed", func(t *testing.T) {
+		manifest := &v1beta2.Manifest{}
+
+		status.SetUpgradeHooksCondition(manifest, status.ConditionReasonUpgradeHookFailed, "pre-upgrade hook failed")
+
+		require.True(t, status.IsUpgradeHookFailed(manifest.GetStatus()))
+	})
+
+	t.Run("hook completed - sets condition true", func(t *testing.T) {
+		manifest := &v1beta2.Manifest{}
+		status.SetUpgradeHooksCondition(manifest, status.ConditionReasonUpgradeHookFailed, "pre-upgrade hook failed")
+
+		status.SetUpgradeHooksCondition(manifest, status.ConditionReasonUpgradeHookCompleted,
+			"post-upgrade hook completed")
+
+		conds := manifest.GetStatus().Conditions
+		require.Len(t, conds, 1)
+		require.Equal(t, apimetav1.ConditionTrue, conds[0].Status)
+		require.False(t, status.IsUpgradeHookFailed(manifest.GetStatus()))
+	})
+}
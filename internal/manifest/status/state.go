@@ -10,19 +10,31 @@ const (
 	WaitingForResourcesMsg = "waiting for resources to become ready"
 )
 
-func RequireManifestStateUpdateAfterSyncResource(manifest *v1beta2.Manifest, newState shared.State) bool {
+// RequireManifestStateUpdateAfterSyncResource sets the new state on the Manifest and reports whether its status changed.
+// The message reported by the manager state check, if any, is appended to the operation.
+// While the Manifest is not ready, a changed message also requires an update, so progress stays visible.
+func RequireManifestStateUpdateAfterSyncResource(manifest *v1beta2.Manifest, newState shared.State,
+	message string,
+) bool {
 	manifestStatus := manifest.GetStatus()
+	waiting := newState == shared.StateProcessing || newState == shared.StateError
 
-	if newState == manifestStatus.State {
+	operation := ResourcesAreReadyMsg
+	if waiting {
+		operation = WaitingForResourcesMsg
+	}
+	if message != "" {
+		operation += ": " + message
+	}
+
+	if newState == manifestStatus.State && (!waiting || operation == manifestStatus.Operation) {
 		return false
 	}
 
-	if newState == shared.StateProcessing || newState == shared.StateError {
-		manifest.SetStatus(manifestStatus.WithState(newState).WithOperation(WaitingForResourcesMsg))
-	} else {
+	if !waiting {
 		SetInstallationConditionTrue(manifest)
-		manifest.SetStatus(manifestStatus.WithState(newState).WithOperation(ResourcesAreReadyMsg))
 	}
+	manifest.SetStatus(manifestStatus.WithState(newState).WithOperation(operation))
 
 	return true
 }
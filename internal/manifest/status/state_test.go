@@ -11,7 +11,10 @@ import (
 func TestRequireManifestStateUpdateAfterSyncResource(t *testing.T) {
 	tests := []struct {
 		name          string
+		currentState  shared.State
+		currentOp     string
 		newState      shared.State
+		message       string
 		expectedState shared.State
 		expectedOp    string
 		expectUpdate  bool
@@ -37,19 +40,50 @@ func TestRequireManifestStateUpdateAfterSyncResource(t *testing.T) {
 			expectedOp:    status.ResourcesAreReadyMsg,
 			expectUpdate:  false,
 		},
+		{
+			name:          "State changes to Processing with message",
+			newState:      shared.StateProcessing,
+			message:       "DaemonSet kyma-system/agent: 1 of 3 pods updated",
+			expectedState: shared.StateProcessing,
+			expectedOp:    status.WaitingForResourcesMsg + ": DaemonSet kyma-system/agent: 1 of 3 pods updated",
+			expectUpdate:  true,
+		},
+		{
+			name:          "Message changes while Processing",
+			currentState:  shared.StateProcessing,
+			currentOp:     status.WaitingForResourcesMsg + ": DaemonSet kyma-system/agent: 1 of 3 pods updated",
+			newState:      shared.StateProcessing,
+			message:       "DaemonSet kyma-system/agent: 2 of 3 pods updated",
+			expectedState: shared.StateProcessing,
+			expectedOp:    status.WaitingForResourcesMsg + ": DaemonSet kyma-system/agent: 2 of 3 pods updated",
+			expectUpdate:  true,
+		},
+		{
+			name:          "Message changes while Ready",
+			newState:      shared.StateReady,
+			message:       "Deployment kyma-system/manager is ready",
+			expectedState: shared.StateReady,
+			expectedOp:    status.ResourcesAreReadyMsg,
+			expectUpdate:  false,
+		},
 	}
 
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
 			manifest := &v1beta2.Manifest{}
 			manifest.SetGeneration(1)
+			currentState, currentOp := shared.StateReady, status.ResourcesAreReadyMsg
+			if testCase.currentState != "" {
+				currentState, currentOp = testCase.currentState, testCase.currentOp
+			}
 			manifestStatus := shared.Status{
-				State: shared.StateReady,
+				State: currentState,
 			}
-			manifestStatus = manifestStatus.WithOperation(status.ResourcesAreReadyMsg)
+			manifestStatus = manifestStatus.WithOperation(currentOp)
 			manifest.SetStatus(manifestStatus)
 
-			updated := status.RequireManifestStateUpdateAfterSyncResource(manifest, testCase.newState)
+			updated := status.RequireManifestStateUpdateAfterSyncResource(manifest, testCase.newState,
+				testCase.message)
 			if updated != testCase.expectUpdate {
 				t.Errorf("expected update to be %v, got %v", testCase.expectUpdate, updated)
 			}
@@ -0,0 +1,146 @@
+package templating
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	ErrUndefinedValue     = errors.New("template value is not defined")
+	ErrInvalidPlaceholder = errors.New("invalid template placeholder")
+)
+
+const defaultSeparator = ":-"
+
+// placeholderPattern matches placeholders such as ${kyma.region} or ${config.replicas:-1}.
+// A placeholder prefixed with another $ is escaped and rendered as a literal ${...}.
+var placeholderPattern = regexp.MustCompile(`\$?\$\{([^{}]*)\}`)
+
+// Render replaces the placeholders in all string values of the objects with the values.
+// A placeholder that makes up a whole value is replaced with the value as is, so that numbers, booleans,
+// and objects keep their type. Placeholders embedded in a longer string must resolve to scalar values.
+// A placeholder without a default that refers to an undefined value fails the rendering.
+func Render(objs []*unstructured.Unstructured, values Values) error {
+	for _, obj := range objs {
+		if err := renderObject(obj.Object, values); err != nil {
+			return fmt.Errorf("failed to template %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func renderObject(object map[string]any, values Values) error {
+	for key, field := range object {
+		rendered, err := renderField(field, values)
+		if err != nil {
+			return fmt.Errorf("in field %s: %w", key, err)
+		}
+		object[key] = rendered
+	}
+	return nil
+}
+
+func renderField(field any, values Values) (any, error) {
+	switch typed := field.(type) {
+	case map[string]any:
+		return typed, renderObject(typed, values)
+	case []any:
+		for i, item := range typed {
+			rendered, err := renderField(item, values)
+			if err != nil {
+				return nil, err
+			}
+			typed[i] = rendered
+		}
+		return typed, nil
+	case string:
+		return renderString(typed, values)
+	default:
+		return field, nil
+	}
+}
+
+func renderString(str string, values Values) (any, error) {
+	if !strings.Contains(str, "${") {
+		return str, nil
+	}
+
+	matches := placeholderPattern.FindAllStringSubmatchIndex(str, -1)
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(str) && !strings.HasPrefix(str, "$$") {
+		return resolve(str[matches[0][2]:matches[0][3]], true, values)
+	}
+
+	var errs []error
+	rendered := placeholderPattern.ReplaceAllStringFunc(str, func(placeholder string) string {
+		if strings.HasPrefix(placeholder, "$$") {
+			return placeholder[1:]
+		}
+		value, err := resolve(placeholder[2:len(placeholder)-1], false, values)
+		if err != nil {
+			errs = append(errs, err)
+			return placeholder
+		}
+		scalar, err := toString(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s", err, placeholder))
+			return placeholder
+		}
+		return scalar
+	})
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return rendered, nil
+}
+
+// resolve returns the value of the placeholder expression `path` or `path:-default`. The default of a
+// placeholder that makes up a whole value keeps its type if it is an integer or a boolean.
+func resolve(expression string, wholeValue bool, values Values) (any, error) {
+	path, defaultValue, hasDefault := strings.Cut(expression, defaultSeparator)
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("%w: ${%s}", ErrInvalidPlaceholder, expression)
+	}
+
+	if value, found := values.lookup(strings.Split(path, ".")); found {
+		return machineryruntime.DeepCopyJSONValue(value), nil
+	}
+	if !hasDefault {
+		return nil, fmt.Errorf("%w: %s", ErrUndefinedValue, path)
+	}
+	if wholeValue {
+		return parseScalar(defaultValue), nil
+	}
+	return defaultValue, nil
+}
+
+func parseScalar(str string) any {
+	if integer, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return integer
+	}
+	if boolean, err := strconv.ParseBool(str); err == nil && (str == "true" || str == "false") {
+		return boolean
+	}
+	return str
+}
+
+func toString(value any) (string, error) {
+	switch typed := value.(type) {
+	case string:
+		return typed, nil
+	case int64:
+		return strconv.FormatInt(typed, 10), nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(typed), nil
+	default:
+		return "", fmt.Errorf("%w: value must be a scalar to be embedded in a string", ErrInvalidPlaceholder)
+	}
+}
@@ -0,0 +1,129 @@
+package templating_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/templating"
+)
+
+func TestRender_ReplacesPlaceholders(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":   "template-operator",
+			"labels": map[string]any{"region": "${kyma.region}"},
+		},
+		"spec": map[string]any{
+			"replicas": "${config.replicas}",
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"image": "europe-docker.pkg.dev/kyma-project/template-operator:${module.version}",
+							"args": []any{
+								"--plan=${kyma.plan}",
+								"--zone=${kyma.zone:-default}",
+								"--literal=$${kyma.region}",
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	err := templating.Render([]*unstructured.Unstructured{deployment}, templating.NewValues(manifest()))
+
+	require.NoError(t, err)
+	assert.Equal(t, "europe-west1", deployment.GetLabels()["region"])
+	replicas, _, _ := unstructured.NestedFieldNoCopy(deployment.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+	containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	container, _ := containers[0].(map[string]any)
+	assert.Equal(t, "europe-docker.pkg.dev/kyma-project/template-operator:1.1.0", container["image"])
+	assert.Equal(t, []any{"--plan=gcp", "--zone=default", "--literal=${kyma.region}"}, container["args"])
+}
+
+func TestRender_WholeValuePlaceholder_KeepsTypeOfValue(t *testing.T) {
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"kind": "ConfigMap",
+		"data": map[string]any{
+			"features": "${config.features}",
+			"debug":    "${config.debug:-false}",
+			"retries":  "${config.retries:-5}",
+		},
+	}}
+
+	err := templating.Render([]*unstructured.Unstructured{configMap}, templating.NewValues(manifest()))
+
+	require.NoError(t, err)
+	data, _, _ := unstructured.NestedMap(configMap.Object, "data")
+	assert.Equal(t, map[string]any{"tracing": true}, data["features"])
+	assert.Equal(t, false, data["debug"])
+	assert.Equal(t, int64(5), data["retries"])
+}
+
+func TestRender_WhenValueUndefined_ReturnsError(t *testing.T) {
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"kind": "ConfigMap",
+		"data": map[string]any{"account": "account-${kyma.globalAccountId}"},
+	}}
+
+	err := templating.Render([]*unstructured.Unstructured{configMap}, templating.NewValues(manifest()))
+
+	require.ErrorIs(t, err, templating.ErrUndefinedValue)
+	require.ErrorContains(t, err, "kyma.globalAccountId")
+}
+
+func TestRender_WhenObjectEmbeddedInString_ReturnsError(t *testing.T) {
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"kind": "ConfigMap",
+		"data": map[string]any{"features": "features: ${config.features}"},
+	}}
+
+	err := templating.Render([]*unstructured.Unstructured{configMap}, templating.NewValues(manifest()))
+
+	require.ErrorIs(t, err, templating.ErrInvalidPlaceholder)
+}
+
+func TestRender_WithoutPlaceholders_KeepsObject(t *testing.T) {
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"kind": "ConfigMap",
+		"data": map[string]any{"rule": "{{ $labels.instance }} is down", "price": "$5"},
+	}}
+	expected := configMap.DeepCopy()
+
+	err := templating.Render([]*unstructured.Unstructured{configMap}, templating.NewValues(manifest()))
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, configMap)
+}
+
+func manifest() *v1beta2.Manifest {
+	resource := &unstructured.Unstructured{Object: map[string]any{
+		"kind": "Sample",
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"features": map[string]any{"tracing": true},
+		},
+	}}
+	return &v1beta2.Manifest{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Labels: map[string]string{
+				shared.KymaName:    "kyma-sample",
+				shared.ModuleName:  "template-operator",
+				shared.RegionLabel: "europe-west1",
+				shared.PlanLabel:   "gcp",
+			},
+		},
+		Spec: v1beta2.ManifestSpec{Version: "1.1.0", Resource: resource},
+	}
+}
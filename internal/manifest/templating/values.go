@@ -0,0 +1,63 @@
+package templating
+
+import (
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// Values are the values available to the placeholders of a templated manifest, addressed by dotted paths
+// such as `kyma.region` or `config.replicas`.
+type Values map[string]any
+
+// kymaValues maps the values under `kyma` to the runtime labels the Manifest carries.
+var kymaValues = map[string]string{
+	"name":            shared.KymaName,
+	"globalAccountId": shared.GlobalAccountIDLabel,
+	"subAccountId":    shared.SubAccountIDLabel,
+	"region":          shared.RegionLabel,
+	"platformRegion":  shared.PlatformRegionLabel,
+	"plan":            shared.PlanLabel,
+	"runtimeId":       shared.RuntimeIDLabel,
+}
+
+// NewValues collects the values of the runtime and the module of the Manifest:
+//   - `kyma` contains the values of the runtime labels that are set on the Manifest.
+//   - `module` contains the name and the version of the module.
+//   - `config` contains the spec of the module CR, which includes the module configuration of the Kyma.
+func NewValues(manifest *v1beta2.Manifest) Values {
+	kyma := make(map[string]any)
+	for key, label := range kymaValues {
+		if value, ok := manifest.GetLabels()[label]; ok {
+			kyma[key] = value
+		}
+	}
+
+	module := map[string]any{"version": manifest.Spec.Version}
+	if name, ok := manifest.GetLabels()[shared.ModuleName]; ok {
+		module["name"] = name
+	}
+
+	config := make(map[string]any)
+	if manifest.Spec.Resource != nil {
+		if spec, ok := manifest.Spec.Resource.Object["spec"].(map[string]any); ok {
+			config = spec
+		}
+	}
+
+	return Values{"kyma": kyma, "module": module, "config": config}
+}
+
+// lookup returns the value at the dotted path.
+func (v Values) lookup(path []string) (any, bool) {
+	var current any = map[string]any(v)
+	for _, key := range path {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
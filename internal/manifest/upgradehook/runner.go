@@ -0,0 +1,157 @@
+package upgradehook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// Hook identifies when an upgrade hook runs during the upgrade of a module.
+type Hook string
+
+const (
+	PreUpgrade  Hook = "pre-upgrade"
+	PostUpgrade Hook = "post-upgrade"
+)
+
+// MinJobTTLAfterFinished is the shortest time a finished hook Job is kept, so that its completion is observed
+// before the Job is removed. A shorter ttlSecondsAfterFinished in the hook manifest is raised to it.
+const MinJobTTLAfterFinished = 1 * time.Hour
+
+var (
+	ErrInvalidHookManifest = errors.New("upgrade hook manifest must contain exactly one Job")
+	ErrHookFailed          = errors.New("upgrade hook Job failed")
+	ErrHookTimedOut        = errors.New("upgrade hook Job did not complete within its timeout")
+)
+
+// Run applies the resources of an upgrade hook to the runtime and reports whether the hook Job completed.
+// The Job runs once per version: a Job created for another version is deleted first, so that the hook
+// runs again for the new version.
+func Run(ctx context.Context, clnt client.Client, resources []*unstructured.Unstructured, version string,
+	timeout time.Duration,
+) (bool, error) {
+	hookJob, err := findJob(resources)
+	if err != nil {
+		return false, err
+	}
+	if err := defaultNamespaces(clnt, resources); err != nil {
+		return false, err
+	}
+
+	job := &batchv1.Job{}
+	err = clnt.Get(ctx, client.ObjectKeyFromObject(hookJob), job)
+	switch {
+	case util.IsNotFound(err):
+		if err := prepareJob(hookJob, version); err != nil {
+			return false, err
+		}
+		return false, apply(ctx, clnt, resources)
+	case err != nil:
+		return false, fmt.Errorf("failed to get upgrade hook Job %s: %w", client.ObjectKeyFromObject(hookJob), err)
+	case !job.GetDeletionTimestamp().IsZero():
+		return false, nil
+	case job.GetAnnotations()[shared.UpgradeHookVersionAnnotation] != version:
+		err := clnt.Delete(ctx, job, client.PropagationPolicy(apimetav1.DeletePropagationBackground))
+		if err != nil && !util.IsNotFound(err) {
+			return false, fmt.Errorf("failed to delete upgrade hook Job %s of a previous version: %w",
+				client.ObjectKeyFromObject(job), err)
+		}
+		return false, nil
+	}
+	return checkJob(job, timeout)
+}
+
+func findJob(resources []*unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	var hookJob *unstructured.Unstructured
+	for _, obj := range resources {
+		gvk := obj.GroupVersionKind()
+		if gvk.Group != batchv1.GroupName || gvk.Kind != "Job" {
+			continue
+		}
+		if hookJob != nil {
+			return nil, fmt.Errorf("%w: found %s and %s", ErrInvalidHookManifest, hookJob.GetName(), obj.GetName())
+		}
+		hookJob = obj
+	}
+	if hookJob == nil {
+		return nil, ErrInvalidHookManifest
+	}
+	return hookJob, nil
+}
+
+// defaultNamespaces places namespaced resources without a namespace in the default namespace,
+// the same as the resources of the module.
+func defaultNamespaces(clnt client.Client, resources []*unstructured.Unstructured) error {
+	for _, obj := range resources {
+		if obj.GetNamespace() != "" {
+			continue
+		}
+		namespaced, err := clnt.IsObjectNamespaced(obj)
+		if err != nil {
+			return fmt.Errorf("failed to determine scope of upgrade hook resource %s: %w", obj.GetName(), err)
+		}
+		if namespaced {
+			obj.SetNamespace(apimetav1.NamespaceDefault)
+		}
+	}
+	return nil
+}
+
+// prepareJob records the version on the Job. A TTL set in the hook manifest is kept, but raised to
+// MinJobTTLAfterFinished, as a Job removed before its completion was observed would be created again.
+func prepareJob(job *unstructured.Unstructured, version string) error {
+	annotations := job.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[shared.UpgradeHookVersionAnnotation] = version
+	job.SetAnnotations(annotations)
+
+	ttl, found, err := unstructured.NestedInt64(job.Object, "spec", "ttlSecondsAfterFinished")
+	if err != nil {
+		return fmt.Errorf("invalid ttlSecondsAfterFinished of upgrade hook Job %s: %w", job.GetName(), err)
+	}
+	if minTTL := int64(MinJobTTLAfterFinished.Seconds()); found && ttl < minTTL {
+		return unstructured.SetNestedField(job.Object, minTTL, "spec", "ttlSecondsAfterFinished")
+	}
+	return nil
+}
+
+func apply(ctx context.Context, clnt client.Client, resources []*unstructured.Unstructured) error {
+	for _, obj := range resources {
+		if err := clnt.Patch(ctx, obj, client.Apply, client.ForceOwnership, fieldowners.UpgradeHook); err != nil {
+			return fmt.Errorf("failed to apply upgrade hook resource %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func checkJob(job *batchv1.Job, timeout time.Duration) (bool, error) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != apicorev1.ConditionTrue {
+			continue
+		}
+		if condition.Type == batchv1.JobComplete {
+			return true, nil
+		}
+		if condition.Type == batchv1.JobFailed {
+			return false, fmt.Errorf("%w: %s: %s", ErrHookFailed, client.ObjectKeyFromObject(job), condition.Message)
+		}
+	}
+	if !job.CreationTimestamp.IsZero() && time.Since(job.CreationTimestamp.Time) > timeout {
+		return false, fmt.Errorf("%w: %s did not complete within %s", ErrHookTimedOut,
+			client.ObjectKeyFromObject(job), timeout)
+	}
+	return false, nil
+}
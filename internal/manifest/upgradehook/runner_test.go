@@ -0,0 +1,182 @@
+package upgradehook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	machineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	k8sclientscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/upgradehook"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+const (
+	jobName      = "migrate-schema"
+	jobNamespace = "kyma-system"
+	timeout      = 10 * time.Minute
+)
+
+func TestRun_WhenJobDoesNotExist_CreatesJobForVersion(t *testing.T) {
+	clnt := fakeClient()
+
+	completed, err := upgradehook.Run(t.Context(), clnt, hookResources(), "1.1.0", timeout)
+
+	require.NoError(t, err)
+	assert.False(t, completed)
+	job := getJob(t, clnt)
+	assert.Equal(t, "1.1.0", job.GetAnnotations()[shared.UpgradeHookVersionAnnotation])
+	require.NotNil(t, job.Spec.TTLSecondsAfterFinished)
+	assert.Equal(t, int32(upgradehook.MinJobTTLAfterFinished.Seconds()), *job.Spec.TTLSecondsAfterFinished)
+}
+
+func TestRun_WhenJobDeclaresLongerTTL_KeepsTTL(t *testing.T) {
+	clnt := fakeClient()
+	job := hookJob()
+	require.NoError(t, unstructured.SetNestedField(job.Object, int64(86400), "spec", "ttlSecondsAfterFinished"))
+
+	_, err := upgradehook.Run(t.Context(), clnt, []*unstructured.Unstructured{job}, "1.1.0", timeout)
+
+	require.NoError(t, err)
+	require.NotNil(t, getJob(t, clnt).Spec.TTLSecondsAfterFinished)
+	assert.Equal(t, int32(86400), *getJob(t, clnt).Spec.TTLSecondsAfterFinished)
+}
+
+func TestRun_WhenJobOfPreviousVersionExists_DeletesJob(t *testing.T) {
+	clnt := fakeClient(existingJob("1.0.0", time.Now(), batchv1.JobComplete))
+
+	completed, err := upgradehook.Run(t.Context(), clnt, hookResources(), "1.1.0", timeout)
+
+	require.NoError(t, err)
+	assert.False(t, completed)
+	err = clnt.Get(t.Context(), client.ObjectKey{Namespace: jobNamespace, Name: jobName}, &batchv1.Job{})
+	assert.True(t, util.IsNotFound(err))
+}
+
+func TestRun_ReportsJobOutcome(t *testing.T) {
+	tests := []struct {
+		name              string
+		job               *batchv1.Job
+		expectedCompleted bool
+		expectedErr       error
+	}{
+		{
+			name:              "job completed",
+			job:               existingJob("1.1.0", time.Now(), batchv1.JobComplete),
+			expectedCompleted: true,
+		},
+		{
+			name: "job running",
+			job:  existingJob("1.1.0", time.Now(), ""),
+		},
+		{
+			name:        "job failed",
+			job:         existingJob("1.1.0", time.Now(), batchv1.JobFailed),
+			expectedErr: upgradehook.ErrHookFailed,
+		},
+		{
+			name:        "job running longer than the timeout",
+			job:         existingJob("1.1.0", time.Now().Add(-2*timeout), ""),
+			expectedErr: upgradehook.ErrHookTimedOut,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			clnt := fakeClient(testCase.job)
+
+			completed, err := upgradehook.Run(t.Context(), clnt, hookResources(), "1.1.0", timeout)
+
+			require.ErrorIs(t, err, testCase.expectedErr)
+			assert.Equal(t, testCase.expectedCompleted, completed)
+		})
+	}
+}
+
+func TestRun_WhenManifestContainsNoJob_ReturnsError(t *testing.T) {
+	resources := []*unstructured.Unstructured{serviceAccount()}
+
+	_, err := upgradehook.Run(t.Context(), fakeClient(), resources, "1.1.0", timeout)
+
+	require.ErrorIs(t, err, upgradehook.ErrInvalidHookManifest)
+}
+
+func TestRun_WhenManifestContainsMultipleJobs_ReturnsError(t *testing.T) {
+	second := hookJob()
+	second.SetName("verify")
+	resources := []*unstructured.Unstructured{hookJob(), second}
+
+	_, err := upgradehook.Run(t.Context(), fakeClient(), resources, "1.1.0", timeout)
+
+	require.ErrorIs(t, err, upgradehook.ErrInvalidHookManifest)
+}
+
+func hookResources() []*unstructured.Unstructured {
+	return []*unstructured.Unstructured{serviceAccount(), hookJob()}
+}
+
+func hookJob() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]any{"name": jobName, "namespace": jobNamespace},
+		"spec": map[string]any{
+			"ttlSecondsAfterFinished": int64(60),
+			"template": map[string]any{
+				"spec": map[string]any{
+					"restartPolicy": "Never",
+					"containers": []any{
+						map[string]any{"name": "migrate", "image": "europe-docker.pkg.dev/kyma-project/migrate:1.1.0"},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func serviceAccount() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ServiceAccount",
+		"metadata":   map[string]any{"name": jobName, "namespace": jobNamespace},
+	}}
+}
+
+func existingJob(version string, created time.Time, conditionType batchv1.JobConditionType) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:              jobName,
+			Namespace:         jobNamespace,
+			Annotations:       map[string]string{shared.UpgradeHookVersionAnnotation: version},
+			CreationTimestamp: apimetav1.NewTime(created),
+		},
+	}
+	if conditionType != "" {
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: conditionType, Status: apicorev1.ConditionTrue, Message: "BackoffLimitExceeded"},
+		}
+	}
+	return job
+}
+
+func getJob(t *testing.T, clnt client.Client) *batchv1.Job {
+	t.Helper()
+	job := &batchv1.Job{}
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKey{Namespace: jobNamespace, Name: jobName}, job))
+	return job
+}
+
+func fakeClient(objs ...client.Object) client.Client {
+	scheme := machineryruntime.NewScheme()
+	machineryutilruntime.Must(k8sclientscheme.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
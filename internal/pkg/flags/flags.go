@@ -11,6 +11,10 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/internal/common"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+	"github.com/kyma-project/lifecycle-manager/internal/remote"
+	nativecertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/native/certificate" //nolint:revive // not for import
+	"github.com/kyma-project/lifecycle-manager/internal/routing"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 )
 
@@ -28,6 +32,9 @@ const (
 	DefaultMandatoryModuleRequeueSuccessInterval                        = 30 * time.Second
 	DefaultMandatoryModuleDeletionRequeueSuccessInterval                = 30 * time.Second
 	DefaultWatcherRequeueSuccessInterval                                = 1 * time.Minute
+	DefaultModuleReleaseMetaRolloutRequeueInterval                      = 1 * time.Minute
+	DefaultMaintenanceWindowPolicyRequeueInterval                       = 5 * time.Minute
+	DefaultModuleUpgradeHealthDeadline                                  = 0 * time.Second
 	DefaultClientQPS                                                    = 1000
 	DefaultClientBurst                                                  = 2000
 	DefaultSkrClientQPS                                                 = 50
@@ -68,10 +75,20 @@ const (
 	DefaultWatcherResourceLimitsMemory                                  = "200Mi"
 	DefaultDropCrdStoredVersionMap                                      = "Manifest:v1beta1,Watcher:v1beta1,ModuleTemplate:v1beta1,Kyma:v1beta1" //nolint:revive // keep it readible
 	DefaultMetricsCleanupIntervalInMinutes                              = 15
+	DefaultCertificateInventoryScanInterval                             = 10 * time.Minute
 	DefaultMinMaintenanceWindowSize                                     = 20 * time.Minute
 	DefaultLeaderElectionLeaseDuration                                  = 180 * time.Second
 	DefaultLeaderElectionRenewDeadline                                  = 120 * time.Second
 	DefaultLeaderElectionRetryPeriod                                    = 3 * time.Second
+	DefaultDriftDetectionMode                                           = string(skrresources.DriftDetectionCorrect)
+	DefaultDriftDetectionInterval                                       = 30 * time.Minute
+	DefaultModuleCatalogMode                                            = string(remote.ModuleCatalogModeObjects)
+	DefaultModuleVersionHistorySize                                     = 20
+	DefaultManifestParseCacheSize                                       = 200
+	DefaultManifestLayerCacheDir                                        = "/tmp/layer-cache"
+	DefaultManifestLayerCacheSizeMiB                                    = 0
+	DefaultManifestLayerCacheGCInterval                                 = 10 * time.Minute
+	DefaultWatcherRoutingBackend                                        = string(routing.BackendIstio)
 )
 
 var (
@@ -87,15 +104,29 @@ var (
 	ErrInvalidManifestRequeueJitterProbability = errors.New(
 		"invalid manifest requeue jitter probability: must be between 0 and 1",
 	)
+	ErrInvalidDriftDetectionMode = errors.New(
+		"invalid drift-detection-mode: must be one of 'disabled', 'correct', 'report-only'",
+	)
+	ErrInvalidDriftDetectionInterval = errors.New("invalid drift-detection-interval: must not be negative")
+	ErrInvalidModuleCatalogMode      = errors.New(
+		"invalid module-catalog-mode: must be one of 'objects', 'resource', 'both'",
+	)
+	ErrInvalidModuleVersionHistorySize = errors.New("invalid module-version-history-size: must not be negative")
+	ErrInvalidManifestParseCacheSize   = errors.New("invalid manifest-parse-cache-size: must not be negative")
+	ErrInvalidManifestLayerCacheSize   = errors.New("invalid manifest-layer-cache-size-mib: must not be negative")
+	ErrInvalidWatcherRoutingBackend    = errors.New(
+		"invalid watcher-routing-backend: must be one of 'istio', 'gateway-api'",
+	)
 )
 
 //nolint:funlen // defines all program flags
 func DefineFlagVar() *FlagVar {
 	flagVar := new(FlagVar)
 	flag.StringVar(&flagVar.CertificateManagement, "cert-management", certmanagerv1.SchemeGroupVersion.String(),
-		fmt.Sprintf("Certificate management system to use. Accepted values: '%s', '%s'. Default: '%s'",
+		fmt.Sprintf("Certificate management system to use. Accepted values: '%s', '%s', '%s'. Default: '%s'",
 			certmanagerv1.SchemeGroupVersion.String(),
 			gcertv1alpha1.SchemeGroupVersion.String(),
+			nativecertificate.CertificateManagement,
 			certmanagerv1.SchemeGroupVersion.String()))
 	flag.StringVar(&flagVar.MetricsAddr, "metrics-bind-address", DefaultMetricsAddress,
 		"Address and port for binding of metrics endpoint.")
@@ -173,6 +204,18 @@ func DefineFlagVar() *FlagVar {
 		"mandatory-module-deletion-requeue-success-interval",
 		DefaultMandatoryModuleDeletionRequeueSuccessInterval,
 		"Duration after which a Kyma in Ready state is enqueued for mandatory module deletion reconciliation.")
+	flag.DurationVar(&flagVar.ModuleReleaseMetaRolloutRequeueInterval,
+		"modulereleasemeta-rollout-requeue-interval",
+		DefaultModuleReleaseMetaRolloutRequeueInterval,
+		"Duration after which a ModuleReleaseMeta with a staged rollout is enqueued to refresh the rollout status.")
+	flag.DurationVar(&flagVar.MaintenanceWindowPolicyRequeueInterval,
+		"maintenance-window-policy-requeue-interval",
+		DefaultMaintenanceWindowPolicyRequeueInterval,
+		"Duration after which a MaintenanceWindowPolicy is enqueued to refresh the number of Kymas matched by its rules.")
+	flag.DurationVar(&flagVar.ModuleUpgradeHealthDeadline, "module-upgrade-health-deadline",
+		DefaultModuleUpgradeHealthDeadline,
+		"Duration within which an upgraded module must become ready before the upgrade is rolled back. "+
+			"A zero duration disables the rollback.")
 	flag.DurationVar(&flagVar.WatcherRequeueSuccessInterval, "watcher-requeue-success-interval",
 		DefaultWatcherRequeueSuccessInterval,
 		"Duration after which a Watcher in Ready state is enqueued for reconciliation.")
@@ -275,6 +318,10 @@ func DefineFlagVar() *FlagVar {
 	flag.IntVar(&flagVar.MetricsCleanupIntervalInMinutes, "metrics-cleanup-interval",
 		DefaultMetricsCleanupIntervalInMinutes,
 		"Interval (in minutes) at which the cleanup of non-existing Kyma CRs metrics runs.")
+	flag.DurationVar(&flagVar.CertificateInventoryScanInterval, "cert-inventory-scan-interval",
+		DefaultCertificateInventoryScanInterval,
+		"Interval at which the SKR certificates and the gateway CA bundle are scanned for the certificate "+
+			"inventory metrics. 0 disables the scan.")
 	flag.DurationVar(&flagVar.MinMaintenanceWindowSize, "min-maintenance-window-size",
 		DefaultMinMaintenanceWindowSize,
 		"Minimum duration of maintenance window required for reconciling modules with downtime.")
@@ -297,6 +344,37 @@ func DefineFlagVar() *FlagVar {
 	)
 	flag.StringVar(&flagVar.SkrImagePullSecret, "skr-image-pull-secret", "",
 		"Allows to reference a secret for the SKR clusters to pull images from private registries.")
+	flag.StringVar(&flagVar.DriftDetectionMode, "drift-detection-mode", DefaultDriftDetectionMode,
+		"Configures the detection of module resources that drifted from the desired state on the SKR. "+
+			"Accepted values: 'disabled', 'correct' to report and correct the drift, "+
+			"'report-only' to report the drift without correcting it.")
+	flag.DurationVar(&flagVar.DriftDetectionInterval, "drift-detection-interval", DefaultDriftDetectionInterval,
+		"Duration for which the result of the drift detection of a Manifest is reused before the module "+
+			"resources are fetched from the SKR again. With 0, the drift is detected in every reconciliation.")
+	flag.StringVar(&flagVar.ModuleCatalogMode, "module-catalog-mode", DefaultModuleCatalogMode,
+		"Configures how the module catalog is synchronized to the SKR. "+
+			"Accepted values: 'objects' to synchronize each ModuleTemplate and ModuleReleaseMeta, "+
+			"'resource' to synchronize a single consolidated ModuleCatalog instead, 'both' to synchronize both.")
+	flag.IntVar(&flagVar.ModuleVersionHistorySize, "module-version-history-size", DefaultModuleVersionHistorySize,
+		"Maximum number of version transitions recorded per module in the ModuleVersionHistory of a Kyma. "+
+			"0 disables the recording.")
+	flag.IntVar(&flagVar.ManifestParseCacheSize, "manifest-parse-cache-size", DefaultManifestParseCacheSize,
+		"Maximum number of module layers whose parsed manifest resources are cached and shared by all Manifests. "+
+			"0 disables the limit.")
+	flag.StringVar(&flagVar.ManifestLayerCacheDir, "manifest-layer-cache-dir", DefaultManifestLayerCacheDir,
+		"Directory of the on-disk cache of the fetched module layers. An empty value disables the cache "+
+			"and stores the layers in the temporary directory without cleanup.")
+	flag.IntVar(&flagVar.ManifestLayerCacheSizeMiB, "manifest-layer-cache-size-mib", DefaultManifestLayerCacheSizeMiB,
+		"Size budget of the on-disk layer cache in MiB. The least recently used layers are evicted first. "+
+			"0 derives the budget from the capacity of the volume holding the cache directory.")
+	flag.DurationVar(&flagVar.ManifestLayerCacheGCInterval, "manifest-layer-cache-gc-interval",
+		DefaultManifestLayerCacheGCInterval,
+		"Interval in which the layers that no Manifest references anymore are removed from the on-disk layer cache. "+
+			"0 disables the removal.")
+	flag.StringVar(&flagVar.WatcherRoutingBackend, "watcher-routing-backend", DefaultWatcherRoutingBackend,
+		"Configures the API that routes the events of the runtime watcher to the managers of the Watchers. "+
+			"Accepted values: 'istio' to create Istio VirtualServices, "+
+			"'gateway-api' to create Gateway API HTTPRoutes.")
 
 	return flagVar
 }
@@ -327,6 +405,9 @@ type FlagVar struct {
 	WatcherRequeueSuccessInterval                  time.Duration
 	MandatoryModuleRequeueSuccessInterval          time.Duration
 	MandatoryModuleDeletionRequeueSuccessInterval  time.Duration
+	ModuleReleaseMetaRolloutRequeueInterval        time.Duration
+	MaintenanceWindowPolicyRequeueInterval         time.Duration
+	ModuleUpgradeHealthDeadline                    time.Duration
 	ClientQPS                                      int
 	ClientBurst                                    int
 	SkrClientQPS                                   int
@@ -363,6 +444,7 @@ type FlagVar struct {
 	WatcherResourceLimitsMemory                string
 	WatcherResourceLimitsCPU                   string
 	MetricsCleanupIntervalInMinutes            int
+	CertificateInventoryScanInterval           time.Duration
 	ManifestRequeueJitterProbability           float64
 	ManifestRequeueJitterPercentage            float64
 	IstioGatewayCertSwitchBeforeExpirationTime time.Duration // Deprecated: not considered by KLM anymore
@@ -374,6 +456,15 @@ type FlagVar struct {
 	OciRegistryHost                            string
 	ModulesRepositorySubPath                   string
 	SkrImagePullSecret                         string
+	DriftDetectionMode                         string
+	DriftDetectionInterval                     time.Duration
+	ModuleCatalogMode                          string
+	ModuleVersionHistorySize                   int
+	ManifestParseCacheSize                     int
+	ManifestLayerCacheDir                      string
+	ManifestLayerCacheSizeMiB                  int
+	ManifestLayerCacheGCInterval               time.Duration
+	WatcherRoutingBackend                      string
 }
 
 func (f FlagVar) Validate() error {
@@ -407,6 +498,7 @@ func (f FlagVar) Validate() error {
 	if !map[string]bool{
 		certmanagerv1.SchemeGroupVersion.String(): true,
 		gcertv1alpha1.SchemeGroupVersion.String(): true,
+		nativecertificate.CertificateManagement:   true,
 	}[f.CertificateManagement] {
 		return fmt.Errorf("%w: '%s'", common.ErrUnsupportedCertificateManagementSystem, f.CertificateManagement)
 	}
@@ -415,6 +507,45 @@ func (f FlagVar) Validate() error {
 		return err
 	}
 
+	if !map[skrresources.DriftDetectionMode]bool{
+		skrresources.DriftDetectionDisabled:   true,
+		skrresources.DriftDetectionCorrect:    true,
+		skrresources.DriftDetectionReportOnly: true,
+	}[skrresources.DriftDetectionMode(f.DriftDetectionMode)] {
+		return fmt.Errorf("%w: '%s'", ErrInvalidDriftDetectionMode, f.DriftDetectionMode)
+	}
+
+	if f.DriftDetectionInterval < 0 {
+		return ErrInvalidDriftDetectionInterval
+	}
+
+	if !map[remote.ModuleCatalogMode]bool{
+		remote.ModuleCatalogModeObjects:  true,
+		remote.ModuleCatalogModeResource: true,
+		remote.ModuleCatalogModeBoth:     true,
+	}[remote.ModuleCatalogMode(f.ModuleCatalogMode)] {
+		return fmt.Errorf("%w: '%s'", ErrInvalidModuleCatalogMode, f.ModuleCatalogMode)
+	}
+
+	if f.ModuleVersionHistorySize < 0 {
+		return ErrInvalidModuleVersionHistorySize
+	}
+
+	if f.ManifestParseCacheSize < 0 {
+		return ErrInvalidManifestParseCacheSize
+	}
+
+	if f.ManifestLayerCacheSizeMiB < 0 {
+		return ErrInvalidManifestLayerCacheSize
+	}
+
+	if !map[routing.Backend]bool{
+		routing.BackendIstio:      true,
+		routing.BackendGatewayAPI: true,
+	}[routing.Backend(f.WatcherRoutingBackend)] {
+		return fmt.Errorf("%w: '%s'", ErrInvalidWatcherRoutingBackend, f.WatcherRoutingBackend)
+	}
+
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-project/lifecycle-manager/internal/common"
+	nativecertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/native/certificate" //nolint:revive // not for import
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 
 	. "github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
@@ -78,6 +79,21 @@ func Test_ConstantFlags(t *testing.T) {
 			constValue:    DefaultMandatoryModuleDeletionRequeueSuccessInterval.String(),
 			expectedValue: (30 * time.Second).String(),
 		},
+		{
+			constName:     "DefaultModuleReleaseMetaRolloutRequeueInterval",
+			constValue:    DefaultModuleReleaseMetaRolloutRequeueInterval.String(),
+			expectedValue: (1 * time.Minute).String(),
+		},
+		{
+			constName:     "DefaultMaintenanceWindowPolicyRequeueInterval",
+			constValue:    DefaultMaintenanceWindowPolicyRequeueInterval.String(),
+			expectedValue: (5 * time.Minute).String(),
+		},
+		{
+			constName:     "DefaultModuleUpgradeHealthDeadline",
+			constValue:    DefaultModuleUpgradeHealthDeadline.String(),
+			expectedValue: (0 * time.Second).String(),
+		},
 		{
 			constName:     "DefaultWatcherRequeueSuccessInterval",
 			constValue:    DefaultWatcherRequeueSuccessInterval.String(),
@@ -278,6 +294,11 @@ func Test_ConstantFlags(t *testing.T) {
 			constValue:    DefaultDropCrdStoredVersionMap,
 			expectedValue: "Manifest:v1beta1,Watcher:v1beta1,ModuleTemplate:v1beta1,Kyma:v1beta1",
 		},
+		{
+			constName:     "DefaultCertificateInventoryScanInterval",
+			constValue:    DefaultCertificateInventoryScanInterval.String(),
+			expectedValue: (10 * time.Minute).String(),
+		},
 		{
 			constName:     "DefaultMinMaintenanceWindowSize",
 			constValue:    DefaultMinMaintenanceWindowSize.String(),
@@ -298,6 +319,51 @@ func Test_ConstantFlags(t *testing.T) {
 			constValue:    DefaultLeaderElectionRetryPeriod.String(),
 			expectedValue: (3 * time.Second).String(),
 		},
+		{
+			constName:     "DefaultDriftDetectionMode",
+			constValue:    DefaultDriftDetectionMode,
+			expectedValue: "correct",
+		},
+		{
+			constName:     "DefaultDriftDetectionInterval",
+			constValue:    DefaultDriftDetectionInterval.String(),
+			expectedValue: (30 * time.Minute).String(),
+		},
+		{
+			constName:     "DefaultModuleCatalogMode",
+			constValue:    DefaultModuleCatalogMode,
+			expectedValue: "objects",
+		},
+		{
+			constName:     "DefaultModuleVersionHistorySize",
+			constValue:    strconv.Itoa(DefaultModuleVersionHistorySize),
+			expectedValue: "20",
+		},
+		{
+			constName:     "DefaultManifestParseCacheSize",
+			constValue:    strconv.Itoa(DefaultManifestParseCacheSize),
+			expectedValue: "200",
+		},
+		{
+			constName:     "DefaultManifestLayerCacheDir",
+			constValue:    DefaultManifestLayerCacheDir,
+			expectedValue: "/tmp/layer-cache",
+		},
+		{
+			constName:     "DefaultManifestLayerCacheSizeMiB",
+			constValue:    strconv.Itoa(DefaultManifestLayerCacheSizeMiB),
+			expectedValue: "0",
+		},
+		{
+			constName:     "DefaultManifestLayerCacheGCInterval",
+			constValue:    DefaultManifestLayerCacheGCInterval.String(),
+			expectedValue: "10m0s",
+		},
+		{
+			constName:     "DefaultWatcherRoutingBackend",
+			constValue:    DefaultWatcherRoutingBackend,
+			expectedValue: "istio",
+		},
 	}
 	for _, testcase := range tests {
 		testName := fmt.Sprintf("const %s has correct value", testcase.constName)
@@ -327,6 +393,11 @@ func Test_Flags_Validate(t *testing.T) {
 			flags: newFlagVarBuilder().withCertificateManagement(gcertv1alpha1.SchemeGroupVersion.String()).build(),
 			err:   nil,
 		},
+		{
+			name:  "CertificateManagement native",
+			flags: newFlagVarBuilder().withCertificateManagement(nativecertificate.CertificateManagement).build(),
+			err:   nil,
+		},
 		{
 			name:  "CertificateManagement unsupported",
 			flags: newFlagVarBuilder().withCertificateManagement("foobar").build(),
@@ -422,6 +493,86 @@ func Test_Flags_Validate(t *testing.T) {
 			flags: newFlagVarBuilder().withModulesRepositorySubPath("some/sub/path").build(),
 			err:   nil,
 		},
+		{
+			name:  "DriftDetectionMode report-only",
+			flags: newFlagVarBuilder().withDriftDetectionMode("report-only").build(),
+			err:   nil,
+		},
+		{
+			name:  "DriftDetectionMode disabled",
+			flags: newFlagVarBuilder().withDriftDetectionMode("disabled").build(),
+			err:   nil,
+		},
+		{
+			name:  "DriftDetectionMode unsupported",
+			flags: newFlagVarBuilder().withDriftDetectionMode("ignore").build(),
+			err:   ErrInvalidDriftDetectionMode,
+		},
+		{
+			name:  "DriftDetectionInterval zero",
+			flags: newFlagVarBuilder().withDriftDetectionInterval(0).build(),
+			err:   nil,
+		},
+		{
+			name:  "DriftDetectionInterval negative",
+			flags: newFlagVarBuilder().withDriftDetectionInterval(-time.Minute).build(),
+			err:   ErrInvalidDriftDetectionInterval,
+		},
+		{
+			name:  "ModuleCatalogMode resource",
+			flags: newFlagVarBuilder().withModuleCatalogMode("resource").build(),
+			err:   nil,
+		},
+		{
+			name:  "ModuleCatalogMode both",
+			flags: newFlagVarBuilder().withModuleCatalogMode("both").build(),
+			err:   nil,
+		},
+		{
+			name:  "ModuleCatalogMode unsupported",
+			flags: newFlagVarBuilder().withModuleCatalogMode("none").build(),
+			err:   ErrInvalidModuleCatalogMode,
+		},
+		{
+			name:  "ModuleVersionHistorySize disabled",
+			flags: newFlagVarBuilder().withModuleVersionHistorySize(0).build(),
+			err:   nil,
+		},
+		{
+			name:  "ModuleVersionHistorySize negative",
+			flags: newFlagVarBuilder().withModuleVersionHistorySize(-1).build(),
+			err:   ErrInvalidModuleVersionHistorySize,
+		},
+		{
+			name:  "ManifestParseCacheSize unlimited",
+			flags: newFlagVarBuilder().withManifestParseCacheSize(0).build(),
+			err:   nil,
+		},
+		{
+			name:  "ManifestParseCacheSize negative",
+			flags: newFlagVarBuilder().withManifestParseCacheSize(-1).build(),
+			err:   ErrInvalidManifestParseCacheSize,
+		},
+		{
+			name:  "ManifestLayerCacheSizeMiB derived from volume",
+			flags: newFlagVarBuilder().withManifestLayerCacheSizeMiB(0).build(),
+			err:   nil,
+		},
+		{
+			name:  "ManifestLayerCacheSizeMiB negative",
+			flags: newFlagVarBuilder().withManifestLayerCacheSizeMiB(-1).build(),
+			err:   ErrInvalidManifestLayerCacheSize,
+		},
+		{
+			name:  "WatcherRoutingBackend gateway-api",
+			flags: newFlagVarBuilder().withWatcherRoutingBackend("gateway-api").build(),
+			err:   nil,
+		},
+		{
+			name:  "WatcherRoutingBackend unsupported",
+			flags: newFlagVarBuilder().withWatcherRoutingBackend("nginx").build(),
+			err:   ErrInvalidWatcherRoutingBackend,
+		},
 	}
 
 	for _, tt := range tests {
@@ -457,7 +608,10 @@ func newFlagVarBuilder() *flagVarBuilder {
 		withSelfSignedCertKeySize(4096).
 		withManifestRequeueJitterProbability(0.01).
 		withManifestRequeueJitterPercentage(0.1).
-		withOciRegistryHost("europe-docker.pkg.dev")
+		withOciRegistryHost("europe-docker.pkg.dev").
+		withDriftDetectionMode("correct").
+		withModuleCatalogMode("objects").
+		withWatcherRoutingBackend("istio")
 }
 
 func (b *flagVarBuilder) build() FlagVar {
@@ -523,3 +677,38 @@ func (b *flagVarBuilder) withModulesRepositorySubPath(subPath string) *flagVarBu
 	b.flags.ModulesRepositorySubPath = subPath
 	return b
 }
+
+func (b *flagVarBuilder) withDriftDetectionMode(mode string) *flagVarBuilder {
+	b.flags.DriftDetectionMode = mode
+	return b
+}
+
+func (b *flagVarBuilder) withDriftDetectionInterval(interval time.Duration) *flagVarBuilder {
+	b.flags.DriftDetectionInterval = interval
+	return b
+}
+
+func (b *flagVarBuilder) withModuleVersionHistorySize(size int) *flagVarBuilder {
+	b.flags.ModuleVersionHistorySize = size
+	return b
+}
+
+func (b *flagVarBuilder) withManifestParseCacheSize(size int) *flagVarBuilder {
+	b.flags.ManifestParseCacheSize = size
+	return b
+}
+
+func (b *flagVarBuilder) withManifestLayerCacheSizeMiB(size int) *flagVarBuilder {
+	b.flags.ManifestLayerCacheSizeMiB = size
+	return b
+}
+
+func (b *flagVarBuilder) withWatcherRoutingBackend(backend string) *flagVarBuilder {
+	b.flags.WatcherRoutingBackend = backend
+	return b
+}
+
+func (b *flagVarBuilder) withModuleCatalogMode(mode string) *flagVarBuilder {
+	b.flags.ModuleCatalogMode = mode
+	return b
+}
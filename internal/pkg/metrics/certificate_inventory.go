@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricSkrCertNotBefore             = "lifecycle_mgr_skr_cert_not_before_timestamp_seconds"
+	MetricSkrCertNotAfter              = "lifecycle_mgr_skr_cert_not_after_timestamp_seconds"
+	MetricSkrCertRenewalIn             = "lifecycle_mgr_skr_cert_renewal_in_seconds"
+	MetricSkrCertCANotInGatewayBundle  = "lifecycle_mgr_skr_cert_ca_not_in_gateway_bundle"
+	MetricSkrCertScanFailed            = "lifecycle_mgr_skr_cert_scan_failed"
+	MetricGatewayCABundleCerts         = "lifecycle_mgr_gateway_ca_bundle_certs"
+	MetricGatewayCABundleCAAge         = "lifecycle_mgr_gateway_ca_bundle_ca_age_seconds"
+	MetricCertificateInventoryScanTime = "lifecycle_mgr_cert_inventory_last_scan_timestamp_seconds"
+	caFingerprintLabel                 = "ca_fingerprint"
+	caPositionLabel                    = "ca_position"
+)
+
+type CertificateInventoryMetrics struct {
+	skrCertNotBeforeGauge            *prometheus.GaugeVec
+	skrCertNotAfterGauge             *prometheus.GaugeVec
+	skrCertRenewalInGauge            *prometheus.GaugeVec
+	skrCertCANotInGatewayBundleGauge *prometheus.GaugeVec
+	skrCertScanFailedGauge           *prometheus.GaugeVec
+	gatewayCABundleCertsGauge        prometheus.Gauge
+	gatewayCABundleCAAgeGauge        *prometheus.GaugeVec
+	scanTimeGauge                    prometheus.Gauge
+}
+
+func NewCertificateInventoryMetrics() *CertificateInventoryMetrics {
+	inventoryMetrics := &CertificateInventoryMetrics{
+		skrCertNotBeforeGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricSkrCertNotBefore,
+			Help: "Indicates the start of the validity of the SKR certificate of related Kyma as Unix timestamp",
+		}, []string{KymaNameLabel}),
+		skrCertNotAfterGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricSkrCertNotAfter,
+			Help: "Indicates the end of the validity of the SKR certificate of related Kyma as Unix timestamp",
+		}, []string{KymaNameLabel}),
+		skrCertRenewalInGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricSkrCertRenewalIn,
+			Help: "Indicates the time until the SKR certificate of related Kyma is renewed, negative if overdue",
+		}, []string{KymaNameLabel}),
+		skrCertCANotInGatewayBundleGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricSkrCertCANotInGatewayBundle,
+			Help: "Indicates the CA of the SKR certificate of related Kyma is not part of the gateway CA bundle",
+		}, []string{KymaNameLabel}),
+		skrCertScanFailedGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricSkrCertScanFailed,
+			Help: "Indicates the last scan of the SKR certificate of related Kyma failed",
+		}, []string{KymaNameLabel}),
+		gatewayCABundleCertsGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: MetricGatewayCABundleCerts,
+			Help: "Indicates the number of CA certificates in the gateway CA bundle",
+		}),
+		gatewayCABundleCAAgeGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricGatewayCABundleCAAge,
+			Help: "Indicates the age of each CA certificate in the gateway CA bundle, position 0 is the newest",
+		}, []string{caFingerprintLabel, caPositionLabel}),
+		scanTimeGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: MetricCertificateInventoryScanTime,
+			Help: "Indicates the time of the last completed certificate inventory scan as Unix timestamp",
+		}),
+	}
+	ctrlmetrics.Registry.MustRegister(inventoryMetrics.skrCertNotBeforeGauge)
+	ctrlmetrics.Registry.MustRegister(inventoryMetrics.skrCertNotAfterGauge)
+	ctrlmetrics.Registry.MustRegister(inventoryMetrics.skrCertRenewalInGauge)
+	ctrlmetrics.Registry.MustRegister(inventoryMetrics.skrCertCANotInGatewayBundleGauge)
+	ctrlmetrics.Registry.MustRegister(inventoryMetrics.skrCertScanFailedGauge)
+	ctrlmetrics.Registry.MustRegister(inventoryMetrics.gatewayCABundleCertsGauge)
+	ctrlmetrics.Registry.MustRegister(inventoryMetrics.gatewayCABundleCAAgeGauge)
+	ctrlmetrics.Registry.MustRegister(inventoryMetrics.scanTimeGauge)
+	return inventoryMetrics
+}
+
+func (c *CertificateInventoryMetrics) SetSkrCertificate(kymaName string, notBefore, notAfter time.Time,
+	renewalIn time.Duration, caInGatewayBundle bool,
+) {
+	labels := prometheus.Labels{KymaNameLabel: kymaName}
+	c.skrCertNotBeforeGauge.With(labels).Set(float64(notBefore.Unix()))
+	c.skrCertNotAfterGauge.With(labels).Set(float64(notAfter.Unix()))
+	c.skrCertRenewalInGauge.With(labels).Set(renewalIn.Seconds())
+	caNotInGatewayBundle := 0.0
+	if !caInGatewayBundle {
+		caNotInGatewayBundle = 1
+	}
+	c.skrCertCANotInGatewayBundleGauge.With(labels).Set(caNotInGatewayBundle)
+}
+
+func (c *CertificateInventoryMetrics) CleanupSkrCertificate(kymaName string) {
+	labels := prometheus.Labels{KymaNameLabel: kymaName}
+	c.skrCertNotBeforeGauge.DeletePartialMatch(labels)
+	c.skrCertNotAfterGauge.DeletePartialMatch(labels)
+	c.skrCertRenewalInGauge.DeletePartialMatch(labels)
+	c.skrCertCANotInGatewayBundleGauge.DeletePartialMatch(labels)
+	c.skrCertScanFailedGauge.DeletePartialMatch(labels)
+}
+
+func (c *CertificateInventoryMetrics) SetSkrCertificateScanFailed(kymaName string, failed bool) {
+	scanFailed := 0.0
+	if failed {
+		scanFailed = 1
+	}
+	c.skrCertScanFailedGauge.With(prometheus.Labels{KymaNameLabel: kymaName}).Set(scanFailed)
+}
+
+// ResetGatewayCABundle sets the number of CAs in the gateway CA bundle and drops the CAs of the previous scan.
+func (c *CertificateInventoryMetrics) ResetGatewayCABundle(caCount int) {
+	c.gatewayCABundleCertsGauge.Set(float64(caCount))
+	c.gatewayCABundleCAAgeGauge.Reset()
+}
+
+func (c *CertificateInventoryMetrics) SetGatewayCA(position int, fingerprint string, age time.Duration) {
+	c.gatewayCABundleCAAgeGauge.With(prometheus.Labels{
+		caFingerprintLabel: fingerprint,
+		caPositionLabel:    strconv.Itoa(position),
+	}).Set(age.Seconds())
+}
+
+func (c *CertificateInventoryMetrics) SetScanTime(scanTime time.Time) {
+	c.scanTimeGauge.Set(float64(scanTime.Unix()))
+}
@@ -0,0 +1,55 @@
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+)
+
+func TestCertificateInventoryMetrics(t *testing.T) {
+	inventoryMetrics := metrics.NewCertificateInventoryMetrics()
+	notBefore := time.Unix(1700000000, 0)
+
+	inventoryMetrics.SetSkrCertificate("kyma-1", notBefore, notBefore.Add(time.Hour), 30*time.Minute, false)
+	inventoryMetrics.SetSkrCertificate("kyma-2", notBefore, notBefore.Add(time.Hour), -time.Minute, true)
+	inventoryMetrics.SetSkrCertificateScanFailed("kyma-1", true)
+	inventoryMetrics.SetSkrCertificateScanFailed("kyma-2", false)
+	inventoryMetrics.CleanupSkrCertificate("kyma-2")
+	inventoryMetrics.ResetGatewayCABundle(2)
+	inventoryMetrics.SetGatewayCA(0, "aaaa", time.Hour)
+	inventoryMetrics.SetGatewayCA(1, "bbbb", 2*time.Hour)
+	inventoryMetrics.ResetGatewayCABundle(1)
+	inventoryMetrics.SetGatewayCA(0, "cccc", time.Minute)
+
+	require.NoError(t, testutil.GatherAndCompare(ctrlmetrics.Registry, strings.NewReader(`
+		# HELP lifecycle_mgr_skr_cert_not_after_timestamp_seconds `+
+		`Indicates the end of the validity of the SKR certificate of related Kyma as Unix timestamp
+		# TYPE lifecycle_mgr_skr_cert_not_after_timestamp_seconds gauge
+		lifecycle_mgr_skr_cert_not_after_timestamp_seconds{kyma_name="kyma-1"} 1.7000036e+09
+		# HELP lifecycle_mgr_skr_cert_renewal_in_seconds `+
+		`Indicates the time until the SKR certificate of related Kyma is renewed, negative if overdue
+		# TYPE lifecycle_mgr_skr_cert_renewal_in_seconds gauge
+		lifecycle_mgr_skr_cert_renewal_in_seconds{kyma_name="kyma-1"} 1800
+		# HELP lifecycle_mgr_skr_cert_ca_not_in_gateway_bundle `+
+		`Indicates the CA of the SKR certificate of related Kyma is not part of the gateway CA bundle
+		# TYPE lifecycle_mgr_skr_cert_ca_not_in_gateway_bundle gauge
+		lifecycle_mgr_skr_cert_ca_not_in_gateway_bundle{kyma_name="kyma-1"} 1
+		# HELP lifecycle_mgr_skr_cert_scan_failed Indicates the last scan of the SKR certificate of related Kyma failed
+		# TYPE lifecycle_mgr_skr_cert_scan_failed gauge
+		lifecycle_mgr_skr_cert_scan_failed{kyma_name="kyma-1"} 1
+		# HELP lifecycle_mgr_gateway_ca_bundle_certs Indicates the number of CA certificates in the gateway CA bundle
+		# TYPE lifecycle_mgr_gateway_ca_bundle_certs gauge
+		lifecycle_mgr_gateway_ca_bundle_certs 1
+		# HELP lifecycle_mgr_gateway_ca_bundle_ca_age_seconds `+
+		`Indicates the age of each CA certificate in the gateway CA bundle, position 0 is the newest
+		# TYPE lifecycle_mgr_gateway_ca_bundle_ca_age_seconds gauge
+		lifecycle_mgr_gateway_ca_bundle_ca_age_seconds{ca_fingerprint="cccc",ca_position="0"} 60
+	`), metrics.MetricSkrCertNotAfter, metrics.MetricSkrCertRenewalIn, metrics.MetricSkrCertCANotInGatewayBundle,
+		metrics.MetricSkrCertScanFailed, metrics.MetricGatewayCABundleCerts, metrics.MetricGatewayCABundleCAAge))
+}
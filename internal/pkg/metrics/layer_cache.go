@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricLayerCacheSize      = "lifecycle_mgr_layer_cache_size_bytes"
+	MetricLayerCacheLayers    = "lifecycle_mgr_layer_cache_layers"
+	MetricLayerCacheEvictions = "lifecycle_mgr_layer_cache_evictions_total"
+)
+
+type LayerCacheMetrics struct {
+	sizeGauge        prometheus.Gauge
+	layersGauge      prometheus.Gauge
+	evictionsCounter *prometheus.CounterVec
+}
+
+func NewLayerCacheMetrics() *LayerCacheMetrics {
+	cacheMetrics := &LayerCacheMetrics{
+		sizeGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: MetricLayerCacheSize,
+			Help: "Indicates the size of the module layers stored in the on-disk layer cache",
+		}),
+		layersGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: MetricLayerCacheLayers,
+			Help: "Indicates the number of module layers stored in the on-disk layer cache",
+		}),
+		evictionsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricLayerCacheEvictions,
+			Help: "Indicates the number of module layers evicted from the on-disk layer cache",
+		}, []string{evictionReasonLabel}),
+	}
+	ctrlmetrics.Registry.MustRegister(cacheMetrics.sizeGauge)
+	ctrlmetrics.Registry.MustRegister(cacheMetrics.layersGauge)
+	ctrlmetrics.Registry.MustRegister(cacheMetrics.evictionsCounter)
+	return cacheMetrics
+}
+
+func (m *LayerCacheMetrics) SetSize(bytes int64) {
+	m.sizeGauge.Set(float64(bytes))
+}
+
+func (m *LayerCacheMetrics) SetLayers(count int) {
+	m.layersGauge.Set(float64(count))
+}
+
+func (m *LayerCacheMetrics) RecordEviction(reason string) {
+	m.evictionsCounter.With(prometheus.Labels{evictionReasonLabel: reason}).Inc()
+}
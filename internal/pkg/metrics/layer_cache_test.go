@@ -0,0 +1,36 @@
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+)
+
+func TestLayerCacheMetrics(t *testing.T) {
+	cacheMetrics := metrics.NewLayerCacheMetrics()
+
+	cacheMetrics.SetSize(2048)
+	cacheMetrics.SetLayers(2)
+	cacheMetrics.RecordEviction("size")
+	cacheMetrics.RecordEviction("size")
+	cacheMetrics.RecordEviction("unreferenced")
+
+	require.NoError(t, testutil.GatherAndCompare(ctrlmetrics.Registry, strings.NewReader(`
+		# HELP lifecycle_mgr_layer_cache_size_bytes Indicates the size of the module layers stored in the on-disk layer cache
+		# TYPE lifecycle_mgr_layer_cache_size_bytes gauge
+		lifecycle_mgr_layer_cache_size_bytes 2048
+		# HELP lifecycle_mgr_layer_cache_layers Indicates the number of module layers stored in the on-disk layer cache
+		# TYPE lifecycle_mgr_layer_cache_layers gauge
+		lifecycle_mgr_layer_cache_layers 2
+		# HELP lifecycle_mgr_layer_cache_evictions_total `+
+		`Indicates the number of module layers evicted from the on-disk layer cache
+		# TYPE lifecycle_mgr_layer_cache_evictions_total counter
+		lifecycle_mgr_layer_cache_evictions_total{reason="size"} 2
+		lifecycle_mgr_layer_cache_evictions_total{reason="unreferenced"} 1
+	`), metrics.MetricLayerCacheSize, metrics.MetricLayerCacheLayers, metrics.MetricLayerCacheEvictions))
+}
@@ -12,34 +12,42 @@ import (
 type ManifestRequeueReason string
 
 const (
-	MetricManifestDuration                                     = "reconcile_duration_seconds"
-	ManifestNameLabel                                          = "manifest_name"
-	ManifestRetrieval                    ManifestRequeueReason = "manifest_retrieval"
-	ManifestInit                         ManifestRequeueReason = "manifest_initialize"
-	ManifestAddFinalizer                 ManifestRequeueReason = "manifest_add_finalizer"
-	ManifestParseSpec                    ManifestRequeueReason = "manifest_parse_spec"
-	ManifestUpdateSyncedOCIRef           ManifestRequeueReason = "manifest_update_synced_oci_ref"
-	ManifestInitSyncedOCIRef             ManifestRequeueReason = "manifest_init_synced_oci_ref"
-	ManifestClientInit                   ManifestRequeueReason = "manifest_client_init"
-	ManifestRenderResources              ManifestRequeueReason = "manifest_render_resources"
-	ManifestPruneDiffNotFinished         ManifestRequeueReason = "manifest_prune_diff_not_finished"
-	ManifestPruneDiff                    ManifestRequeueReason = "manifest_prune_diff"
-	ManifestPreDeleteEnqueueRequired     ManifestRequeueReason = "manifest_pre_delete_enqueue_required"
-	ManifestPreDelete                    ManifestRequeueReason = "manifest_pre_delete"
-	ManifestSyncResourcesEnqueueRequired ManifestRequeueReason = "manifest_sync_resources_enqueue_required"
-	ManifestSyncResources                ManifestRequeueReason = "manifest_sync_resources"
-	ManifestSyncState                    ManifestRequeueReason = "manifest_sync_state"
-	ManifestUnauthorized                 ManifestRequeueReason = "manifest_unauthorized"
-	ManifestReconcileFinished            ManifestRequeueReason = "manifest_reconcile_finished"
-	ManifestUnmanagedUpdate              ManifestRequeueReason = "manifest_unmanaged_update"
-	ManifestResourcesLabelRemoval        ManifestRequeueReason = "manifest_labels_removal"
-	ManifestOrphaned                     ManifestRequeueReason = "manifest_orphaned"
+	MetricManifestDuration                                      = "reconcile_duration_seconds"
+	MetricManifestDriftedResources                              = "lifecycle_mgr_manifest_drifted_resources"
+	ManifestNameLabel                                           = "manifest_name"
+	ManifestRetrieval                     ManifestRequeueReason = "manifest_retrieval"
+	ManifestInit                          ManifestRequeueReason = "manifest_initialize"
+	ManifestAddFinalizer                  ManifestRequeueReason = "manifest_add_finalizer"
+	ManifestParseSpec                     ManifestRequeueReason = "manifest_parse_spec"
+	ManifestUpdateSyncedOCIRef            ManifestRequeueReason = "manifest_update_synced_oci_ref"
+	ManifestInitSyncedOCIRef              ManifestRequeueReason = "manifest_init_synced_oci_ref"
+	ManifestClientInit                    ManifestRequeueReason = "manifest_client_init"
+	ManifestRenderResources               ManifestRequeueReason = "manifest_render_resources"
+	ManifestPruneDiffNotFinished          ManifestRequeueReason = "manifest_prune_diff_not_finished"
+	ManifestPruneDiff                     ManifestRequeueReason = "manifest_prune_diff"
+	ManifestPreDeleteEnqueueRequired      ManifestRequeueReason = "manifest_pre_delete_enqueue_required"
+	ManifestPreDelete                     ManifestRequeueReason = "manifest_pre_delete"
+	ManifestSyncResourcesEnqueueRequired  ManifestRequeueReason = "manifest_sync_resources_enqueue_required"
+	ManifestSyncResources                 ManifestRequeueReason = "manifest_sync_resources"
+	ManifestSyncState                     ManifestRequeueReason = "manifest_sync_state"
+	ManifestUnauthorized                  ManifestRequeueReason = "manifest_unauthorized"
+	ManifestReconcileFinished             ManifestRequeueReason = "manifest_reconcile_finished"
+	ManifestUnmanagedUpdate               ManifestRequeueReason = "manifest_unmanaged_update"
+	ManifestResourcesLabelRemoval         ManifestRequeueReason = "manifest_labels_removal"
+	ManifestOrphaned                      ManifestRequeueReason = "manifest_orphaned"
+	ManifestUpgradeHook                   ManifestRequeueReason = "manifest_upgrade_hook"
+	ManifestUpgradeHookRunning            ManifestRequeueReason = "manifest_upgrade_hook_running"
+	ManifestUpdateCompletedPreUpgradeHook ManifestRequeueReason = "manifest_update_completed_pre_upgrade_hook"
+	ManifestUpdateSyncedTemplateValues    ManifestRequeueReason = "manifest_update_synced_template_values"
+	ManifestSyncModuleConfig              ManifestRequeueReason = "manifest_sync_module_config"
+	ManifestUpdateSyncedModuleConfig      ManifestRequeueReason = "manifest_update_synced_module_config"
 )
 
 type ManifestMetrics struct {
 	*SharedMetrics
 
-	ManifestDurationGauge *prometheus.GaugeVec
+	ManifestDurationGauge         *prometheus.GaugeVec
+	ManifestDriftedResourcesGauge *prometheus.GaugeVec
 }
 
 func NewManifestMetrics(sharedMetrics *SharedMetrics) *ManifestMetrics {
@@ -49,9 +57,14 @@ func NewManifestMetrics(sharedMetrics *SharedMetrics) *ManifestMetrics {
 			Name: MetricManifestDuration,
 			Help: "Indicates the duration for manifest reconciliation in seconds",
 		}, []string{ManifestNameLabel}),
+		ManifestDriftedResourcesGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricManifestDriftedResources,
+			Help: "Indicates the number of module resources that drifted from the desired state",
+		}, []string{ManifestNameLabel, KymaNameLabel, moduleNameLabel}),
 	}
 
 	ctrlmetrics.Registry.MustRegister(metrics.ManifestDurationGauge)
+	ctrlmetrics.Registry.MustRegister(metrics.ManifestDriftedResourcesGauge)
 	return metrics
 }
 
@@ -69,8 +82,15 @@ func (k *ManifestMetrics) RemoveManifestDuration(manifestName string) {
 	})
 }
 
+func (k *ManifestMetrics) RecordDriftedResources(manifestName, kymaName, moduleName string, count int) {
+	k.ManifestDriftedResourcesGauge.WithLabelValues(manifestName, kymaName, moduleName).Set(float64(count))
+}
+
 func (k *ManifestMetrics) CleanupMetrics(manifestName string) {
 	k.ManifestDurationGauge.DeletePartialMatch(prometheus.Labels{
 		ManifestNameLabel: manifestName,
 	})
+	k.ManifestDriftedResourcesGauge.DeletePartialMatch(prometheus.Labels{
+		ManifestNameLabel: manifestName,
+	})
 }
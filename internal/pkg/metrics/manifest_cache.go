@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricManifestCacheHits      = "lifecycle_mgr_manifest_cache_hits_total"
+	MetricManifestCacheMisses    = "lifecycle_mgr_manifest_cache_misses_total"
+	MetricManifestCacheEvictions = "lifecycle_mgr_manifest_cache_evictions_total"
+	MetricManifestCacheEntries   = "lifecycle_mgr_manifest_cache_entries"
+	evictionReasonLabel          = "reason"
+)
+
+type ManifestCacheMetrics struct {
+	hitsCounter      prometheus.Counter
+	missesCounter    prometheus.Counter
+	evictionsCounter *prometheus.CounterVec
+	entriesGauge     prometheus.Gauge
+}
+
+func NewManifestCacheMetrics() *ManifestCacheMetrics {
+	cacheMetrics := &ManifestCacheMetrics{
+		hitsCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: MetricManifestCacheHits,
+			Help: "Indicates the number of parsed manifests served from the cache",
+		}),
+		missesCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: MetricManifestCacheMisses,
+			Help: "Indicates the number of manifests parsed as they were not cached",
+		}),
+		evictionsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricManifestCacheEvictions,
+			Help: "Indicates the number of parsed manifests evicted from the cache",
+		}, []string{evictionReasonLabel}),
+		entriesGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: MetricManifestCacheEntries,
+			Help: "Indicates the number of module layers whose parsed manifest is cached",
+		}),
+	}
+	ctrlmetrics.Registry.MustRegister(cacheMetrics.hitsCounter)
+	ctrlmetrics.Registry.MustRegister(cacheMetrics.missesCounter)
+	ctrlmetrics.Registry.MustRegister(cacheMetrics.evictionsCounter)
+	ctrlmetrics.Registry.MustRegister(cacheMetrics.entriesGauge)
+	return cacheMetrics
+}
+
+func (m *ManifestCacheMetrics) RecordHit() {
+	m.hitsCounter.Inc()
+}
+
+func (m *ManifestCacheMetrics) RecordMiss() {
+	m.missesCounter.Inc()
+}
+
+func (m *ManifestCacheMetrics) RecordEviction(reason string) {
+	m.evictionsCounter.With(prometheus.Labels{evictionReasonLabel: reason}).Inc()
+}
+
+func (m *ManifestCacheMetrics) SetEntries(entries int) {
+	m.entriesGauge.Set(float64(entries))
+}
@@ -0,0 +1,38 @@
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+)
+
+func TestManifestCacheMetrics(t *testing.T) {
+	cacheMetrics := metrics.NewManifestCacheMetrics()
+
+	cacheMetrics.RecordHit()
+	cacheMetrics.RecordHit()
+	cacheMetrics.RecordMiss()
+	cacheMetrics.RecordEviction("capacity")
+	cacheMetrics.SetEntries(3)
+
+	require.NoError(t, testutil.GatherAndCompare(ctrlmetrics.Registry, strings.NewReader(`
+		# HELP lifecycle_mgr_manifest_cache_hits_total Indicates the number of parsed manifests served from the cache
+		# TYPE lifecycle_mgr_manifest_cache_hits_total counter
+		lifecycle_mgr_manifest_cache_hits_total 2
+		# HELP lifecycle_mgr_manifest_cache_misses_total Indicates the number of manifests parsed as they were not cached
+		# TYPE lifecycle_mgr_manifest_cache_misses_total counter
+		lifecycle_mgr_manifest_cache_misses_total 1
+		# HELP lifecycle_mgr_manifest_cache_evictions_total Indicates the number of parsed manifests evicted from the cache
+		# TYPE lifecycle_mgr_manifest_cache_evictions_total counter
+		lifecycle_mgr_manifest_cache_evictions_total{reason="capacity"} 1
+		# HELP lifecycle_mgr_manifest_cache_entries Indicates the number of module layers whose parsed manifest is cached
+		# TYPE lifecycle_mgr_manifest_cache_entries gauge
+		lifecycle_mgr_manifest_cache_entries 3
+	`), metrics.MetricManifestCacheHits, metrics.MetricManifestCacheMisses, metrics.MetricManifestCacheEvictions,
+		metrics.MetricManifestCacheEntries))
+}
@@ -39,6 +39,11 @@ func Test_ConstantMetricNames(t *testing.T) {
 			constValue:    MetricPurgeError,
 			expectedValue: "lifecycle_mgr_purgectrl_error",
 		},
+		{
+			constName:     "MetricPurgeDecisions",
+			constValue:    MetricPurgeDecisions,
+			expectedValue: "lifecycle_mgr_purgectrl_decisions_total",
+		},
 		{
 			constName:     "MetricSelfSignedCertNotRenew",
 			constValue:    MetricSelfSignedCertNotRenew,
@@ -49,6 +54,11 @@ func Test_ConstantMetricNames(t *testing.T) {
 			constValue:    MetricManifestDuration,
 			expectedValue: "reconcile_duration_seconds",
 		},
+		{
+			constName:     "MetricManifestDriftedResources",
+			constValue:    MetricManifestDriftedResources,
+			expectedValue: "lifecycle_mgr_manifest_drifted_resources",
+		},
 		{
 			constName:     "MetricMandatoryModulesCount",
 			constValue:    MetricMandatoryModulesCount,
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -15,7 +16,11 @@ const (
 	MetricPurgeTime                     = "lifecycle_mgr_purgectrl_time"
 	MetricPurgeRequests                 = "lifecycle_mgr_purgectrl_requests_total"
 	MetricPurgeError                    = "lifecycle_mgr_purgectrl_error"
+	MetricPurgeDecisions                = "lifecycle_mgr_purgectrl_decisions_total"
 	errorReasonLabel                    = "err_reason"
+	crdGroupLabel                       = "crd_group"
+	purgeActionLabel                    = "action"
+	dryRunLabel                         = "dry_run"
 	ErrPurgeFinalizerRemoval PurgeError = "PurgeFinalizerRemovalError"
 	ErrCleanup               PurgeError = "CleanupError"
 )
@@ -26,6 +31,7 @@ type PurgeMetrics struct {
 	purgeTimeGauge       prometheus.Gauge
 	purgeRequestsCounter prometheus.Counter
 	purgeErrorGauge      *prometheus.GaugeVec
+	purgeDecisionCounter *prometheus.CounterVec
 }
 
 func NewPurgeMetrics() *PurgeMetrics {
@@ -42,10 +48,15 @@ func NewPurgeMetrics() *PurgeMetrics {
 			Name: MetricPurgeError,
 			Help: "Indicates purge errors",
 		}, []string{KymaNameLabel, shootIDLabel, instanceIDLabel, errorReasonLabel}),
+		purgeDecisionCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricPurgeDecisions,
+			Help: "Indicates the number of resources purged per CRD group and action",
+		}, []string{crdGroupLabel, purgeActionLabel, dryRunLabel}),
 	}
 	ctrlmetrics.Registry.MustRegister(purgeMetrics.purgeTimeGauge)
 	ctrlmetrics.Registry.MustRegister(purgeMetrics.purgeRequestsCounter)
 	ctrlmetrics.Registry.MustRegister(purgeMetrics.purgeErrorGauge)
+	ctrlmetrics.Registry.MustRegister(purgeMetrics.purgeDecisionCounter)
 	return purgeMetrics
 }
 
@@ -57,6 +68,16 @@ func (p *PurgeMetrics) UpdatePurgeTime(duration time.Duration) {
 	p.purgeTimeGauge.Set(duration.Seconds())
 }
 
+// RecordPurgeDecision counts the resources of a CRD group on which the action was taken,
+// or would have been taken in a dry run.
+func (p *PurgeMetrics) RecordPurgeDecision(group string, action v1beta2.PurgeAction, dryRun bool, resources int) {
+	p.purgeDecisionCounter.With(prometheus.Labels{
+		crdGroupLabel:    group,
+		purgeActionLabel: string(action),
+		dryRunLabel:      strconv.FormatBool(dryRun),
+	}).Add(float64(resources))
+}
+
 func (p *PurgeMetrics) SetPurgeError(ctx context.Context, kyma *v1beta2.Kyma, purgeError PurgeError) {
 	shootID, err := ExtractShootID(kyma)
 	if err != nil {
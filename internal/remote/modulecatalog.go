@@ -0,0 +1,101 @@
+package remote
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// ModuleCatalogMode configures how the module catalog is synchronized to the SKR.
+type ModuleCatalogMode string
+
+const (
+	// ModuleCatalogModeObjects synchronizes each allowed ModuleTemplate and ModuleReleaseMeta to the SKR.
+	ModuleCatalogModeObjects ModuleCatalogMode = "objects"
+	// ModuleCatalogModeResource synchronizes only the consolidated ModuleCatalog to the SKR
+	// and removes the ModuleTemplates and ModuleReleaseMetas synchronized before.
+	ModuleCatalogModeResource ModuleCatalogMode = "resource"
+	// ModuleCatalogModeBoth synchronizes the ModuleTemplates, the ModuleReleaseMetas and the ModuleCatalog to the SKR.
+	ModuleCatalogModeBoth ModuleCatalogMode = "both"
+)
+
+// NewModuleCatalog consolidates the ModuleReleaseMetas and ModuleTemplates allowed for a Kyma into a ModuleCatalog.
+// A module is listed with the channels of its ModuleReleaseMeta and the versions of its ModuleTemplates.
+func NewModuleCatalog(moduleReleaseMetas []v1beta2.ModuleReleaseMeta, moduleTemplates []v1beta2.ModuleTemplate,
+	namespace string,
+) *v1beta2.ModuleCatalog {
+	versions := map[string][]v1beta2.ModuleCatalogVersion{}
+	for i := range moduleTemplates {
+		moduleTemplate := &moduleTemplates[i]
+		version := v1beta2.ModuleCatalogVersion{
+			Version:  moduleTemplate.Spec.Version,
+			Beta:     moduleTemplate.IsBeta(),
+			Internal: moduleTemplate.IsInternal(),
+		}
+		if moduleTemplate.Spec.Info != nil {
+			version.Info = moduleTemplate.Spec.Info.DeepCopy()
+		}
+		versions[moduleTemplate.Spec.ModuleName] = append(versions[moduleTemplate.Spec.ModuleName], version)
+	}
+
+	entries := make([]v1beta2.ModuleCatalogEntry, 0, len(moduleReleaseMetas))
+	for _, moduleReleaseMeta := range moduleReleaseMetas {
+		entry := v1beta2.ModuleCatalogEntry{
+			Name:     moduleReleaseMeta.Spec.ModuleName,
+			Channels: make([]v1beta2.ModuleCatalogChannel, 0, len(moduleReleaseMeta.Spec.Channels)),
+			Versions: versions[moduleReleaseMeta.Spec.ModuleName],
+		}
+		for _, channel := range moduleReleaseMeta.Spec.Channels {
+			entry.Channels = append(entry.Channels, v1beta2.ModuleCatalogChannel{
+				Channel: channel.Channel,
+				Version: channel.Version,
+			})
+		}
+		slices.SortFunc(entry.Channels, func(a, b v1beta2.ModuleCatalogChannel) int {
+			return strings.Compare(a.Channel, b.Channel)
+		})
+		slices.SortFunc(entry.Versions, compareCatalogVersions)
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b v1beta2.ModuleCatalogEntry) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return &v1beta2.ModuleCatalog{
+		TypeMeta: apimetav1.TypeMeta{
+			APIVersion: v1beta2.GroupVersion.String(),
+			Kind:       string(shared.ModuleCatalogKind),
+		},
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      shared.DefaultModuleCatalogName,
+			Namespace: moduleCatalogNamespace(namespace),
+			Labels: map[string]string{
+				shared.ManagedBy: shared.ManagedByLabelValue,
+			},
+		},
+		Spec: v1beta2.ModuleCatalogSpec{
+			Modules: entries,
+		},
+	}
+}
+
+func compareCatalogVersions(a, b v1beta2.ModuleCatalogVersion) int {
+	versionA, errA := semver.NewVersion(a.Version)
+	versionB, errB := semver.NewVersion(b.Version)
+	if errA != nil || errB != nil {
+		return strings.Compare(a.Version, b.Version)
+	}
+	return versionA.Compare(versionB)
+}
+
+func moduleCatalogNamespace(namespace string) string {
+	if namespace == "" {
+		return shared.DefaultRemoteNamespace
+	}
+	return namespace
+}
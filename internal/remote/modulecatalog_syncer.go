@@ -0,0 +1,60 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+var errModuleCatalogCRDNotReady = errors.New("catalog sync: ModuleCatalog CRD is not ready")
+
+// moduleCatalogSyncer synchronizes the consolidated ModuleCatalog from KCP to SKR.
+// It expects a ready-to-use client to the KCP and SKR cluster.
+type moduleCatalogSyncer struct {
+	kcpClient client.Client
+	skrClient client.Client
+	settings  *Settings
+}
+
+func newModuleCatalogSyncer(kcpClient, skrClient client.Client, settings *Settings) *moduleCatalogSyncer {
+	return &moduleCatalogSyncer{
+		kcpClient: kcpClient,
+		skrClient: skrClient,
+		settings:  settings,
+	}
+}
+
+// SyncToSKR applies the ModuleCatalog to the SKR with a single Server-Side-Apply Patch.
+// If the ModuleCatalog CRD does not exist in the SKR, it is installed and the sync is retried in the next reconciliation.
+func (s *moduleCatalogSyncer) SyncToSKR(ctx context.Context, catalog *v1beta2.ModuleCatalog) error {
+	//nolint: staticcheck // issues: #2706, #2707
+	err := s.skrClient.Patch(ctx, catalog, client.Apply, s.settings.SSAPatchOptions)
+	if err == nil {
+		return nil
+	}
+	if meta.IsNoMatchError(err) || CRDNotFoundErr(err) {
+		if crdErr := createCRDInRuntime(ctx, shared.ModuleCatalogKind, errModuleCatalogCRDNotReady,
+			s.kcpClient, s.skrClient); crdErr != nil {
+			return crdErr
+		}
+	}
+	return fmt.Errorf("could not apply ModuleCatalog: %w", err)
+}
+
+// Delete deletes the ModuleCatalog from the SKR.
+func (s *moduleCatalogSyncer) Delete(ctx context.Context) error {
+	catalog := &v1beta2.ModuleCatalog{}
+	catalog.SetName(shared.DefaultModuleCatalogName)
+	catalog.SetNamespace(moduleCatalogNamespace(s.settings.Namespace))
+	if err := s.skrClient.Delete(ctx, catalog); err != nil && !util.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ModuleCatalog from skr: %w", err)
+	}
+	return nil
+}
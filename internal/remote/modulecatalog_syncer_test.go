@@ -0,0 +1,79 @@
+//nolint:testpackage // this file tests unexported types of the package
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+)
+
+func TestModuleCatalogSyncer_SyncToSKR_AppliesCatalog(t *testing.T) {
+	var applied *v1beta2.ModuleCatalog
+	skrClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch,
+			_ ...client.PatchOption,
+		) error {
+			catalog, ok := obj.(*v1beta2.ModuleCatalog)
+			require.True(t, ok)
+			applied = catalog
+			return nil
+		},
+	}).Build()
+	settings := &Settings{
+		Namespace:       "kyma-system",
+		SSAPatchOptions: &client.PatchOptions{FieldManager: string(fieldowners.ModuleCatalogSync)},
+	}
+	catalog := NewModuleCatalog(nil, nil, settings.Namespace)
+
+	err := newModuleCatalogSyncer(nil, skrClient, settings).SyncToSKR(t.Context(), catalog)
+
+	require.NoError(t, err)
+	assert.Equal(t, catalog, applied)
+}
+
+func TestModuleCatalogSyncer_SyncToSKR_ReturnsCRDError_WhenCRDIsMissing(t *testing.T) {
+	skrClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch,
+			_ ...client.PatchOption,
+		) error {
+			return &meta.NoKindMatchError{
+				GroupKind: schema.GroupKind{Group: v1beta2.GroupVersion.Group, Kind: string(shared.ModuleCatalogKind)},
+			}
+		},
+	}).Build()
+	// the KCP client has no ModuleCatalog CRD, so installing it to the SKR fails
+	kcpClient := fake.NewClientBuilder().Build()
+
+	err := newModuleCatalogSyncer(kcpClient, skrClient, &Settings{}).
+		SyncToSKR(t.Context(), NewModuleCatalog(nil, nil, ""))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get ModuleCatalog CRD from KCP")
+}
+
+func TestModuleCatalogSyncer_Delete(t *testing.T) {
+	scheme, err := v1beta2.SchemeBuilder.Build()
+	require.NoError(t, err)
+	catalog := NewModuleCatalog(nil, nil, "kyma-system")
+	skrClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(catalog).Build()
+	syncer := newModuleCatalogSyncer(nil, skrClient, &Settings{Namespace: "kyma-system"})
+
+	require.NoError(t, syncer.Delete(t.Context()))
+	err = skrClient.Get(t.Context(), client.ObjectKeyFromObject(catalog), &v1beta2.ModuleCatalog{})
+	require.True(t, apierrors.IsNotFound(err))
+
+	// deleting a missing ModuleCatalog succeeds
+	require.NoError(t, syncer.Delete(t.Context()))
+}
@@ -0,0 +1,85 @@
+package remote_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/remote"
+)
+
+func Test_NewModuleCatalog_ListsModulesWithChannelsAndVersions(t *testing.T) {
+	mrms := []v1beta2.ModuleReleaseMeta{
+		*newModuleReleaseMetaBuilder().
+			withName("regular-module").
+			withChannelVersion("regular", "1.10.0").
+			withChannelVersion("fast", "1.9.0").
+			build(),
+		*newModuleReleaseMetaBuilder().
+			withName("beta-module").
+			withChannelVersion("regular", "1.0.0").
+			build(),
+	}
+	betaTemplate := newModuleTemplateBuilder().
+		withName("beta-module-1.0.0").
+		withModuleName("beta-module").
+		withVersion("1.0.0").
+		withBetaEnabled().
+		build()
+	betaTemplate.Spec.Info = &v1beta2.ModuleInfo{
+		Repository:    "https://github.com/kyma-project/beta-module",
+		Documentation: "https://kyma-project.io/beta-module",
+		Icons:         []v1beta2.ModuleIcon{{Name: "module-icon", Link: "https://kyma-project.io/icon.svg"}},
+	}
+	mts := []v1beta2.ModuleTemplate{
+		*newModuleTemplateBuilder().
+			withName("regular-module-1.10.0").
+			withModuleName("regular-module").
+			withVersion("1.10.0").
+			build(),
+		*newModuleTemplateBuilder().
+			withName("regular-module-1.9.0").
+			withModuleName("regular-module").
+			withVersion("1.9.0").
+			withInternalEnabled().
+			build(),
+		*betaTemplate,
+	}
+
+	catalog := remote.NewModuleCatalog(mrms, mts, "kyma-system")
+
+	assert.Equal(t, shared.DefaultModuleCatalogName, catalog.Name)
+	assert.Equal(t, "kyma-system", catalog.Namespace)
+	assert.Equal(t, string(shared.ModuleCatalogKind), catalog.Kind)
+	assert.Equal(t, shared.ManagedByLabelValue, catalog.Labels[shared.ManagedBy])
+	require.Len(t, catalog.Spec.Modules, 2)
+
+	betaModule := catalog.Spec.Modules[0]
+	assert.Equal(t, "beta-module", betaModule.Name)
+	assert.Equal(t, []v1beta2.ModuleCatalogChannel{{Channel: "regular", Version: "1.0.0"}}, betaModule.Channels)
+	require.Len(t, betaModule.Versions, 1)
+	assert.True(t, betaModule.Versions[0].Beta)
+	assert.False(t, betaModule.Versions[0].Internal)
+	assert.Equal(t, betaTemplate.Spec.Info, betaModule.Versions[0].Info)
+
+	regularModule := catalog.Spec.Modules[1]
+	assert.Equal(t, "regular-module", regularModule.Name)
+	assert.Equal(t, []v1beta2.ModuleCatalogChannel{
+		{Channel: "fast", Version: "1.9.0"},
+		{Channel: "regular", Version: "1.10.0"},
+	}, regularModule.Channels)
+	assert.Equal(t, []v1beta2.ModuleCatalogVersion{
+		{Version: "1.9.0", Internal: true},
+		{Version: "1.10.0"},
+	}, regularModule.Versions)
+}
+
+func Test_NewModuleCatalog_UsesDefaultRemoteNamespace_WhenNoNamespaceIsConfigured(t *testing.T) {
+	catalog := remote.NewModuleCatalog(nil, nil, "")
+
+	assert.Equal(t, shared.DefaultRemoteNamespace, catalog.Namespace)
+	assert.Empty(t, catalog.Spec.Modules)
+}
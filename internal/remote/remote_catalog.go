@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,6 +17,9 @@ type Settings struct {
 	// this namespace flag can be used to override the namespace in which all ModuleTemplates should be applied.
 	Namespace       string
 	SSAPatchOptions *client.PatchOptions
+	// CatalogMode configures whether the ModuleTemplates and ModuleReleaseMetas, the ModuleCatalog, or both
+	// are synchronized to the SKR.
+	CatalogMode ModuleCatalogMode
 }
 
 type RemoteCatalog struct {
@@ -24,6 +28,10 @@ type RemoteCatalog struct {
 	settings                          Settings
 	moduleTemplateSyncAPIFactoryFn    moduleTemplateSyncAPIFactory
 	moduleReleaseMetaSyncAPIFactoryFn moduleReleaseMetaSyncAPIFactory
+	moduleCatalogSyncAPIFactoryFn     moduleCatalogSyncAPIFactory
+	// cleanedUpKymas contains the Kymas whose SKR was cleaned up from the objects synchronized in the other
+	// catalog modes. As the mode is only configured on startup, the cleanup is done once per Kyma.
+	cleanedUpKymas sync.Map
 }
 
 // moduleTemplateSyncAPI encapsulates the top-level abstration for syncing module templates to a remote cluster.
@@ -37,6 +45,11 @@ type moduleReleaseMetaSyncAPI interface {
 	DeleteAllManaged(ctx context.Context) error
 }
 
+type moduleCatalogSyncAPI interface {
+	SyncToSKR(ctx context.Context, catalog *v1beta2.ModuleCatalog) error
+	Delete(ctx context.Context) error
+}
+
 // moduleTemplateSyncAPIFactory is a function that creates moduleTemplateSyncAPI instances.
 type moduleTemplateSyncAPIFactory func(kcpClient, skrClient client.Client, settings *Settings) moduleTemplateSyncAPI
 
@@ -45,14 +58,18 @@ type moduleReleaseMetaSyncAPIFactory func(kcpClient, skrClient client.Client,
 	settings *Settings,
 ) moduleReleaseMetaSyncAPI
 
+// moduleCatalogSyncAPIFactory is a function that creates moduleCatalogSyncAPI instances.
+type moduleCatalogSyncAPIFactory func(kcpClient, skrClient client.Client, settings *Settings) moduleCatalogSyncAPI
+
 func NewRemoteCatalogFromKyma(kcpClient client.Client, skrContextFactory SkrContextProvider,
-	remoteSyncNamespace string,
+	remoteSyncNamespace string, catalogMode ModuleCatalogMode,
 ) *RemoteCatalog {
 	force := true
 	return newRemoteCatalog(kcpClient, skrContextFactory,
 		Settings{
 			SSAPatchOptions: &client.PatchOptions{FieldManager: string(fieldowners.ModuleCatalogSync), Force: &force},
 			Namespace:       remoteSyncNamespace,
+			CatalogMode:     catalogMode,
 		},
 	)
 }
@@ -70,12 +87,19 @@ func newRemoteCatalog(kcpClient client.Client, skrContextFactory SkrContextProvi
 		return newModuleReleaseMetaSyncer(kcpClient, skrClient, settings)
 	}
 
+	var moduleCatalogSyncerAPIFactoryFn moduleCatalogSyncAPIFactory = func(kcpClient, skrClient client.Client,
+		settings *Settings,
+	) moduleCatalogSyncAPI {
+		return newModuleCatalogSyncer(kcpClient, skrClient, settings)
+	}
+
 	res := &RemoteCatalog{
 		kcpClient:                         kcpClient,
 		skrContextFactory:                 skrContextFactory,
 		settings:                          settings,
 		moduleTemplateSyncAPIFactoryFn:    moduleTemplateSyncerAPIFactoryFn,
 		moduleReleaseMetaSyncAPIFactoryFn: moduleReleaseMetaSyncerAPIFactoryFn,
+		moduleCatalogSyncAPIFactoryFn:     moduleCatalogSyncerAPIFactoryFn,
 	}
 
 	return res
@@ -110,7 +134,17 @@ func (c *RemoteCatalog) Delete(
 	}
 
 	moduleTemplates := c.moduleTemplateSyncAPIFactoryFn(c.kcpClient, skrContext.Client, &c.settings)
-	return moduleTemplates.DeleteAllManaged(ctx)
+	if err := moduleTemplates.DeleteAllManaged(ctx); err != nil {
+		return err
+	}
+
+	// the ModuleCatalog is deleted regardless of the mode, as it may be left over from a previous mode
+	moduleCatalog := c.moduleCatalogSyncAPIFactoryFn(c.kcpClient, skrContext.Client, &c.settings)
+	if err := moduleCatalog.Delete(ctx); err != nil {
+		return err
+	}
+	c.cleanedUpKymas.Delete(kyma)
+	return nil
 }
 
 // GetModuleReleaseMetasToSync returns a list of ModuleReleaseMetas that should be synced to the SKR.
@@ -137,14 +171,21 @@ func (c *RemoteCatalog) GetModuleReleaseMetasToSync(
 		allowedChannels := []v1beta2.ChannelVersionAssignment{}
 		// Only add channel-version pairs which have allowed ModuleTemplates to be synced
 		for _, channel := range moduleReleaseMeta.Spec.Channels {
-			if IsAllowedModuleVersion(kyma, moduleTemplateList, moduleReleaseMeta.Spec.ModuleName, channel.Version) {
-				allowedChannels = append(allowedChannels, channel)
+			// Staged rollouts are resolved per Kyma, the SKR only sees the version assigned to it
+			resolvedChannel := v1beta2.ChannelVersionAssignment{
+				Channel: channel.Channel,
+				Version: channel.VersionFor(moduleReleaseMeta.Spec.ModuleName, kyma),
+			}
+			if IsAllowedModuleVersion(kyma, moduleTemplateList, moduleReleaseMeta.Spec.ModuleName,
+				resolvedChannel.Version) {
+				allowedChannels = append(allowedChannels, resolvedChannel)
 			}
 		}
 
 		if len(allowedChannels) > 0 {
 			allowedModuleReleaseMeta := moduleReleaseMeta
 			allowedModuleReleaseMeta.Spec.Channels = allowedChannels
+			allowedModuleReleaseMeta.Status = v1beta2.ModuleReleaseMetaStatus{}
 			moduleReleaseMetas = append(moduleReleaseMetas, allowedModuleReleaseMeta)
 		}
 	}
@@ -220,11 +261,46 @@ func (c *RemoteCatalog) sync(
 
 	moduleTemplates := c.moduleTemplateSyncAPIFactoryFn(c.kcpClient, skrContext.Client, &c.settings)
 	moduleReleaseMetas := c.moduleReleaseMetaSyncAPIFactoryFn(c.kcpClient, skrContext.Client, &c.settings)
+	moduleCatalog := c.moduleCatalogSyncAPIFactoryFn(c.kcpClient, skrContext.Client, &c.settings)
+
+	switch c.settings.CatalogMode {
+	case ModuleCatalogModeResource:
+		// The ModuleCatalog replaces the synchronized objects, so the ones synchronized before are removed.
+		cleanupErr := c.cleanupOnce(kyma, func() error {
+			return errors.Join(moduleTemplates.DeleteAllManaged(ctx), moduleReleaseMetas.DeleteAllManaged(ctx))
+		})
+		catalogErr := moduleCatalog.SyncToSKR(ctx,
+			NewModuleCatalog(kcpModuleReleaseMeta, kcpModules, c.settings.Namespace))
+		return errors.Join(cleanupErr, catalogErr)
+	case ModuleCatalogModeBoth:
+		mtErr := moduleTemplates.SyncToSKR(ctx, kcpModules)
+		mrmErr := moduleReleaseMetas.SyncToSKR(ctx, kcpModuleReleaseMeta)
+		catalogErr := moduleCatalog.SyncToSKR(ctx,
+			NewModuleCatalog(kcpModuleReleaseMeta, kcpModules, c.settings.Namespace))
+		return errors.Join(mtErr, mrmErr, catalogErr)
+	case ModuleCatalogModeObjects:
+	}
 
+	// The ModuleCatalog synchronized in a previous mode is stale.
+	cleanupErr := c.cleanupOnce(kyma, func() error {
+		return moduleCatalog.Delete(ctx)
+	})
 	mtErr := moduleTemplates.SyncToSKR(ctx, kcpModules)
 	mrmErr := moduleReleaseMetas.SyncToSKR(ctx, kcpModuleReleaseMeta)
 
-	return errors.Join(mtErr, mrmErr)
+	return errors.Join(cleanupErr, mtErr, mrmErr)
+}
+
+// cleanupOnce runs the cleanup of the Kyma's SKR unless it already succeeded.
+func (c *RemoteCatalog) cleanupOnce(kyma types.NamespacedName, cleanup func() error) error {
+	if _, done := c.cleanedUpKymas.Load(kyma); done {
+		return nil
+	}
+	if err := cleanup(); err != nil {
+		return err
+	}
+	c.cleanedUpKymas.Store(kyma, struct{}{})
+	return nil
 }
 
 func formatModuleName(moduleName, version string) string {
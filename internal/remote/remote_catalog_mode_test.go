@@ -0,0 +1,135 @@
+//nolint:testpackage // this file tests unexported types of the package
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var catalogModeKyma = types.NamespacedName{Name: "kyma", Namespace: "kcp-system"}
+
+func TestSync_InResourceMode_DeletesSynchronizedObjectsOnce(t *testing.T) {
+	templates := &moduleTemplateSyncAPIStub{}
+	releaseMetas := &moduleReleaseMetaSyncAPIStub{}
+	catalog := &moduleCatalogSyncAPIStub{}
+	remoteCatalog := newRemoteCatalogWithStubs(ModuleCatalogModeResource, templates, releaseMetas, catalog)
+
+	require.NoError(t, remoteCatalog.sync(t.Context(), catalogModeKyma, nil, nil))
+	require.NoError(t, remoteCatalog.sync(t.Context(), catalogModeKyma, nil, nil))
+
+	assert.Equal(t, 1, templates.deleteAllCalls)
+	assert.Equal(t, 1, releaseMetas.deleteAllCalls)
+	assert.Equal(t, 2, catalog.syncCalls)
+	assert.Equal(t, 0, templates.syncCalls)
+}
+
+func TestSync_InObjectsMode_DeletesStaleModuleCatalogOnce(t *testing.T) {
+	templates := &moduleTemplateSyncAPIStub{}
+	releaseMetas := &moduleReleaseMetaSyncAPIStub{}
+	catalog := &moduleCatalogSyncAPIStub{}
+	remoteCatalog := newRemoteCatalogWithStubs(ModuleCatalogModeObjects, templates, releaseMetas, catalog)
+
+	require.NoError(t, remoteCatalog.sync(t.Context(), catalogModeKyma, nil, nil))
+	require.NoError(t, remoteCatalog.sync(t.Context(), catalogModeKyma, nil, nil))
+
+	assert.Equal(t, 1, catalog.deleteCalls)
+	assert.Equal(t, 0, catalog.syncCalls)
+	assert.Equal(t, 2, templates.syncCalls)
+	assert.Equal(t, 2, releaseMetas.syncCalls)
+}
+
+func TestSync_WhenCleanupFails_RetriesCleanup(t *testing.T) {
+	templates := &moduleTemplateSyncAPIStub{}
+	releaseMetas := &moduleReleaseMetaSyncAPIStub{}
+	catalog := &moduleCatalogSyncAPIStub{deleteErr: assert.AnError}
+	remoteCatalog := newRemoteCatalogWithStubs(ModuleCatalogModeObjects, templates, releaseMetas, catalog)
+
+	require.ErrorIs(t, remoteCatalog.sync(t.Context(), catalogModeKyma, nil, nil), assert.AnError)
+	catalog.deleteErr = nil
+	require.NoError(t, remoteCatalog.sync(t.Context(), catalogModeKyma, nil, nil))
+
+	assert.Equal(t, 2, catalog.deleteCalls)
+}
+
+func newRemoteCatalogWithStubs(mode ModuleCatalogMode, templates moduleTemplateSyncAPI,
+	releaseMetas moduleReleaseMetaSyncAPI, catalog moduleCatalogSyncAPI,
+) *RemoteCatalog {
+	remoteCatalog := newRemoteCatalog(nil, &skrContextProviderStub{}, Settings{CatalogMode: mode})
+	remoteCatalog.moduleTemplateSyncAPIFactoryFn = func(_, _ client.Client, _ *Settings) moduleTemplateSyncAPI {
+		return templates
+	}
+	remoteCatalog.moduleReleaseMetaSyncAPIFactoryFn = func(_, _ client.Client,
+		_ *Settings,
+	) moduleReleaseMetaSyncAPI {
+		return releaseMetas
+	}
+	remoteCatalog.moduleCatalogSyncAPIFactoryFn = func(_, _ client.Client, _ *Settings) moduleCatalogSyncAPI {
+		return catalog
+	}
+	return remoteCatalog
+}
+
+type skrContextProviderStub struct{}
+
+func (s *skrContextProviderStub) Get(_ types.NamespacedName) (*SkrContext, error) {
+	return NewSkrContext(nil, nil), nil
+}
+
+func (s *skrContextProviderStub) Init(_ context.Context, _ types.NamespacedName) error {
+	return nil
+}
+
+func (s *skrContextProviderStub) InvalidateCache(_ types.NamespacedName) {}
+
+type moduleTemplateSyncAPIStub struct {
+	syncCalls      int
+	deleteAllCalls int
+}
+
+func (s *moduleTemplateSyncAPIStub) SyncToSKR(_ context.Context, _ []v1beta2.ModuleTemplate) error {
+	s.syncCalls++
+	return nil
+}
+
+func (s *moduleTemplateSyncAPIStub) DeleteAllManaged(_ context.Context) error {
+	s.deleteAllCalls++
+	return nil
+}
+
+type moduleReleaseMetaSyncAPIStub struct {
+	syncCalls      int
+	deleteAllCalls int
+}
+
+func (s *moduleReleaseMetaSyncAPIStub) SyncToSKR(_ context.Context, _ []v1beta2.ModuleReleaseMeta) error {
+	s.syncCalls++
+	return nil
+}
+
+func (s *moduleReleaseMetaSyncAPIStub) DeleteAllManaged(_ context.Context) error {
+	s.deleteAllCalls++
+	return nil
+}
+
+type moduleCatalogSyncAPIStub struct {
+	syncCalls   int
+	deleteCalls int
+	deleteErr   error
+}
+
+func (s *moduleCatalogSyncAPIStub) SyncToSKR(_ context.Context, _ *v1beta2.ModuleCatalog) error {
+	s.syncCalls++
+	return nil
+}
+
+func (s *moduleCatalogSyncAPIStub) Delete(_ context.Context) error {
+	s.deleteCalls++
+	return s.deleteErr
+}
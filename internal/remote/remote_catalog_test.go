@@ -19,7 +19,8 @@ import (
 )
 
 func Test_GetModuleReleaseMetasToSync_ReturnsError_ForErrorClient(t *testing.T) {
-	remoteCatalog := remote.NewRemoteCatalogFromKyma(newErrorClient(), nil, "kyma-system")
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(newErrorClient(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
 	kyma := newKymaBuilder().build()
 
 	_, err := remoteCatalog.GetModuleReleaseMetasToSync(t.Context(), kyma, nil)
@@ -29,7 +30,8 @@ func Test_GetModuleReleaseMetasToSync_ReturnsError_ForErrorClient(t *testing.T)
 }
 
 func Test_GetModuleReleaseMetasToSync_ReturnsNonBetaNonInternalMRM_ForNonBetaNonInternalKyma(t *testing.T) {
-	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system")
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
 	kyma := newKymaBuilder().build()
 	mts := &v1beta2.ModuleTemplateList{}
 	err := fakeClient().List(t.Context(), mts)
@@ -50,7 +52,8 @@ func Test_GetModuleReleaseMetasToSync_ReturnsNonBetaNonInternalMRM_ForNonBetaNon
 }
 
 func Test_GetModuleReleaseMetasToSync_ReturnsBetaNonInternalMRM_ForBetaNonInternalKyma(t *testing.T) {
-	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system")
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
 	kyma := newKymaBuilder().withBetaEnabled().build()
 	mts := &v1beta2.ModuleTemplateList{}
 	err := fakeClient().List(t.Context(), mts)
@@ -71,7 +74,8 @@ func Test_GetModuleReleaseMetasToSync_ReturnsBetaNonInternalMRM_ForBetaNonIntern
 }
 
 func Test_GetModuleReleaseMetasToSync_ReturnsNonBetaInternalMRM_ForNonBetaInternalKyma(t *testing.T) {
-	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system")
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
 	kyma := newKymaBuilder().withInternalEnabled().build()
 	mts := &v1beta2.ModuleTemplateList{}
 	err := fakeClient().List(t.Context(), mts)
@@ -91,7 +95,8 @@ func Test_GetModuleReleaseMetasToSync_ReturnsNonBetaInternalMRM_ForNonBetaIntern
 }
 
 func Test_GetModuleReleaseMetasToSync_ReturnsBetaInternalMRM_ForBetaInternalKyma(t *testing.T) {
-	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system")
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
 	kyma := newKymaBuilder().withBetaEnabled().withInternalEnabled().build()
 	mts := &v1beta2.ModuleTemplateList{}
 	err := fakeClient().List(t.Context(), mts)
@@ -116,7 +121,8 @@ func Test_GetModuleReleaseMetasToSync_ReturnsBetaInternalMRM_ForBetaInternalKyma
 }
 
 func Test_GetModuleReleaseMetasToSync_SkipsMandatoryMRM_ForAnyKyma(t *testing.T) {
-	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system")
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
 	kyma := newKymaBuilder().build()
 	mts := &v1beta2.ModuleTemplateList{}
 	err := fakeClient().List(t.Context(), mts)
@@ -131,8 +137,40 @@ func Test_GetModuleReleaseMetasToSync_SkipsMandatoryMRM_ForAnyKyma(t *testing.T)
 	}
 }
 
+func Test_GetModuleReleaseMetasToSync_ResolvesRolloutVersion_ForKymaInCohort(t *testing.T) {
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClientWithRollout(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
+	kyma := newKymaBuilder().withLabel("canary", "true").build()
+	mts := moduleTemplates()
+
+	mrms, err := remoteCatalog.GetModuleReleaseMetasToSync(t.Context(), kyma, &mts)
+
+	require.NoError(t, err)
+	require.Len(t, mrms, 1)
+	require.Len(t, mrms[0].Spec.Channels, 1)
+	assert.Equal(t, "regular", mrms[0].Spec.Channels[0].Channel)
+	assert.Equal(t, "2.0.0", mrms[0].Spec.Channels[0].Version)
+	assert.Nil(t, mrms[0].Spec.Channels[0].Rollout)
+}
+
+func Test_GetModuleReleaseMetasToSync_KeepsChannelVersion_ForKymaOutsideCohort(t *testing.T) {
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClientWithRollout(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
+	kyma := newKymaBuilder().build()
+	mts := moduleTemplates()
+
+	mrms, err := remoteCatalog.GetModuleReleaseMetasToSync(t.Context(), kyma, &mts)
+
+	require.NoError(t, err)
+	require.Len(t, mrms, 1)
+	require.Len(t, mrms[0].Spec.Channels, 1)
+	assert.Equal(t, "1.0.0", mrms[0].Spec.Channels[0].Version)
+	assert.Nil(t, mrms[0].Spec.Channels[0].Rollout)
+}
+
 func Test_GetModuleTemplatesToSync_ReturnsMTsThatAreReferencedInMRMAndNotMandatoryNotSyncDisabled(t *testing.T) {
-	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system")
+	remoteCatalog := remote.NewRemoteCatalogFromKyma(fakeClient(), nil, "kyma-system",
+		remote.ModuleCatalogModeObjects)
 	kyma := newKymaBuilder().build()
 	mts := &v1beta2.ModuleTemplateList{}
 	err := fakeClient().List(t.Context(), mts)
@@ -504,6 +542,24 @@ func fakeClient() client.Client {
 	return fake.NewClientBuilder().WithScheme(scheme).WithLists(&mrms, &mts).Build()
 }
 
+func fakeClientWithRollout() client.Client {
+	mrm := newModuleReleaseMetaBuilder().
+		withName("regular-module").
+		withChannelVersion("regular", "1.0.0").
+		withChannelRollout("regular", &v1beta2.ChannelRollout{
+			Version: "2.0.0",
+			Selector: &apimetav1.LabelSelector{
+				MatchLabels: map[string]string{"canary": "true"},
+			},
+		}).
+		build()
+
+	scheme := machineryruntime.NewScheme()
+	machineryutilruntime.Must(api.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(mrm).Build()
+}
+
 type moduleReleaseMetaBuilder struct {
 	moduleReleaseMeta *v1beta2.ModuleReleaseMeta
 }
@@ -536,6 +592,17 @@ func (b *moduleReleaseMetaBuilder) withChannelVersion(channel, version string) *
 	return b
 }
 
+func (b *moduleReleaseMetaBuilder) withChannelRollout(channel string,
+	rollout *v1beta2.ChannelRollout,
+) *moduleReleaseMetaBuilder {
+	for i := range b.moduleReleaseMeta.Spec.Channels {
+		if b.moduleReleaseMeta.Spec.Channels[i].Channel == channel {
+			b.moduleReleaseMeta.Spec.Channels[i].Rollout = rollout
+		}
+	}
+	return b
+}
+
 func (b *moduleReleaseMetaBuilder) withMandatory(version string) *moduleReleaseMetaBuilder {
 	b.moduleReleaseMeta.Spec.Mandatory = &v1beta2.Mandatory{
 		Version: version,
@@ -627,6 +694,11 @@ func (b *kymaBuilder) withInternalEnabled() *kymaBuilder {
 	return b
 }
 
+func (b *kymaBuilder) withLabel(key, value string) *kymaBuilder {
+	b.kyma.Labels[key] = value
+	return b
+}
+
 type errorClient struct {
 	client.Client
 }
@@ -0,0 +1,102 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+const yamlDocumentSeparator = "---\n"
+
+// LoadKyma reads a Kyma from a YAML file and applies the defaults of the Kyma CRD to its modules.
+func LoadKyma(path string) (*v1beta2.Kyma, error) {
+	object, err := readObject(path)
+	if err != nil {
+		return nil, err
+	}
+	modules, _, err := unstructured.NestedSlice(object.Object, "spec", "modules")
+	if err != nil {
+		return nil, fmt.Errorf("invalid modules in Kyma %s: %w", path, err)
+	}
+	for _, module := range modules {
+		moduleObject, ok := module.(map[string]any)
+		if !ok {
+			continue
+		}
+		if _, found := moduleObject["customResourcePolicy"]; !found {
+			moduleObject["customResourcePolicy"] = v1beta2.CustomResourcePolicyCreateAndDelete
+		}
+		if _, found := moduleObject["managed"]; !found {
+			moduleObject["managed"] = true
+		}
+	}
+	if len(modules) > 0 {
+		if err := unstructured.SetNestedSlice(object.Object, modules, "spec", "modules"); err != nil {
+			return nil, fmt.Errorf("failed to default modules in Kyma %s: %w", path, err)
+		}
+	}
+
+	kyma := &v1beta2.Kyma{}
+	if err := convert(object, kyma); err != nil {
+		return nil, fmt.Errorf("invalid Kyma %s: %w", path, err)
+	}
+	return kyma, nil
+}
+
+// LoadModuleTemplate reads a ModuleTemplate from a YAML file.
+func LoadModuleTemplate(path string) (*v1beta2.ModuleTemplate, error) {
+	object, err := readObject(path)
+	if err != nil {
+		return nil, err
+	}
+	template := &v1beta2.ModuleTemplate{}
+	if err := convert(object, template); err != nil {
+		return nil, fmt.Errorf("invalid ModuleTemplate %s: %w", path, err)
+	}
+	return template, nil
+}
+
+// WriteResources writes the resources as a stream of YAML documents.
+func WriteResources(writer io.Writer, resources []*unstructured.Unstructured) error {
+	for _, resource := range resources {
+		data, err := yaml.Marshal(resource.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal resource %s: %w", resource.GetName(), err)
+		}
+		if _, err := io.WriteString(writer, yamlDocumentSeparator); err != nil {
+			return fmt.Errorf("failed to write resource %s: %w", resource.GetName(), err)
+		}
+		if _, err := writer.Write(data); err != nil {
+			return fmt.Errorf("failed to write resource %s: %w", resource.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func readObject(path string) (*unstructured.Unstructured, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	object := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(data, &object.Object); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return object, nil
+}
+
+func convert(object *unstructured.Unstructured, target any) error {
+	data, err := object.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal object: %w", err)
+	}
+	if err := yaml.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("failed to unmarshal object: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package render_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/render"
+)
+
+func TestLoadKyma_AppliesModuleDefaults(t *testing.T) {
+	path := writeFile(t, "kyma.yaml", `apiVersion: operator.kyma-project.io/v1beta2
+kind: Kyma
+metadata:
+  name: test-kyma
+  namespace: kcp-system
+spec:
+  channel: regular
+  modules:
+  - name: template-operator
+  - name: other-module
+    managed: false
+    customResourcePolicy: Ignore
+`)
+
+	kyma, err := render.LoadKyma(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "test-kyma", kyma.GetName())
+	require.Len(t, kyma.Spec.Modules, 2)
+	assert.Equal(t, v1beta2.CustomResourcePolicy(v1beta2.CustomResourcePolicyCreateAndDelete),
+		kyma.Spec.Modules[0].CustomResourcePolicy)
+	assert.True(t, kyma.Spec.Modules[0].Managed)
+	assert.Equal(t, v1beta2.CustomResourcePolicy(v1beta2.CustomResourcePolicyIgnore), kyma.Spec.Modules[1].CustomResourcePolicy)
+	assert.False(t, kyma.Spec.Modules[1].Managed)
+}
+
+func TestLoadKyma_WhenFileDoesNotExist_ReturnsError(t *testing.T) {
+	_, err := render.LoadKyma(filepath.Join(t.TempDir(), "kyma.yaml"))
+
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadModuleTemplate_ReturnsModuleTemplate(t *testing.T) {
+	path := writeFile(t, "moduletemplate.yaml", `apiVersion: operator.kyma-project.io/v1beta2
+kind: ModuleTemplate
+metadata:
+  name: template-operator-1.0.0
+  namespace: kcp-system
+spec:
+  moduleName: template-operator
+  version: 1.0.0
+  data:
+    apiVersion: operator.kyma-project.io/v1alpha1
+    kind: Sample
+    metadata:
+      name: sample-yaml
+    spec:
+      resourceFilePath: ./module-data/yaml
+`)
+
+	template, err := render.LoadModuleTemplate(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "template-operator", template.Spec.ModuleName)
+	assert.Equal(t, "1.0.0", template.Spec.Version)
+	require.NotNil(t, template.Spec.Data)
+	assert.Equal(t, "Sample", template.Spec.Data.GetKind())
+}
+
+func TestWriteResources_WritesYAMLStream(t *testing.T) {
+	first := &unstructured.Unstructured{}
+	first.SetAPIVersion("v1")
+	first.SetKind("Namespace")
+	first.SetName("first")
+	second := first.DeepCopy()
+	second.SetName("second")
+	var buffer bytes.Buffer
+
+	err := render.WriteResources(&buffer, []*unstructured.Unstructured{first, second})
+
+	require.NoError(t, err)
+	assert.Equal(t, `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: first
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: second
+`, buffer.String())
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
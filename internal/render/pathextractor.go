@@ -0,0 +1,105 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+type BlobReader interface {
+	Blob(digest string) (io.ReadCloser, error)
+}
+
+// LayoutPathExtractor extracts the raw manifest layer from an OCI image layout instead of a registry.
+type LayoutPathExtractor struct {
+	blobReader    BlobReader
+	dir           string
+	pathExtractor *img.PathExtractor
+}
+
+// NewLayoutPathExtractor creates a LayoutPathExtractor that writes the extracted layers to the given directory.
+func NewLayoutPathExtractor(blobReader BlobReader, dir string) *LayoutPathExtractor {
+	return &LayoutPathExtractor{
+		blobReader:    blobReader,
+		dir:           dir,
+		pathExtractor: img.NewPathExtractor(),
+	}
+}
+
+func (e *LayoutPathExtractor) GetPathFromRawManifest(_ context.Context,
+	imageSpec v1beta2.ImageSpec,
+	_ authn.Keychain,
+) (string, error) {
+	switch imageSpec.Type {
+	case v1beta2.OciRefType:
+		return e.writeBlob(imageSpec, string(v1beta2.RawManifestLayer)+".yaml")
+	case v1beta2.OciDirType:
+		tarFile, err := e.writeBlob(imageSpec, string(v1beta2.RawManifestLayer)+".tar")
+		if err != nil {
+			return "", err
+		}
+		return e.pathExtractor.ExtractLayer(tarFile)
+	default:
+		return "", img.ErrInvalidImageSpecType
+	}
+}
+
+func (e *LayoutPathExtractor) writeBlob(imageSpec v1beta2.ImageSpec, filename string) (string, error) {
+	blob, err := e.blobReader.Blob(imageSpec.Ref)
+	if err != nil {
+		return "", err
+	}
+	defer blob.Close()
+
+	content, err := uncompressed(blob)
+	if err != nil {
+		return "", fmt.Errorf("failed to uncompress layer %s: %w", imageSpec.Ref, err)
+	}
+
+	installPath := filepath.Join(e.dir, strings.ReplaceAll(imageSpec.Ref, ":", "-"))
+	if err := os.MkdirAll(installPath, fs.ModePerm); err != nil {
+		return "", fmt.Errorf("failed to create directory for layer %s: %w", imageSpec.Ref, err)
+	}
+	manifestPath := filepath.Join(installPath, filename)
+	outFile, err := os.Create(manifestPath)
+	if err != nil {
+		return "", fmt.Errorf("file create failed for layer %s: %w", imageSpec.Ref, err)
+	}
+	defer outFile.Close()
+	if _, err := io.Copy(outFile, content); err != nil {
+		return "", fmt.Errorf("file copy failed for layer %s: %w", imageSpec.Ref, err)
+	}
+	return manifestPath, nil
+}
+
+// uncompressed returns the content of the blob, decompressing it if it is gzip compressed.
+func uncompressed(blob io.Reader) (io.Reader, error) {
+	reader := bufio.NewReader(blob)
+	magic, err := reader.Peek(len(gzipMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read blob: %w", err)
+	}
+	if !bytes.Equal(magic, gzipMagic) {
+		return reader, nil
+	}
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gzip blob: %w", err)
+	}
+	return gzipReader, nil
+}
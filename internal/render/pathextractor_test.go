@@ -0,0 +1,76 @@
+package render_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+	"github.com/kyma-project/lifecycle-manager/internal/render"
+)
+
+const layerDigest = "sha256:0123456789abcdef"
+
+func TestGetPathFromRawManifest_WithPlainLayer_WritesManifest(t *testing.T) {
+	extractor := render.NewLayoutPathExtractor(&blobReaderStub{content: []byte(rawManifestLayer)}, t.TempDir())
+
+	path, err := extractor.GetPathFromRawManifest(t.Context(),
+		v1beta2.ImageSpec{Ref: layerDigest, Type: v1beta2.OciRefType}, nil)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, rawManifestLayer, string(content))
+}
+
+func TestGetPathFromRawManifest_WithGzipLayer_WritesUncompressedManifest(t *testing.T) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, err := writer.Write([]byte(rawManifestLayer))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	extractor := render.NewLayoutPathExtractor(&blobReaderStub{content: compressed.Bytes()}, t.TempDir())
+
+	path, err := extractor.GetPathFromRawManifest(t.Context(),
+		v1beta2.ImageSpec{Ref: layerDigest, Type: v1beta2.OciRefType}, nil)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, rawManifestLayer, string(content))
+}
+
+func TestGetPathFromRawManifest_WhenBlobIsMissing_ReturnsError(t *testing.T) {
+	extractor := render.NewLayoutPathExtractor(&blobReaderStub{err: assert.AnError}, t.TempDir())
+
+	_, err := extractor.GetPathFromRawManifest(t.Context(),
+		v1beta2.ImageSpec{Ref: layerDigest, Type: v1beta2.OciRefType}, nil)
+
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestGetPathFromRawManifest_WithInvalidType_ReturnsError(t *testing.T) {
+	extractor := render.NewLayoutPathExtractor(&blobReaderStub{}, t.TempDir())
+
+	_, err := extractor.GetPathFromRawManifest(t.Context(), v1beta2.ImageSpec{Ref: layerDigest}, nil)
+
+	require.ErrorIs(t, err, img.ErrInvalidImageSpecType)
+}
+
+type blobReaderStub struct {
+	content []byte
+	err     error
+}
+
+func (b *blobReaderStub) Blob(_ string) (io.ReadCloser, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return io.NopCloser(bytes.NewReader(b.content)), nil
+}
@@ -0,0 +1,174 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal"
+	declarativev2 "github.com/kyma-project/lifecycle-manager/internal/declarative/v2"
+	descriptorcache "github.com/kyma-project/lifecycle-manager/internal/descriptor/cache"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types/ocmidentity"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/parser"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/templating"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+const defaultOcmComponentNamePrefix = "kyma-project.io/module/"
+
+var (
+	ErrModuleNameMissing = errors.New("module template does not define spec.moduleName")
+	ErrModuleNotRendered = errors.New("module could not be rendered")
+)
+
+type SpecResolver interface {
+	GetSpec(ctx context.Context, manifest *v1beta2.Manifest) (*declarativev2.Spec, error)
+}
+
+type Options struct {
+	// OCIRegistry is the registry host the raw manifest layer is referenced from.
+	OCIRegistry string
+	// RemoteSyncNamespace is the namespace of the default CR if the ModuleTemplate does not define one.
+	RemoteSyncNamespace string
+	// OcmComponentName is the OCM component of the module. Defaults to "kyma-project.io/module/<module-name>".
+	OcmComponentName string
+	// SkrImagePullSecretName is the image pull secret added to the workloads, if set.
+	SkrImagePullSecretName string
+}
+
+// Renderer renders a ModuleTemplate for a Kyma into the resources Lifecycle Manager applies to the runtime.
+// It uses the same parser and resource transforms as the reconcilers, but reads the component descriptor
+// and the layers from the given sources instead of the cluster.
+type Renderer struct {
+	descriptorProvider *provider.CachedDescriptorProvider
+	specResolver       SpecResolver
+	options            Options
+}
+
+func NewRenderer(descriptorService provider.DescriptorService, specResolver SpecResolver,
+	options Options,
+) *Renderer {
+	return &Renderer{
+		descriptorProvider: provider.NewCachedDescriptorProvider(descriptorService,
+			descriptorcache.NewDescriptorCache()),
+		specResolver: specResolver,
+		options:      options,
+	}
+}
+
+// Render returns the resources of the raw manifest layer after the manifest templating and all resource transforms
+// are applied, followed by the default CR if it is created for the module.
+func (r *Renderer) Render(ctx context.Context, kyma *v1beta2.Kyma, template *v1beta2.ModuleTemplate,
+) ([]*unstructured.Unstructured, error) {
+	manifest, err := r.RenderManifest(ctx, kyma, template)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := r.specResolver.GetSpec(ctx, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve manifest spec: %w", err)
+	}
+	resources, err := internal.ParseManifestToObjects(spec.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse manifest objects: %w", err)
+	}
+
+	if manifest.Spec.TemplateManifest {
+		if err := templating.Render(resources.Items, templating.NewValues(manifest)); err != nil {
+			return nil, fmt.Errorf("failed to render manifest template: %w", err)
+		}
+	}
+
+	for _, transform := range r.resourceTransforms() {
+		if err := transform(ctx, manifest, resources.Items); err != nil {
+			return nil, fmt.Errorf("failed to transform resources: %w", err)
+		}
+	}
+
+	rendered := resources.Items
+	if manifest.ShouldCreateDefaultModuleCR() {
+		rendered = append(rendered, manifest.Spec.Resource.DeepCopy())
+	}
+	return rendered, nil
+}
+
+// RenderManifest returns the Manifest the Kyma reconciler creates for the module of the ModuleTemplate.
+// The module is enabled in the Kyma if it is not part of the spec yet.
+func (r *Renderer) RenderManifest(ctx context.Context, kyma *v1beta2.Kyma, template *v1beta2.ModuleTemplate,
+) (*v1beta2.Manifest, error) {
+	moduleName := template.Spec.ModuleName
+	if moduleName == "" {
+		return nil, fmt.Errorf("%w: %s", ErrModuleNameMissing, template.GetName())
+	}
+	ocmComponentName := r.options.OcmComponentName
+	if ocmComponentName == "" {
+		ocmComponentName = defaultOcmComponentNamePrefix + moduleName
+	}
+	ocmId, err := ocmidentity.NewComponentId(ocmComponentName, template.Spec.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid component identity: %w", err)
+	}
+
+	kyma = kyma.DeepCopy()
+	kyma.Status.Modules = nil
+	templateInfo := &templatelookup.ModuleTemplateInfo{
+		ModuleTemplate: template.DeepCopy(),
+		ComponentId:    ocmId,
+	}
+	templates := templatelookup.ModuleTemplatesByModuleName{moduleName: templateInfo}
+
+	prsr := parser.NewParser(nil, r.descriptorProvider, r.options.RemoteSyncNamespace, r.options.OCIRegistry)
+	var modules modulecommon.Modules
+	if template.IsMandatory() {
+		modules = prsr.GenerateMandatoryModulesFromTemplates(ctx, kyma, templates)
+	} else {
+		module := enableModule(kyma, moduleName)
+		templateInfo.DesiredChannel = module.Channel
+		if templateInfo.DesiredChannel == "" {
+			templateInfo.DesiredChannel = kyma.Spec.Channel
+		}
+		modules = prsr.GenerateModulesFromTemplates(kyma, templates)
+	}
+
+	for _, module := range modules {
+		if module.ModuleName != moduleName {
+			continue
+		}
+		if module.TemplateInfo.Err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrModuleNotRendered, module.TemplateInfo.Err)
+		}
+		module.ApplyDefaultMetaToManifest(kyma)
+		return module.Manifest, nil
+	}
+	return nil, fmt.Errorf("%w: module %s not found", ErrModuleNotRendered, moduleName)
+}
+
+func (r *Renderer) resourceTransforms() []declarativev2.ResourceTransform {
+	transforms := declarativev2.GetDefaultResourceTransforms()
+	if r.options.SkrImagePullSecretName != "" {
+		transforms = append(transforms,
+			declarativev2.CreateSkrImagePullSecretTransform(r.options.SkrImagePullSecretName))
+	}
+	return transforms
+}
+
+func enableModule(kyma *v1beta2.Kyma, moduleName string) v1beta2.Module {
+	for _, module := range kyma.Spec.Modules {
+		if module.Name == moduleName {
+			return module
+		}
+	}
+	module := v1beta2.Module{
+		Name:                 moduleName,
+		CustomResourcePolicy: v1beta2.CustomResourcePolicyCreateAndDelete,
+		Managed:              true,
+	}
+	kyma.Spec.Modules = append(kyma.Spec.Modules, module)
+	return module
+}
@@ -0,0 +1,214 @@
+package render_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	declarativev2 "github.com/kyma-project/lifecycle-manager/internal/declarative/v2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/templating"
+	"github.com/kyma-project/lifecycle-manager/internal/render"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/builder"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/service/componentdescriptor"
+)
+
+const (
+	moduleName       = "template-operator"
+	moduleVersion    = "1.1.1-e2e-test"
+	kymaName         = "test-kyma"
+	kymaNamespace    = "kcp-system"
+	rawManifestLayer = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: template-operator-controller-manager
+  namespace: template-operator-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: europe-docker.pkg.dev/kyma-project/prod/template-operator:1.1.1
+`
+)
+
+func TestRender_ReturnsTransformedResourcesAndDefaultCR(t *testing.T) {
+	renderer := newRenderer(t, render.Options{OCIRegistry: "registry.localhost", RemoteSyncNamespace: "kyma-system"})
+	manifest, err := renderer.RenderManifest(t.Context(), newKyma(), newTemplate(false))
+	require.NoError(t, err)
+
+	resources, err := renderer.Render(t.Context(), newKyma(), newTemplate(false))
+
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	deployment := resources[0]
+	assert.Equal(t, "Deployment", deployment.GetKind())
+	assert.Equal(t, shared.ManagedByLabelValue, deployment.GetLabels()[shared.ManagedBy])
+	assert.Equal(t, "Kyma", deployment.GetLabels()["app.kubernetes.io/part-of"])
+	assert.Equal(t, kymaNamespace+"/"+manifest.GetName(), deployment.GetAnnotations()[shared.OwnedByAnnotation])
+	assert.Equal(t, declarativev2.DisclaimerAnnotationValue,
+		deployment.GetAnnotations()[declarativev2.DisclaimerAnnotation])
+
+	defaultCR := resources[1]
+	assert.Equal(t, "kyma-system", defaultCR.GetNamespace())
+}
+
+func TestRender_WithImagePullSecret_AddsSecretToWorkloads(t *testing.T) {
+	renderer := newRenderer(t, render.Options{SkrImagePullSecretName: "skr-pull-secret"})
+
+	resources, err := renderer.Render(t.Context(), newKyma(), newTemplate(false))
+
+	require.NoError(t, err)
+	imagePullSecrets, found, err := unstructured.NestedSlice(resources[0].Object,
+		"spec", "template", "spec", "imagePullSecrets")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Contains(t, imagePullSecrets, map[string]any{"name": "skr-pull-secret"})
+}
+
+func TestRender_WithIgnoredCustomResourcePolicy_OmitsDefaultCR(t *testing.T) {
+	renderer := newRenderer(t, render.Options{})
+	kyma := newKyma()
+	kyma.Spec.Modules = []v1beta2.Module{{
+		Name:                 moduleName,
+		CustomResourcePolicy: v1beta2.CustomResourcePolicyIgnore,
+		Managed:              true,
+	}}
+
+	resources, err := renderer.Render(t.Context(), kyma, newTemplate(false))
+
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "Deployment", resources[0].GetKind())
+}
+
+func TestRender_WithTemplateManifest_RendersTemplateValues(t *testing.T) {
+	renderer := newRendererWithManifest(t, render.Options{}, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: runtime-info
+  namespace: template-operator-system
+data:
+  region: ${kyma.region}
+  version: ${module.version}
+`)
+	kyma := newKyma()
+	kyma.SetLabels(map[string]string{shared.RegionLabel: "europe-west1"})
+	template := newTemplate(false)
+	template.Spec.TemplateManifest = true
+
+	resources, err := renderer.Render(t.Context(), kyma, template)
+
+	require.NoError(t, err)
+	data, found, err := unstructured.NestedStringMap(resources[0].Object, "data")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, map[string]string{"region": "europe-west1", "version": moduleVersion}, data)
+}
+
+func TestRender_WithTemplateManifest_WhenValueIsUndefined_ReturnsError(t *testing.T) {
+	renderer := newRendererWithManifest(t, render.Options{}, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: runtime-info
+data:
+  region: ${kyma.region}
+`)
+	template := newTemplate(false)
+	template.Spec.TemplateManifest = true
+
+	_, err := renderer.Render(t.Context(), newKyma(), template)
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, templating.ErrUndefinedValue)
+}
+
+func TestRenderManifest_ReturnsManifestOfKymaReconciler(t *testing.T) {
+	renderer := newRenderer(t, render.Options{OCIRegistry: "registry.localhost"})
+	kyma := newKyma()
+	kyma.Spec.Channel = "fast"
+
+	manifest, err := renderer.RenderManifest(t.Context(), kyma, newTemplate(false))
+
+	require.NoError(t, err)
+	assert.Equal(t, kymaNamespace, manifest.GetNamespace())
+	assert.Equal(t, moduleVersion, manifest.Spec.Version)
+	assert.Equal(t, kymaName, manifest.GetLabels()[shared.KymaName])
+	assert.Equal(t, moduleName, manifest.GetLabels()[shared.ModuleName])
+	assert.Equal(t, "fast", manifest.GetLabels()[shared.ChannelLabel])
+	assert.Equal(t, string(v1beta2.RawManifestLayer), manifest.Spec.Install.Name)
+}
+
+func TestRenderManifest_WithMandatoryTemplate_ReturnsManifest(t *testing.T) {
+	renderer := newRenderer(t, render.Options{})
+
+	manifest, err := renderer.RenderManifest(t.Context(), newKyma(), newTemplate(true))
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.EnableLabelValue, manifest.GetLabels()[shared.IsMandatoryModule])
+}
+
+func TestRenderManifest_WhenModuleNameIsMissing_ReturnsError(t *testing.T) {
+	renderer := newRenderer(t, render.Options{})
+	template := newTemplate(false)
+	template.Spec.ModuleName = ""
+
+	_, err := renderer.RenderManifest(t.Context(), newKyma(), template)
+
+	require.ErrorIs(t, err, render.ErrModuleNameMissing)
+}
+
+func TestRenderManifest_WhenDescriptorIsNotFound_ReturnsError(t *testing.T) {
+	renderer := render.NewRenderer(&componentdescriptor.FakeService{}, &specResolverStub{}, render.Options{})
+
+	_, err := renderer.RenderManifest(t.Context(), newKyma(), newTemplate(false))
+
+	require.ErrorIs(t, err, render.ErrModuleNotRendered)
+}
+
+type specResolverStub struct {
+	path string
+}
+
+func (s *specResolverStub) GetSpec(_ context.Context, manifest *v1beta2.Manifest) (*declarativev2.Spec, error) {
+	return &declarativev2.Spec{ManifestName: manifest.Spec.Install.Name, Path: s.path}, nil
+}
+
+func newRenderer(t *testing.T, options render.Options) *render.Renderer {
+	t.Helper()
+	return newRendererWithManifest(t, options, rawManifestLayer)
+}
+
+func newRendererWithManifest(t *testing.T, options render.Options, rawManifest string) *render.Renderer {
+	t.Helper()
+	var templateWithDescriptor v1beta2.ModuleTemplate
+	builder.ReadComponentDescriptorFromFile("v1beta2_template_operator_current_ocm.yaml", &templateWithDescriptor)
+	descriptorService := componentdescriptor.NewFakeService(templateWithDescriptor.Spec.Descriptor.Raw)
+
+	manifestPath := filepath.Join(t.TempDir(), "raw-manifest.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(rawManifest), 0o600))
+	return render.NewRenderer(descriptorService, &specResolverStub{path: manifestPath}, options)
+}
+
+func newKyma() *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: kymaName, Namespace: kymaNamespace},
+		Spec:       v1beta2.KymaSpec{Channel: "regular"},
+	}
+}
+
+func newTemplate(mandatory bool) *v1beta2.ModuleTemplate {
+	return builder.NewModuleTemplateBuilder().
+		WithName(v1beta2.CreateModuleTemplateName(moduleName, moduleVersion)).
+		WithModuleName(moduleName).
+		WithVersion(moduleVersion).
+		WithMandatory(mandatory).
+		Build()
+}
@@ -0,0 +1,36 @@
+package configmap
+
+import (
+	"context"
+	"fmt"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+)
+
+type Repository struct {
+	kcpClient client.Client
+}
+
+func NewRepository(kcpClient client.Client) *Repository {
+	return &Repository{
+		kcpClient: kcpClient,
+	}
+}
+
+// Apply creates or updates the ConfigMap using server-side apply.
+func (r *Repository) Apply(ctx context.Context, configMap *apicorev1.ConfigMap) error {
+	configMap.SetGroupVersionKind(apicorev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	configMap.SetManagedFields(nil)
+	if err := r.kcpClient.Patch(ctx, configMap,
+		//nolint: staticcheck // issues: #2706, #2707
+		client.Apply,
+		fieldowners.LifecycleManager,
+		client.ForceOwnership,
+	); err != nil {
+		return fmt.Errorf("failed to apply configmap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+	return nil
+}
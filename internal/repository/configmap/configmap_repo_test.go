@@ -0,0 +1,62 @@
+package configmap_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	configmaprepo "github.com/kyma-project/lifecycle-manager/internal/repository/configmap"
+)
+
+func TestApply_ClientCallSucceeds_AppliesConfigMap(t *testing.T) {
+	clientStub := &patchClientStub{}
+	repository := configmaprepo.NewRepository(clientStub)
+	configMap := &apicorev1.ConfigMap{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "test-configmap", Namespace: "kcp-system"},
+		Data:       map[string]string{"key": "value"},
+	}
+
+	err := repository.Apply(t.Context(), configMap)
+
+	require.NoError(t, err)
+	assert.True(t, clientStub.called)
+	assert.Equal(t, client.Apply, clientStub.patch) //nolint: staticcheck // issues: #2706, #2707
+	assert.Contains(t, clientStub.opts, fieldowners.LifecycleManager)
+	assert.Equal(t, "ConfigMap", clientStub.object.GetObjectKind().GroupVersionKind().Kind)
+}
+
+func TestApply_ClientReturnsAnError_ReturnsError(t *testing.T) {
+	clientStub := &patchClientStub{err: assert.AnError}
+	repository := configmaprepo.NewRepository(clientStub)
+
+	err := repository.Apply(t.Context(), &apicorev1.ConfigMap{})
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.True(t, clientStub.called)
+}
+
+type patchClientStub struct {
+	client.Client
+
+	called bool
+	object client.Object
+	patch  client.Patch
+	opts   []client.PatchOption
+	err    error
+}
+
+func (c *patchClientStub) Patch(_ context.Context, obj client.Object, patch client.Patch,
+	opts ...client.PatchOption,
+) error {
+	c.called = true
+	c.object = obj
+	c.patch = patch
+	c.opts = opts
+	return c.err
+}
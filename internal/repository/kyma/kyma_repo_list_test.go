@@ -61,6 +61,29 @@ func Test_Get_WhenTwoKymasFound_ReturnsList(t *testing.T) {
 	require.Equal(t, kymaNamespace, foundKymas.Items[1].GetNamespace())
 }
 
+func Test_List_WhenKymasFound_ReturnsAllKymas(t *testing.T) {
+	expectedKymas := []client.Object{
+		testKyma(kymaName+"-1", kymaNamespace),
+		testKyma(kymaName+"-2", kymaNamespace),
+	}
+	kymaClient := kymarepo.NewRepository(&readerStubValidKyma{listItems: expectedKymas}, kymaNamespace)
+
+	foundKymas, err := kymaClient.List(t.Context())
+
+	require.NoError(t, err)
+	require.Len(t, foundKymas.Items, 2)
+	require.Equal(t, kymaName+"-1", foundKymas.Items[0].GetName())
+	require.Equal(t, kymaName+"-2", foundKymas.Items[1].GetName())
+}
+
+func Test_List_WhenListingKymasFails_ReturnError(t *testing.T) {
+	kymaClient := kymarepo.NewRepository(&readerStubGenericError{}, kymaNamespace)
+
+	_, err := kymaClient.List(t.Context())
+
+	require.ErrorIs(t, err, errGeneric)
+}
+
 func testKyma(name, namespace string) *v1beta2.Kyma {
 	return &v1beta2.Kyma{
 		ObjectMeta: apimetav1.ObjectMeta{
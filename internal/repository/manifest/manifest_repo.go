@@ -35,6 +35,14 @@ func (r *Repository) DeleteAllForModule(ctx context.Context, moduleName string)
 	return nil
 }
 
+func (r *Repository) List(ctx context.Context) ([]v1beta2.Manifest, error) {
+	var manifestList v1beta2.ManifestList
+	if err := r.clnt.List(ctx, &manifestList, client.InNamespace(r.namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Manifests in namespace %s: %w", r.namespace, err)
+	}
+	return manifestList.Items, nil
+}
+
 func (r *Repository) ListAllForModule(ctx context.Context, moduleName string) (
 	[]apimetav1.PartialObjectMetadata, error,
 ) {
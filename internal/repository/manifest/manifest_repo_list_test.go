@@ -0,0 +1,46 @@
+package manifest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	manifestrepo "github.com/kyma-project/lifecycle-manager/internal/repository/manifest"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/random"
+)
+
+func TestRepository_List(t *testing.T) {
+	ctx := context.Background()
+	testNamespace := random.Name()
+
+	t.Run("successfully lists all manifests", func(t *testing.T) {
+		expectedManifests := []v1beta2.Manifest{
+			{ObjectMeta: apimetav1.ObjectMeta{Name: "manifest1", Namespace: testNamespace}},
+			{ObjectMeta: apimetav1.ObjectMeta{Name: "manifest2", Namespace: testNamespace}},
+		}
+		stub := &clientStub{manifests: expectedManifests}
+		repo := manifestrepo.NewRepository(stub, testNamespace)
+
+		result, err := repo.List(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedManifests, result)
+		require.True(t, stub.listCalled)
+		require.Equal(t, testNamespace, stub.capturedNamespace)
+	})
+
+	t.Run("returns error when list fails", func(t *testing.T) {
+		stub := &clientStub{listErr: errors.New("list error")}
+		repo := manifestrepo.NewRepository(stub, testNamespace)
+
+		result, err := repo.List(ctx)
+
+		require.Error(t, err)
+		require.Nil(t, result)
+		require.Contains(t, err.Error(), "failed to list Manifests in namespace")
+	})
+}
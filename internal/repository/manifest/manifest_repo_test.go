@@ -5,6 +5,8 @@ import (
 
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 )
 
 type clientStub struct {
@@ -20,6 +22,7 @@ type clientStub struct {
 	capturedObjectType client.Object
 
 	partialObjectMetadata []apimetav1.PartialObjectMetadata
+	manifests             []v1beta2.Manifest
 }
 
 func (c *clientStub) DeleteAllOf(_ context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
@@ -57,6 +60,9 @@ func (c *clientStub) List(_ context.Context, list client.ObjectList, opts ...cli
 	if partialList, ok := list.(*apimetav1.PartialObjectMetadataList); ok {
 		partialList.Items = c.partialObjectMetadata
 	}
+	if manifestList, ok := list.(*v1beta2.ManifestList); ok {
+		manifestList.Items = c.manifests
+	}
 
 	return nil
 }
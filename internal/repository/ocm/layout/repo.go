@@ -0,0 +1,104 @@
+package layout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"ocm.software/ocm/api/ocm/extensions/repositories/genericocireg/componentmapping"
+)
+
+// RefNameAnnotation is the annotation of the image index entries that holds the reference of the image.
+const RefNameAnnotation = "org.opencontainers.image.ref.name"
+
+var ErrComponentNotFound = errors.New("component version not found in OCI layout")
+
+// RepositoryReader provides basic support to read OCM data from an OCI image layout directory.
+// The component versions are identified by the reference name annotation of the image index entries,
+// which is either the tag, or the repository and tag of the component version, for example
+// "component-descriptors/kyma-project.io/module/template-operator:1.0.0".
+type RepositoryReader struct {
+	layoutPath layout.Path
+}
+
+// NewRepository creates a new RepositoryReader for the OCI image layout in the given directory.
+func NewRepository(dir string) (*RepositoryReader, error) {
+	layoutPath, err := layout.FromPath(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI layout from %q: %w", dir, err)
+	}
+	return &RepositoryReader{layoutPath: layoutPath}, nil
+}
+
+// GetConfig retrieves the config file as a byte slice for the OCM artifact.
+func (r *RepositoryReader) GetConfig(_ context.Context, name, tag string) ([]byte, error) {
+	image, err := r.image(name, tag)
+	if err != nil {
+		return nil, err
+	}
+	configBytes, err := image.RawConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config file for %s:%s: %w", name, tag, err)
+	}
+	return configBytes, nil
+}
+
+// PullLayer retrieves a layer with given digest from the OCM artifact identified by name and tag.
+func (r *RepositoryReader) PullLayer(_ context.Context, name, tag, digest string) (containerregistryv1.Layer, error) {
+	image, err := r.image(name, tag)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := containerregistryv1.NewHash(digest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest %q: %w", digest, err)
+	}
+	layer, err := image.LayerByDigest(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get layer %s of %s:%s: %w", digest, name, tag, err)
+	}
+	return layer, nil
+}
+
+// Blob retrieves the content of the blob with the given digest.
+func (r *RepositoryReader) Blob(digest string) (io.ReadCloser, error) {
+	hash, err := containerregistryv1.NewHash(digest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest %q: %w", digest, err)
+	}
+	blob, err := r.layoutPath.Blob(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob %s: %w", digest, err)
+	}
+	return blob, nil
+}
+
+func (r *RepositoryReader) image(name, tag string) (containerregistryv1.Image, error) {
+	index, err := r.layoutPath.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image index: %w", err)
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image index manifest: %w", err)
+	}
+
+	ref := path.Join(componentmapping.ComponentDescriptorNamespace, name) + ":" + tag
+	for _, descriptor := range indexManifest.Manifests {
+		refName := descriptor.Annotations[RefNameAnnotation]
+		if refName != tag && refName != ref && !strings.HasSuffix(refName, "/"+ref) {
+			continue
+		}
+		image, err := index.Image(descriptor.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read image for %s: %w", ref, err)
+		}
+		return image, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrComponentNotFound, ref)
+}
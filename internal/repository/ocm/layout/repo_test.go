@@ -0,0 +1,111 @@
+package layout_test
+
+import (
+	"io"
+	"testing"
+
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	containerregistrylayout "github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/layout"
+)
+
+const (
+	componentName    = "kyma-project.io/module/template-operator"
+	componentVersion = "1.0.0"
+)
+
+func TestGetConfig_WhenComponentVersionExists_ReturnsConfig(t *testing.T) {
+	image, dir := writeLayout(t, "component-descriptors/"+componentName+":"+componentVersion)
+	repository, err := layout.NewRepository(dir)
+	require.NoError(t, err)
+
+	config, err := repository.GetConfig(t.Context(), componentName, componentVersion)
+
+	require.NoError(t, err)
+	expectedConfig, err := image.RawConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, expectedConfig, config)
+}
+
+func TestGetConfig_WhenRefNameIsTag_ReturnsConfig(t *testing.T) {
+	_, dir := writeLayout(t, componentVersion)
+	repository, err := layout.NewRepository(dir)
+	require.NoError(t, err)
+
+	_, err = repository.GetConfig(t.Context(), componentName, componentVersion)
+
+	require.NoError(t, err)
+}
+
+func TestGetConfig_WhenComponentVersionDoesNotExist_ReturnsError(t *testing.T) {
+	_, dir := writeLayout(t, "component-descriptors/"+componentName+":"+componentVersion)
+	repository, err := layout.NewRepository(dir)
+	require.NoError(t, err)
+
+	_, err = repository.GetConfig(t.Context(), componentName, "2.0.0")
+
+	require.ErrorIs(t, err, layout.ErrComponentNotFound)
+}
+
+func TestPullLayer_ReturnsLayerWithDigest(t *testing.T) {
+	image, dir := writeLayout(t, "registry.localhost/component-descriptors/"+componentName+":"+componentVersion)
+	repository, err := layout.NewRepository(dir)
+	require.NoError(t, err)
+	layers, err := image.Layers()
+	require.NoError(t, err)
+	digest, err := layers[0].Digest()
+	require.NoError(t, err)
+
+	layer, err := repository.PullLayer(t.Context(), componentName, componentVersion, digest.String())
+
+	require.NoError(t, err)
+	actualDigest, err := layer.Digest()
+	require.NoError(t, err)
+	assert.Equal(t, digest, actualDigest)
+}
+
+func TestBlob_ReturnsBlobContent(t *testing.T) {
+	image, dir := writeLayout(t, componentVersion)
+	repository, err := layout.NewRepository(dir)
+	require.NoError(t, err)
+	layers, err := image.Layers()
+	require.NoError(t, err)
+	digest, err := layers[0].Digest()
+	require.NoError(t, err)
+
+	blob, err := repository.Blob(digest.String())
+
+	require.NoError(t, err)
+	defer blob.Close()
+	content, err := io.ReadAll(blob)
+	require.NoError(t, err)
+	expectedBlob, err := layers[0].Compressed()
+	require.NoError(t, err)
+	defer expectedBlob.Close()
+	expectedContent, err := io.ReadAll(expectedBlob)
+	require.NoError(t, err)
+	assert.Equal(t, expectedContent, content)
+}
+
+func TestNewRepository_WhenDirectoryIsNoLayout_ReturnsError(t *testing.T) {
+	_, err := layout.NewRepository(t.TempDir())
+
+	require.Error(t, err)
+}
+
+func writeLayout(t *testing.T, refName string) (containerregistryv1.Image, string) {
+	t.Helper()
+	dir := t.TempDir()
+	layoutPath, err := containerregistrylayout.Write(dir, empty.Index)
+	require.NoError(t, err)
+	image, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, layoutPath.AppendImage(image,
+		containerregistrylayout.WithAnnotations(map[string]string{layout.RefNameAnnotation: refName})))
+	return image, dir
+}
@@ -0,0 +1,342 @@
+package certificate
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/config"
+	certerror "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/errors"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// CertificateManagement is the value of the cert-management flag that selects this repository.
+const CertificateManagement = "native"
+
+const (
+	caCertKey        = "ca.crt"
+	serialNumberBits = 128
+	// certificateBackdate is subtracted from the issuance time to tolerate clock skew between the clusters.
+	certificateBackdate = 5 * time.Minute
+)
+
+var (
+	ErrNativeRepoConfigKeySize  = errors.New("KeySize must be at least 2048 bits")
+	ErrCASecretNotFound         = errors.New("CA secret not found")
+	ErrInvalidCASecret          = errors.New("CA secret does not contain a valid CA key pair")
+	ErrInvalidCertificateSecret = errors.New("certificate secret does not contain a valid certificate")
+)
+
+const minKeySize = 2048
+
+// GetCacheObjects returns a list of objects that need to be cached for this client.
+// The repository only uses Secrets, which are cached anyway.
+func GetCacheObjects() []client.Object {
+	return []client.Object{}
+}
+
+// VerifyCASecret checks that the CA secret exists and contains a valid CA key pair. The repository does not create
+// the CA secret, so it is verified at startup rather than failing to issue the first certificate.
+func VerifyCASecret(ctx context.Context, reader client.Reader, caSecretName, caSecretNamespace string) error {
+	secret := &apicorev1.Secret{}
+	err := reader.Get(ctx, client.ObjectKey{Name: caSecretName, Namespace: caSecretNamespace}, secret)
+	if util.IsNotFound(err) {
+		return fmt.Errorf("%w: create the Secret %s in the namespace %s with the CA certificate and key "+
+			"to use the %s certificate management", ErrCASecretNotFound, caSecretName, caSecretNamespace,
+			CertificateManagement)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get CA secret %s-%s: %w", caSecretName, caSecretNamespace, err)
+	}
+
+	_, _, err = parseCA(secret)
+	return err
+}
+
+// Repository issues the certificates in-process. It signs them with the CA key pair of the CA secret and stores
+// them in Secrets with the name of the certificate, in the same format as cert-manager does.
+type Repository struct {
+	kcpClient         client.Client
+	caSecretName      string
+	caSecretNamespace string
+	certConfig        config.CertificateValues
+}
+
+func NewRepository(kcpClient client.Client,
+	caSecretName string,
+	caSecretNamespace string,
+	certConfig config.CertificateValues,
+) (*Repository, error) {
+	if certConfig.KeySize < minKeySize {
+		return nil, ErrNativeRepoConfigKeySize
+	}
+
+	if certConfig.Namespace == "" {
+		return nil, certerror.ErrCertRepoConfigNamespace
+	}
+
+	return &Repository{
+		kcpClient,
+		caSecretName,
+		caSecretNamespace,
+		certConfig,
+	}, nil
+}
+
+// Create issues the certificate unless the Secret already contains a certificate for the common name and the
+// DNS names that is signed by the current CA and is not due for renewal.
+func (r *Repository) Create(ctx context.Context, name, commonName string, dnsNames []string) error {
+	caCert, caKey, err := r.getCA(ctx)
+	if err != nil {
+		return err
+	}
+
+	cert, err := r.getCertificate(ctx, name)
+	if client.IgnoreNotFound(err) != nil && !errors.Is(err, ErrInvalidCertificateSecret) {
+		return err
+	}
+	if err == nil && isUpToDate(cert, caCert, commonName, dnsNames, r.certConfig) {
+		return nil
+	}
+
+	return r.issue(ctx, name, commonName, dnsNames, caCert, caKey)
+}
+
+func (r *Repository) Delete(ctx context.Context, name string) error {
+	secret := &apicorev1.Secret{}
+	secret.SetName(name)
+	secret.SetNamespace(r.certConfig.Namespace)
+
+	if err := r.kcpClient.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete certificate secret %s-%s: %w", name, r.certConfig.Namespace, err)
+	}
+
+	return nil
+}
+
+// Renew issues the certificate again for the common name and the DNS names of the current certificate.
+func (r *Repository) Renew(ctx context.Context, name string) error {
+	cert, err := r.getCertificate(ctx, name)
+	if err != nil {
+		return fmt.Errorf("could not get certificate for renewal: %w", err)
+	}
+
+	caCert, caKey, err := r.getCA(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.issue(ctx, name, cert.Subject.CommonName, cert.DNSNames, caCert, caKey)
+}
+
+func (r *Repository) Exists(ctx context.Context, name string) (bool, error) {
+	secret := &apicorev1.Secret{}
+	err := r.kcpClient.Get(ctx, client.ObjectKey{Name: name, Namespace: r.certConfig.Namespace}, secret)
+	if err != nil {
+		if util.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to check existence of certificate %s-%s: %w", name, r.certConfig.Namespace,
+				err)
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// GetRenewalTime returns the expiration date of the certificate minus the renewal time.
+func (r *Repository) GetRenewalTime(ctx context.Context, name string) (time.Time, error) {
+	cert, err := r.getCertificate(ctx, name)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get certificate %s-%s: %w", name, r.certConfig.Namespace, err)
+	}
+
+	return cert.NotAfter.Add(-r.certConfig.RenewBefore), nil
+}
+
+// GetValidity returns the validity of the certificate. The CA certificate, which is referred to by the name of
+// the cert-manager Certificate issuing it in the other implementations, is read from the CA secret.
+func (r *Repository) GetValidity(ctx context.Context, name string) (time.Time, time.Time, error) {
+	if name == shared.CACertificateName {
+		caCert, _, err := r.getCA(ctx)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return caCert.NotBefore, caCert.NotAfter, nil
+	}
+
+	cert, err := r.getCertificate(ctx, name)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf(
+			"failed to get certificate %s/%s: %w",
+			r.certConfig.Namespace,
+			name,
+			err,
+		)
+	}
+
+	return cert.NotBefore, cert.NotAfter, nil
+}
+
+func (r *Repository) issue(ctx context.Context, name, commonName string, dnsNames []string,
+	caCert *x509.Certificate, caKey any,
+) error {
+	certPEM, keyPEM, err := r.newCertificate(commonName, dnsNames, caCert, caKey)
+	if err != nil {
+		return fmt.Errorf("failed to issue certificate %s-%s: %w", name, r.certConfig.Namespace, err)
+	}
+
+	secret := &apicorev1.Secret{
+		TypeMeta: apimetav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: apicorev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      name,
+			Namespace: r.certConfig.Namespace,
+			Labels:    certificate.GetCertificateLabels(),
+		},
+		Type: apicorev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			apicorev1.TLSCertKey:       certPEM,
+			apicorev1.TLSPrivateKeyKey: keyPEM,
+			caCertKey:                  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}),
+		},
+	}
+
+	err = r.kcpClient.Patch(ctx,
+		secret,
+		//nolint: staticcheck // issues: #2706, #2707
+		client.Apply,
+		client.ForceOwnership,
+		fieldowners.LifecycleManager,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to patch certificate secret: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) newCertificate(commonName string, dnsNames []string, caCert *x509.Certificate, caKey any,
+) ([]byte, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, r.certConfig.KeySize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName:         commonName,
+			OrganizationalUnit: []string{certificate.DefaultOrganizationalUnit},
+			Organization:       []string{certificate.DefaultOrganization},
+			Locality:           []string{certificate.DefaultLocality},
+			Province:           []string{certificate.DefaultProvince},
+			Country:            []string{certificate.DefaultCountry},
+		},
+		DNSNames:    dnsNames,
+		NotBefore:   now.Add(-certificateBackdate),
+		NotAfter:    now.Add(r.certConfig.Duration),
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign certificate: %w", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return certPEM, keyPEM, nil
+}
+
+func (r *Repository) getCA(ctx context.Context) (*x509.Certificate, any, error) {
+	secret := &apicorev1.Secret{}
+	err := r.kcpClient.Get(ctx, client.ObjectKey{Name: r.caSecretName, Namespace: r.caSecretNamespace}, secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get CA secret %s-%s: %w", r.caSecretName, r.caSecretNamespace, err)
+	}
+
+	return parseCA(secret)
+}
+
+func parseCA(secret *apicorev1.Secret) (*x509.Certificate, any, error) {
+	keyPair, err := tls.X509KeyPair(secret.Data[apicorev1.TLSCertKey], secret.Data[apicorev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, nil, errors.Join(ErrInvalidCASecret, err)
+	}
+	caCert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, nil, errors.Join(ErrInvalidCASecret, err)
+	}
+	if !caCert.IsCA {
+		return nil, nil, fmt.Errorf("%w: certificate is not a CA", ErrInvalidCASecret)
+	}
+
+	return caCert, keyPair.PrivateKey, nil
+}
+
+func (r *Repository) getCertificate(ctx context.Context, name string) (*x509.Certificate, error) {
+	secret := &apicorev1.Secret{}
+	err := r.kcpClient.Get(ctx, client.ObjectKey{Name: name, Namespace: r.certConfig.Namespace}, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate secret %s-%s: %w", name, r.certConfig.Namespace, err)
+	}
+
+	block, _ := pem.Decode(secret.Data[apicorev1.TLSCertKey])
+	if block == nil {
+		return nil, fmt.Errorf("%w: %s-%s", ErrInvalidCertificateSecret, name, r.certConfig.Namespace)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidCertificateSecret, err)
+	}
+
+	return cert, nil
+}
+
+// isUpToDate reports whether the certificate matches the requested subject and the configuration and is signed
+// by the CA certificate.
+func isUpToDate(cert, caCert *x509.Certificate, commonName string, dnsNames []string,
+	certConfig config.CertificateValues,
+) bool {
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok || publicKey.N.BitLen() != certConfig.KeySize {
+		return false
+	}
+	if cert.CheckSignatureFrom(caCert) != nil {
+		return false
+	}
+	if time.Now().After(cert.NotAfter.Add(-certConfig.RenewBefore)) {
+		return false
+	}
+	return cert.Subject.CommonName == commonName && sameElements(cert.DNSNames, dnsNames)
+}
+
+func sameElements(actual, expected []string) bool {
+	actual = slices.Sorted(slices.Values(actual))
+	expected = slices.Sorted(slices.Values(expected))
+	return slices.Equal(slices.Compact(actual), slices.Compact(expected))
+}
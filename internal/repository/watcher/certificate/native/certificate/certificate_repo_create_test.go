@@ -0,0 +1,91 @@
+package certificate_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+
+	"github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate"
+	nativecertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/native/certificate" //nolint:revive // not for import
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/random"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+func TestCreate_IssuesCertificateSignedByCA(t *testing.T) {
+	caCert, caSecret := newCA(t)
+	clnt := fakeClient(caSecret)
+	certRepository := newRepository(t, clnt)
+
+	err := certRepository.Create(t.Context(), certName, certCommonName, certDNSNames)
+
+	require.NoError(t, err)
+	secret := getCertificateSecret(t, clnt)
+	assert.Equal(t, apicorev1.SecretTypeTLS, secret.Type)
+	assert.Equal(t, map[string]string(certificate.GetCertificateLabels()), secret.GetLabels())
+	assert.Equal(t, caSecret.Data[apicorev1.TLSCertKey], secret.Data["ca.crt"])
+	cert := parseCertificate(t, secret.Data[apicorev1.TLSCertKey])
+	require.NoError(t, cert.CheckSignatureFrom(caCert))
+	assert.Equal(t, certCommonName, cert.Subject.CommonName)
+	assert.Equal(t, []string{certificate.DefaultOrganization}, cert.Subject.Organization)
+	assert.Equal(t, certDNSNames, cert.DNSNames)
+	assert.WithinDuration(t, time.Now().Add(certDuration), cert.NotAfter, time.Minute)
+	block, _ := pem.Decode(secret.Data[apicorev1.TLSPrivateKeyKey])
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, certKeySize, key.N.BitLen())
+}
+
+func TestCreate_WhenCertificateIsUpToDate_KeepsCertificate(t *testing.T) {
+	_, caSecret := newCA(t)
+	clnt := fakeClient(caSecret)
+	certRepository := newRepository(t, clnt)
+	require.NoError(t, certRepository.Create(t.Context(), certName, certCommonName, certDNSNames))
+	issued := getCertificateSecret(t, clnt).Data[apicorev1.TLSCertKey]
+
+	err := certRepository.Create(t.Context(), certName, certCommonName, []string{certDNSNames[1], certDNSNames[0]})
+
+	require.NoError(t, err)
+	assert.Equal(t, issued, getCertificateSecret(t, clnt).Data[apicorev1.TLSCertKey])
+}
+
+func TestCreate_WhenDNSNamesChanged_IssuesCertificate(t *testing.T) {
+	_, caSecret := newCA(t)
+	clnt := fakeClient(caSecret)
+	certRepository := newRepository(t, clnt)
+	require.NoError(t, certRepository.Create(t.Context(), certName, certCommonName, certDNSNames))
+	dnsNames := append([]string{random.Name()}, certDNSNames...)
+
+	err := certRepository.Create(t.Context(), certName, certCommonName, dnsNames)
+
+	require.NoError(t, err)
+	cert := parseCertificate(t, getCertificateSecret(t, clnt).Data[apicorev1.TLSCertKey])
+	assert.Equal(t, dnsNames, cert.DNSNames)
+}
+
+func TestCreate_WhenCASecretIsMissing_Error(t *testing.T) {
+	certRepository := newRepository(t, fakeClient())
+
+	err := certRepository.Create(t.Context(), certName, certCommonName, certDNSNames)
+
+	require.Error(t, err)
+	assert.True(t, util.IsNotFound(err))
+}
+
+func TestCreate_WhenCASecretIsNoCA_Error(t *testing.T) {
+	_, caSecret := newCA(t)
+	clnt := fakeClient(caSecret)
+	certRepository := newRepository(t, clnt)
+	require.NoError(t, certRepository.Create(t.Context(), certName, certCommonName, certDNSNames))
+	leafSecret := getCertificateSecret(t, clnt)
+	caSecret.Data = leafSecret.Data
+	require.NoError(t, clnt.Update(t.Context(), caSecret))
+
+	err := certRepository.Create(t.Context(), certName, certCommonName, certDNSNames)
+
+	require.ErrorIs(t, err, nativecertificate.ErrInvalidCASecret)
+}
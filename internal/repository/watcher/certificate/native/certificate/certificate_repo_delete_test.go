@@ -0,0 +1,33 @@
+package certificate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+func TestDelete_RemovesCertificateSecret(t *testing.T) {
+	_, caSecret := newCA(t)
+	clnt := fakeClient(caSecret)
+	certRepository := newRepository(t, clnt)
+	require.NoError(t, certRepository.Create(t.Context(), certName, certCommonName, certDNSNames))
+
+	err := certRepository.Delete(t.Context(), certName)
+
+	require.NoError(t, err)
+	err = clnt.Get(t.Context(), client.ObjectKey{Name: certName, Namespace: certNamespace}, &apicorev1.Secret{})
+	assert.True(t, util.IsNotFound(err))
+}
+
+func TestDelete_WhenCertificateSecretIsMissing_Succeeds(t *testing.T) {
+	certRepository := newRepository(t, fakeClient())
+
+	err := certRepository.Delete(t.Context(), certName)
+
+	require.NoError(t, err)
+}
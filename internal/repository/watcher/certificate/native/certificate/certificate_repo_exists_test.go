@@ -0,0 +1,28 @@
+package certificate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExists_WhenCertificateSecretExists_ReturnsTrue(t *testing.T) {
+	_, caSecret := newCA(t)
+	certRepository := newRepository(t, fakeClient(caSecret))
+	require.NoError(t, certRepository.Create(t.Context(), certName, certCommonName, certDNSNames))
+
+	exists, err := certRepository.Exists(t.Context(), certName)
+
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestExists_WhenCertificateSecretIsMissing_ReturnsFalse(t *testing.T) {
+	certRepository := newRepository(t, fakeClient())
+
+	exists, err := certRepository.Exists(t.Context(), certName)
+
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,49 @@
+package certificate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+func TestGetValidity_ReturnsValidityOfCertificate(t *testing.T) {
+	_, caSecret := newCA(t)
+	clnt := fakeClient(caSecret)
+	certRepository := newRepository(t, clnt)
+	require.NoError(t, certRepository.Create(t.Context(), certName, certCommonName, certDNSNames))
+	cert := parseCertificate(t, getCertificateSecret(t, clnt).Data[apicorev1.TLSCertKey])
+
+	notBefore, notAfter, err := certRepository.GetValidity(t.Context(), certName)
+
+	require.NoError(t, err)
+	assert.Equal(t, cert.NotBefore, notBefore)
+	assert.Equal(t, cert.NotAfter, notAfter)
+}
+
+func TestGetValidity_ForCACertificate_ReturnsValidityOfCA(t *testing.T) {
+	caCert, caSecret := newCA(t)
+	certRepository := newRepository(t, fakeClient(caSecret))
+
+	notBefore, notAfter, err := certRepository.GetValidity(t.Context(), shared.CACertificateName)
+
+	require.NoError(t, err)
+	assert.Equal(t, caCert.NotBefore, notBefore)
+	assert.Equal(t, caCert.NotAfter, notAfter)
+}
+
+func TestGetRenewalTime_ReturnsExpirationMinusRenewBefore(t *testing.T) {
+	_, caSecret := newCA(t)
+	clnt := fakeClient(caSecret)
+	certRepository := newRepository(t, clnt)
+	require.NoError(t, certRepository.Create(t.Context(), certName, certCommonName, certDNSNames))
+	cert := parseCertificate(t, getCertificateSecret(t, clnt).Data[apicorev1.TLSCertKey])
+
+	renewalTime, err := certRepository.GetRenewalTime(t.Context(), certName)
+
+	require.NoError(t, err)
+	assert.Equal(t, cert.NotAfter.Add(-certRenewBefore), renewalTime)
+}
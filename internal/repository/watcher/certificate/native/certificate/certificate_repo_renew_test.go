@@ -0,0 +1,25 @@
+package certificate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+)
+
+func TestRenew_IssuesCertificateForSameSubject(t *testing.T) {
+	_, caSecret := newCA(t)
+	clnt := fakeClient(caSecret)
+	certRepository := newRepository(t, clnt)
+	require.NoError(t, certRepository.Create(t.Context(), certName, certCommonName, certDNSNames))
+	issued := parseCertificate(t, getCertificateSecret(t, clnt).Data[apicorev1.TLSCertKey])
+
+	err := certRepository.Renew(t.Context(), certName)
+
+	require.NoError(t, err)
+	renewed := parseCertificate(t, getCertificateSecret(t, clnt).Data[apicorev1.TLSCertKey])
+	assert.NotEqual(t, issued.SerialNumber, renewed.SerialNumber)
+	assert.Equal(t, issued.Subject.CommonName, renewed.Subject.CommonName)
+	assert.Equal(t, issued.DNSNames, renewed.DNSNames)
+}
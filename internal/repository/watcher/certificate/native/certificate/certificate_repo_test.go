@@ -0,0 +1,150 @@
+package certificate_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	machineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	k8sclientscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/config"
+	certerror "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/errors"
+	nativecertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/native/certificate" //nolint:revive // not for import
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/random"
+)
+
+const (
+	caSecretName    = "klm-watcher"
+	certKeySize     = 2048
+	certDuration    = 24 * time.Hour
+	certRenewBefore = 12 * time.Hour
+)
+
+var (
+	caSecretNamespace = random.Name()
+	certName          = random.Name()
+	certNamespace     = random.Name()
+	certCommonName    = random.Name()
+	certDNSNames      = []string{random.Name(), random.Name()}
+)
+
+func Test_GetCacheObjects(t *testing.T) {
+	assert.Empty(t, nativecertificate.GetCacheObjects())
+}
+
+func TestVerifyCASecret_WhenCASecretIsValid_Succeeds(t *testing.T) {
+	_, caSecret := newCA(t)
+
+	err := nativecertificate.VerifyCASecret(t.Context(), fakeClient(caSecret), caSecretName, caSecretNamespace)
+
+	require.NoError(t, err)
+}
+
+func TestVerifyCASecret_WhenCASecretIsMissing_ErrorNamesSecret(t *testing.T) {
+	err := nativecertificate.VerifyCASecret(t.Context(), fakeClient(), caSecretName, caSecretNamespace)
+
+	require.ErrorIs(t, err, nativecertificate.ErrCASecretNotFound)
+	assert.Contains(t, err.Error(), caSecretName)
+	assert.Contains(t, err.Error(), caSecretNamespace)
+}
+
+func TestVerifyCASecret_WhenCASecretIsNoCA_Error(t *testing.T) {
+	_, caSecret := newCA(t)
+	caSecret.Data[apicorev1.TLSPrivateKeyKey] = nil
+
+	err := nativecertificate.VerifyCASecret(t.Context(), fakeClient(caSecret), caSecretName, caSecretNamespace)
+
+	require.ErrorIs(t, err, nativecertificate.ErrInvalidCASecret)
+}
+
+func TestNew_KeySize_Error(t *testing.T) {
+	certRepository, err := nativecertificate.NewRepository(nil, caSecretName, caSecretNamespace,
+		config.CertificateValues{KeySize: 1024, Namespace: certNamespace})
+
+	require.ErrorIs(t, err, nativecertificate.ErrNativeRepoConfigKeySize)
+	assert.Nil(t, certRepository)
+}
+
+func TestNew_Namespace_Error(t *testing.T) {
+	certRepository, err := nativecertificate.NewRepository(nil, caSecretName, caSecretNamespace,
+		config.CertificateValues{KeySize: certKeySize})
+
+	require.ErrorIs(t, err, certerror.ErrCertRepoConfigNamespace)
+	assert.Nil(t, certRepository)
+}
+
+func newRepository(t *testing.T, clnt client.Client) *nativecertificate.Repository {
+	t.Helper()
+	certRepository, err := nativecertificate.NewRepository(clnt, caSecretName, caSecretNamespace,
+		config.CertificateValues{
+			Duration:    certDuration,
+			RenewBefore: certRenewBefore,
+			KeySize:     certKeySize,
+			Namespace:   certNamespace,
+		})
+	require.NoError(t, err)
+	return certRepository
+}
+
+func newCA(t *testing.T) (*x509.Certificate, *apicorev1.Secret) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, certKeySize)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "klm-watcher-selfsigned-ca"},
+		NotBefore:             time.Now().Add(-time.Hour).Truncate(time.Second),
+		NotAfter:              time.Now().Add(90 * 24 * time.Hour).Truncate(time.Second),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	return caCert, &apicorev1.Secret{
+		ObjectMeta: apimetav1.ObjectMeta{Name: caSecretName, Namespace: caSecretNamespace},
+		Data: map[string][]byte{
+			apicorev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+			apicorev1.TLSPrivateKeyKey: pem.EncodeToMemory(
+				&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		},
+	}
+}
+
+func getCertificateSecret(t *testing.T, clnt client.Client) *apicorev1.Secret {
+	t.Helper()
+	secret := &apicorev1.Secret{}
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKey{Name: certName, Namespace: certNamespace}, secret))
+	return secret
+}
+
+func parseCertificate(t *testing.T, certPEM []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(certPEM)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func fakeClient(objs ...client.Object) client.Client {
+	scheme := machineryruntime.NewScheme()
+	machineryutilruntime.Must(k8sclientscheme.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
@@ -0,0 +1,31 @@
+package routing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// Backend identifies the API that routes the events of the runtime watcher in the KCP to the managers of the
+// Watchers.
+type Backend string
+
+const (
+	// BackendIstio routes the events with Istio VirtualServices bound to Istio Gateways.
+	BackendIstio Backend = "istio"
+	// BackendGatewayAPI routes the events with Gateway API HTTPRoutes attached to Gateway API Gateways.
+	BackendGatewayAPI Backend = "gateway-api"
+)
+
+var ErrGatewayNotFound = errors.New("gateway for the Watcher route not found")
+
+const (
+	contractVersion = "v2"
+	prefixFormat    = "/%s/%s/event"
+)
+
+// PathPrefix returns the path prefix of the events that are routed to the manager of the Watcher.
+func PathPrefix(watcher *v1beta2.Watcher) string {
+	return fmt.Sprintf(prefixFormat, contractVersion, watcher.GetManagerName())
+}
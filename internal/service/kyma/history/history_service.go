@@ -0,0 +1,204 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+type Service struct {
+	client     client.Client
+	maxEntries int
+	now        func() time.Time
+}
+
+// NewService creates a Service that keeps up to maxEntries version transitions per module.
+// A maxEntries of zero disables the recording.
+func NewService(client client.Client, maxEntries int) *Service {
+	return &Service{
+		client:     client,
+		maxEntries: maxEntries,
+		now:        time.Now,
+	}
+}
+
+// Record compares the module statuses of the Kyma with the statuses before they were updated and records
+// each version transition in the ModuleVersionHistory of the Kyma. A change of the state of a module
+// without a version change updates the state of its latest transition.
+// The ModuleVersionHistory is only read and written if a module version or state changed.
+func (s *Service) Record(ctx context.Context, kyma *v1beta2.Kyma, previousStatuses []v1beta2.ModuleStatus) error {
+	if s.maxEntries <= 0 {
+		return nil
+	}
+
+	changes := detectChanges(kyma.Status.Modules, previousStatuses)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	history, err := s.getOrCreate(ctx, kyma)
+	if err != nil {
+		return err
+	}
+
+	timestamp := apimetav1.NewTime(s.now())
+	updated := false
+	for _, change := range changes {
+		if s.apply(history, change, timestamp) {
+			updated = true
+		}
+	}
+	if !updated {
+		return nil
+	}
+
+	if err := s.client.Status().Update(ctx, history); err != nil {
+		return fmt.Errorf("failed to update module version history %s/%s: %w",
+			history.Namespace, history.Name, err)
+	}
+	return nil
+}
+
+func (s *Service) getOrCreate(ctx context.Context, kyma *v1beta2.Kyma) (*v1beta2.ModuleVersionHistory, error) {
+	history := &v1beta2.ModuleVersionHistory{}
+	err := s.client.Get(ctx, client.ObjectKey{Namespace: kyma.Namespace, Name: kyma.Name}, history)
+	if err == nil {
+		return history, nil
+	}
+	if !util.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get module version history %s/%s: %w", kyma.Namespace, kyma.Name, err)
+	}
+
+	history = &v1beta2.ModuleVersionHistory{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      kyma.Name,
+			Namespace: kyma.Namespace,
+		},
+	}
+	if err := controllerutil.SetOwnerReference(kyma, history, s.client.Scheme()); err != nil {
+		return nil, fmt.Errorf("failed to set owner of module version history %s/%s: %w",
+			kyma.Namespace, kyma.Name, err)
+	}
+	if err := s.client.Create(ctx, history); err != nil {
+		return nil, fmt.Errorf("failed to create module version history %s/%s: %w", kyma.Namespace, kyma.Name, err)
+	}
+	return history, nil
+}
+
+// apply adds the change to the history and reports whether the history was modified.
+func (s *Service) apply(history *v1beta2.ModuleVersionHistory, change change,
+	timestamp apimetav1.Time,
+) bool {
+	module := findOrAddModule(history, change.status.Name)
+	var latest *v1beta2.ModuleVersionTransition
+	if len(module.Transitions) > 0 {
+		latest = &module.Transitions[len(module.Transitions)-1]
+	}
+
+	// A transition is recorded only once, even if the Kyma status could not be updated after recording it.
+	if latest != nil && latest.Version == change.status.Version &&
+		(change.trigger == "" || latest.PreviousVersion == change.previousVersion) {
+		if latest.State == change.status.State {
+			return false
+		}
+		latest.State = change.status.State
+		return true
+	}
+	if change.trigger == "" {
+		return false
+	}
+
+	transition := v1beta2.ModuleVersionTransition{
+		PreviousVersion: change.previousVersion,
+		Version:         change.status.Version,
+		Channel:         change.status.Channel,
+		Timestamp:       timestamp,
+		Trigger:         change.trigger,
+		State:           change.status.State,
+	}
+	if change.status.Template != nil {
+		transition.TemplateGeneration = change.status.Template.Generation
+	}
+	module.Transitions = append(module.Transitions, transition)
+	if overflow := len(module.Transitions) - s.maxEntries; overflow > 0 {
+		module.Transitions = slices.Delete(module.Transitions, 0, overflow)
+	}
+	return true
+}
+
+func findOrAddModule(history *v1beta2.ModuleVersionHistory, name string) *v1beta2.ModuleVersionHistoryModule {
+	idx, found := slices.BinarySearchFunc(history.Status.Modules, name,
+		func(module v1beta2.ModuleVersionHistoryModule, name string) int {
+			return strings.Compare(module.Name, name)
+		})
+	if !found {
+		history.Status.Modules = slices.Insert(history.Status.Modules, idx,
+			v1beta2.ModuleVersionHistoryModule{Name: name})
+	}
+	return &history.Status.Modules[idx]
+}
+
+// change is a module whose version or state changed. The trigger is empty if only the state changed.
+type change struct {
+	status          *v1beta2.ModuleStatus
+	previousVersion string
+	trigger         v1beta2.ModuleVersionTransitionTrigger
+}
+
+func detectChanges(statuses, previousStatuses []v1beta2.ModuleStatus) []change {
+	var changes []change
+	for i := range statuses {
+		status := &statuses[i]
+		if status.Version == "" {
+			continue
+		}
+		previous := findStatus(previousStatuses, status.Name)
+		switch {
+		case previous == nil || previous.Version == "":
+			changes = append(changes, change{status: status, trigger: v1beta2.TransitionTriggerInstallation})
+		case previous.Version != status.Version:
+			changes = append(changes, change{
+				status:          status,
+				previousVersion: previous.Version,
+				trigger:         transitionTrigger(previous, status),
+			})
+		case previous.State != status.State:
+			changes = append(changes, change{status: status})
+		}
+	}
+	return changes
+}
+
+// transitionTrigger infers the cause of a version change from the module status before and after it.
+// A module waiting for a maintenance window is marked in its status, and a rolled back version is
+// recorded as failed version before the status is updated.
+func transitionTrigger(previous, status *v1beta2.ModuleStatus) v1beta2.ModuleVersionTransitionTrigger {
+	switch {
+	case slices.Contains(status.FailedVersions, previous.Version):
+		return v1beta2.TransitionTriggerRollback
+	case previous.Channel != status.Channel:
+		return v1beta2.TransitionTriggerChannelChange
+	case previous.Maintenance:
+		return v1beta2.TransitionTriggerMaintenanceWindow
+	default:
+		return v1beta2.TransitionTriggerModuleReleaseMetaUpdate
+	}
+}
+
+func findStatus(statuses []v1beta2.ModuleStatus, name string) *v1beta2.ModuleStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package history_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	machineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api"
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/history"
+)
+
+const (
+	kymaName      = "kyma-sample"
+	kymaNamespace = "kcp-system"
+)
+
+func TestRecord_WhenModuleInstalled_CreatesHistoryOwnedByKyma(t *testing.T) {
+	clnt := fakeClient()
+	service := history.NewService(clnt, 10)
+	kyma := newKyma(moduleStatus("module-a", "regular", "1.0.0", shared.StateProcessing))
+
+	require.NoError(t, service.Record(t.Context(), kyma, nil))
+
+	moduleHistory := getHistory(t, clnt)
+	require.Len(t, moduleHistory.OwnerReferences, 1)
+	assert.Equal(t, kymaName, moduleHistory.OwnerReferences[0].Name)
+	transitions := moduleTransitions(t, moduleHistory, "module-a")
+	require.Len(t, transitions, 1)
+	assert.Empty(t, transitions[0].PreviousVersion)
+	assert.Equal(t, "1.0.0", transitions[0].Version)
+	assert.Equal(t, "regular", transitions[0].Channel)
+	assert.Equal(t, int64(3), transitions[0].TemplateGeneration)
+	assert.Equal(t, v1beta2.TransitionTriggerInstallation, transitions[0].Trigger)
+	assert.Equal(t, shared.StateProcessing, transitions[0].State)
+	assert.False(t, transitions[0].Timestamp.IsZero())
+}
+
+func TestRecord_InfersTrigger(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous v1beta2.ModuleStatus
+		current  v1beta2.ModuleStatus
+		trigger  v1beta2.ModuleVersionTransitionTrigger
+	}{
+		{
+			name:     "version of channel changed",
+			previous: moduleStatus("module-a", "regular", "1.0.0", shared.StateReady),
+			current:  moduleStatus("module-a", "regular", "1.1.0", shared.StateProcessing),
+			trigger:  v1beta2.TransitionTriggerModuleReleaseMetaUpdate,
+		},
+		{
+			name:     "channel changed",
+			previous: moduleStatus("module-a", "regular", "1.0.0", shared.StateReady),
+			current:  moduleStatus("module-a", "fast", "1.1.0", shared.StateProcessing),
+			trigger:  v1beta2.TransitionTriggerChannelChange,
+		},
+		{
+			name: "maintenance window opened",
+			previous: func() v1beta2.ModuleStatus {
+				status := moduleStatus("module-a", "regular", "1.0.0", shared.StateReady)
+				status.Maintenance = true
+				return status
+			}(),
+			current: moduleStatus("module-a", "regular", "1.1.0", shared.StateProcessing),
+			trigger: v1beta2.TransitionTriggerMaintenanceWindow,
+		},
+		{
+			name:     "upgrade rolled back",
+			previous: moduleStatus("module-a", "regular", "1.1.0", shared.StateError),
+			current: func() v1beta2.ModuleStatus {
+				status := moduleStatus("module-a", "regular", "1.0.0", shared.StateWarning)
+				status.FailedVersions = []string{"1.1.0"}
+				return status
+			}(),
+			trigger: v1beta2.TransitionTriggerRollback,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			clnt := fakeClient()
+			service := history.NewService(clnt, 10)
+			kyma := newKyma(testCase.current)
+
+			require.NoError(t, service.Record(t.Context(), kyma, []v1beta2.ModuleStatus{testCase.previous}))
+
+			transitions := moduleTransitions(t, getHistory(t, clnt), "module-a")
+			require.Len(t, transitions, 1)
+			assert.Equal(t, testCase.previous.Version, transitions[0].PreviousVersion)
+			assert.Equal(t, testCase.current.Version, transitions[0].Version)
+			assert.Equal(t, testCase.trigger, transitions[0].Trigger)
+		})
+	}
+}
+
+func TestRecord_WhenStateChanged_UpdatesStateOfLatestTransition(t *testing.T) {
+	clnt := fakeClient()
+	service := history.NewService(clnt, 10)
+	previous := moduleStatus("module-a", "regular", "1.0.0", shared.StateProcessing)
+	require.NoError(t, service.Record(t.Context(), newKyma(previous), nil))
+
+	require.NoError(t, service.Record(t.Context(),
+		newKyma(moduleStatus("module-a", "regular", "1.0.0", shared.StateReady)),
+		[]v1beta2.ModuleStatus{previous}))
+
+	transitions := moduleTransitions(t, getHistory(t, clnt), "module-a")
+	require.Len(t, transitions, 1)
+	assert.Equal(t, shared.StateReady, transitions[0].State)
+}
+
+func TestRecord_WhenTransitionRecordedTwice_KeepsSingleEntry(t *testing.T) {
+	clnt := fakeClient()
+	service := history.NewService(clnt, 10)
+	previous := []v1beta2.ModuleStatus{moduleStatus("module-a", "regular", "1.0.0", shared.StateReady)}
+	kyma := newKyma(moduleStatus("module-a", "regular", "1.1.0", shared.StateProcessing))
+
+	require.NoError(t, service.Record(t.Context(), kyma, previous))
+	require.NoError(t, service.Record(t.Context(), kyma, previous))
+
+	assert.Len(t, moduleTransitions(t, getHistory(t, clnt), "module-a"), 1)
+}
+
+func TestRecord_WhenMaxEntriesReached_DropsOldestTransitions(t *testing.T) {
+	clnt := fakeClient()
+	service := history.NewService(clnt, 2)
+	versions := []string{"1.0.0", "1.1.0", "1.2.0", "1.3.0"}
+
+	var previous []v1beta2.ModuleStatus
+	for _, version := range versions {
+		kyma := newKyma(moduleStatus("module-a", "regular", version, shared.StateReady))
+		require.NoError(t, service.Record(t.Context(), kyma, previous))
+		previous = kyma.Status.Modules
+	}
+
+	transitions := moduleTransitions(t, getHistory(t, clnt), "module-a")
+	require.Len(t, transitions, 2)
+	assert.Equal(t, "1.2.0", transitions[0].Version)
+	assert.Equal(t, "1.3.0", transitions[1].Version)
+}
+
+func TestRecord_WhenNothingChanged_DoesNotCreateHistory(t *testing.T) {
+	clnt := fakeClient()
+	service := history.NewService(clnt, 10)
+	status := moduleStatus("module-a", "regular", "1.0.0", shared.StateReady)
+
+	require.NoError(t, service.Record(t.Context(), newKyma(status), []v1beta2.ModuleStatus{status}))
+
+	assertNoHistory(t, clnt)
+}
+
+func TestRecord_WhenDisabled_DoesNotCreateHistory(t *testing.T) {
+	clnt := fakeClient()
+	service := history.NewService(clnt, 0)
+
+	require.NoError(t, service.Record(t.Context(),
+		newKyma(moduleStatus("module-a", "regular", "1.0.0", shared.StateReady)), nil))
+
+	assertNoHistory(t, clnt)
+}
+
+func moduleStatus(name, channel, version string, state shared.State) v1beta2.ModuleStatus {
+	return v1beta2.ModuleStatus{
+		Name:    name,
+		Channel: channel,
+		Version: version,
+		State:   state,
+		Template: &v1beta2.TrackingObject{
+			PartialMeta: v1beta2.PartialMeta{Name: name + "-" + channel, Generation: 3},
+		},
+	}
+}
+
+func newKyma(statuses ...v1beta2.ModuleStatus) *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: kymaName, Namespace: kymaNamespace, UID: "kyma-uid"},
+		Status:     v1beta2.KymaStatus{Modules: statuses},
+	}
+}
+
+func getHistory(t *testing.T, clnt client.Client) *v1beta2.ModuleVersionHistory {
+	t.Helper()
+	moduleHistory := &v1beta2.ModuleVersionHistory{}
+	require.NoError(t, clnt.Get(t.Context(),
+		client.ObjectKey{Namespace: kymaNamespace, Name: kymaName}, moduleHistory))
+	return moduleHistory
+}
+
+func assertNoHistory(t *testing.T, clnt client.Client) {
+	t.Helper()
+	list := &v1beta2.ModuleVersionHistoryList{}
+	require.NoError(t, clnt.List(t.Context(), list))
+	assert.Empty(t, list.Items)
+}
+
+func moduleTransitions(t *testing.T, moduleHistory *v1beta2.ModuleVersionHistory,
+	name string,
+) []v1beta2.ModuleVersionTransition {
+	t.Helper()
+	for _, module := range moduleHistory.Status.Modules {
+		if module.Name == name {
+			return module.Transitions
+		}
+	}
+	require.Failf(t, "module not found in history", "module %s", name)
+	return nil
+}
+
+func fakeClient() client.Client {
+	scheme := machineryruntime.NewScheme()
+	machineryutilruntime.Must(api.AddToScheme(scheme))
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&v1beta2.ModuleVersionHistory{}).
+		Build()
+}
@@ -0,0 +1,82 @@
+package maintenancewindow
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+type MaintenanceWindow interface {
+	NextWindow(kyma *v1beta2.Kyma) (*resolver.ResolvedWindow, error)
+	ActiveBlackout(kyma *v1beta2.Kyma) (resolver.ResolvedWindow, bool)
+}
+
+type Service struct {
+	maintenanceWindow MaintenanceWindow
+}
+
+func NewService(maintenanceWindow MaintenanceWindow) *Service {
+	return &Service{
+		maintenanceWindow: maintenanceWindow,
+	}
+}
+
+// UpdateStatus sets the next maintenance window, the active blackout period, and the modules whose upgrade
+// is held back by them in the Kyma status. If the Kyma skips maintenance windows, only blackout periods
+// hold back upgrades. The status is cleared if there is nothing to report.
+func (s *Service) UpdateStatus(kyma *v1beta2.Kyma, modules modulecommon.Modules) {
+	status := &v1beta2.MaintenanceWindowStatus{
+		WaitingModules: waitingModules(kyma, modules),
+	}
+	if blackout, active := s.maintenanceWindow.ActiveBlackout(kyma); active {
+		status.ActiveBlackout = toStatusWindow(blackout)
+	}
+	// A policy that cannot be resolved is reported in the status of the modules requiring a maintenance window.
+	if !kyma.Spec.SkipMaintenanceWindows {
+		if window, err := s.maintenanceWindow.NextWindow(kyma); err == nil {
+			status.NextWindow = toStatusWindow(*window)
+		}
+	}
+
+	if status.NextWindow == nil && status.ActiveBlackout == nil && len(status.WaitingModules) == 0 {
+		kyma.Status.MaintenanceWindow = nil
+		return
+	}
+	kyma.Status.MaintenanceWindow = status
+}
+
+func toStatusWindow(window resolver.ResolvedWindow) *v1beta2.ResolvedMaintenanceWindow {
+	return &v1beta2.ResolvedMaintenanceWindow{
+		Begin: apimetav1.NewTime(window.Begin),
+		End:   apimetav1.NewTime(window.End),
+	}
+}
+
+func waitingModules(kyma *v1beta2.Kyma, modules modulecommon.Modules) []v1beta2.WaitingModule {
+	var waiting []v1beta2.WaitingModule
+	for _, module := range modules {
+		if module.TemplateInfo == nil ||
+			!errors.Is(module.TemplateInfo.Err, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow) {
+			continue
+		}
+		waitingModule := v1beta2.WaitingModule{
+			Name:          module.ModuleName,
+			TargetVersion: module.TemplateInfo.MaintenanceVersion,
+		}
+		if moduleStatus := kyma.Status.GetModuleStatus(module.ModuleName); moduleStatus != nil {
+			waitingModule.CurrentVersion = moduleStatus.Version
+		}
+		waiting = append(waiting, waitingModule)
+	}
+	slices.SortFunc(waiting, func(a, b v1beta2.WaitingModule) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return waiting
+}
@@ -0,0 +1,147 @@
+package maintenancewindow_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
+	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/maintenancewindow"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+var (
+	windowBegin = time.Date(2026, 1, 10, 2, 0, 0, 0, time.UTC)
+	windowEnd   = windowBegin.Add(4 * time.Hour)
+)
+
+func TestUpdateStatus_WithWaitingModules_SetsNextWindowAndWaitingModules(t *testing.T) {
+	service := maintenancewindow.NewService(&maintenanceWindowStub{})
+	kyma := &v1beta2.Kyma{Status: v1beta2.KymaStatus{Modules: []v1beta2.ModuleStatus{
+		{Name: "module-b", Version: "1.0.0"},
+		{Name: "module-a", Version: "2.0.0"},
+	}}}
+	modules := modulecommon.Modules{
+		newWaitingModule("module-b", "1.1.0"),
+		newModule("module-c", nil),
+		newWaitingModule("module-a", "3.0.0"),
+	}
+
+	service.UpdateStatus(kyma, modules)
+
+	require.NotNil(t, kyma.Status.MaintenanceWindow)
+	require.NotNil(t, kyma.Status.MaintenanceWindow.NextWindow)
+	assert.True(t, windowBegin.Equal(kyma.Status.MaintenanceWindow.NextWindow.Begin.Time))
+	assert.True(t, windowEnd.Equal(kyma.Status.MaintenanceWindow.NextWindow.End.Time))
+	assert.Equal(t, []v1beta2.WaitingModule{
+		{Name: "module-a", CurrentVersion: "2.0.0", TargetVersion: "3.0.0"},
+		{Name: "module-b", CurrentVersion: "1.0.0", TargetVersion: "1.1.0"},
+	}, kyma.Status.MaintenanceWindow.WaitingModules)
+}
+
+func TestUpdateStatus_WithoutWaitingModules_SetsNextWindowOnly(t *testing.T) {
+	service := maintenancewindow.NewService(&maintenanceWindowStub{})
+	kyma := &v1beta2.Kyma{}
+
+	service.UpdateStatus(kyma, modulecommon.Modules{newModule("module-a", nil)})
+
+	require.NotNil(t, kyma.Status.MaintenanceWindow)
+	assert.NotNil(t, kyma.Status.MaintenanceWindow.NextWindow)
+	assert.Empty(t, kyma.Status.MaintenanceWindow.WaitingModules)
+}
+
+func TestUpdateStatus_WhenWindowCannotBeResolved_SetsWaitingModulesOnly(t *testing.T) {
+	service := maintenancewindow.NewService(&maintenanceWindowStub{
+		err: maintenancewindows.ErrNoMaintenanceWindowPolicyConfigured,
+	})
+	kyma := &v1beta2.Kyma{}
+
+	service.UpdateStatus(kyma, modulecommon.Modules{newWaitingModule("module-a", "1.1.0")})
+
+	require.NotNil(t, kyma.Status.MaintenanceWindow)
+	assert.Nil(t, kyma.Status.MaintenanceWindow.NextWindow)
+	assert.Equal(t, []v1beta2.WaitingModule{{Name: "module-a", TargetVersion: "1.1.0"}},
+		kyma.Status.MaintenanceWindow.WaitingModules)
+}
+
+func TestUpdateStatus_WhenNothingToReport_ClearsStatus(t *testing.T) {
+	service := maintenancewindow.NewService(&maintenanceWindowStub{
+		err: maintenancewindows.ErrNoMaintenanceWindowPolicyConfigured,
+	})
+	kyma := &v1beta2.Kyma{Status: v1beta2.KymaStatus{MaintenanceWindow: &v1beta2.MaintenanceWindowStatus{}}}
+
+	service.UpdateStatus(kyma, modulecommon.Modules{newModule("module-a", nil)})
+
+	assert.Nil(t, kyma.Status.MaintenanceWindow)
+}
+
+func TestUpdateStatus_WhenSkippingMaintenanceWindows_ClearsStatus(t *testing.T) {
+	stub := &maintenanceWindowStub{}
+	service := maintenancewindow.NewService(stub)
+	kyma := &v1beta2.Kyma{
+		Spec:   v1beta2.KymaSpec{SkipMaintenanceWindows: true},
+		Status: v1beta2.KymaStatus{MaintenanceWindow: &v1beta2.MaintenanceWindowStatus{}},
+	}
+
+	service.UpdateStatus(kyma, modulecommon.Modules{newModule("module-a", nil)})
+
+	assert.Nil(t, kyma.Status.MaintenanceWindow)
+	assert.False(t, stub.called)
+}
+
+func TestUpdateStatus_WhenSkippingMaintenanceWindowsDuringBlackout_SetsBlackoutAndWaitingModules(t *testing.T) {
+	stub := &maintenanceWindowStub{blackout: &resolver.ResolvedWindow{Begin: windowBegin, End: windowEnd}}
+	service := maintenancewindow.NewService(stub)
+	kyma := &v1beta2.Kyma{Spec: v1beta2.KymaSpec{SkipMaintenanceWindows: true}}
+
+	service.UpdateStatus(kyma, modulecommon.Modules{newWaitingModule("module-a", "1.1.0")})
+
+	require.NotNil(t, kyma.Status.MaintenanceWindow)
+	assert.Nil(t, kyma.Status.MaintenanceWindow.NextWindow)
+	require.NotNil(t, kyma.Status.MaintenanceWindow.ActiveBlackout)
+	assert.True(t, windowEnd.Equal(kyma.Status.MaintenanceWindow.ActiveBlackout.End.Time))
+	assert.Equal(t, []v1beta2.WaitingModule{{Name: "module-a", TargetVersion: "1.1.0"}},
+		kyma.Status.MaintenanceWindow.WaitingModules)
+	assert.False(t, stub.called)
+}
+
+type maintenanceWindowStub struct {
+	err      error
+	called   bool
+	blackout *resolver.ResolvedWindow
+}
+
+func (s *maintenanceWindowStub) NextWindow(_ *v1beta2.Kyma) (*resolver.ResolvedWindow, error) {
+	s.called = true
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &resolver.ResolvedWindow{Begin: windowBegin, End: windowEnd}, nil
+}
+
+func (s *maintenanceWindowStub) ActiveBlackout(_ *v1beta2.Kyma) (resolver.ResolvedWindow, bool) {
+	if s.blackout == nil {
+		return resolver.ResolvedWindow{}, false
+	}
+	return *s.blackout, true
+}
+
+func newWaitingModule(name, version string) *modulecommon.Module {
+	module := newModule(name, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow)
+	module.TemplateInfo.MaintenanceVersion = version
+	return module
+}
+
+func newModule(name string, templateErr error) *modulecommon.Module {
+	return &modulecommon.Module{
+		ModuleName:   name,
+		Enabled:      true,
+		TemplateInfo: &templatelookup.ModuleTemplateInfo{Err: templateErr},
+	}
+}
@@ -0,0 +1,118 @@
+package moduleevent
+
+import (
+	"fmt"
+	"slices"
+
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/event"
+)
+
+type Event interface {
+	Normal(object machineryruntime.Object, reason event.Reason, msg string)
+}
+
+type Service struct {
+	event Event
+}
+
+func NewService(event Event) *Service {
+	return &Service{
+		event: event,
+	}
+}
+
+// Publish compares the module statuses of the Kyma with the statuses before they were updated and records
+// an event on the Kyma for each module that was enabled, started, completed or waits for its upgrade, or was removed.
+// The events name the versions involved, so that they can be used for alerting and auditing.
+func (s *Service) Publish(kyma *v1beta2.Kyma, previousStatuses []v1beta2.ModuleStatus) {
+	for i := range kyma.Status.Modules {
+		status := &kyma.Status.Modules[i]
+		previous := findStatus(previousStatuses, status.Name)
+		if status.Version == "" {
+			continue
+		}
+		if previous == nil || previous.Version == "" {
+			s.event.Normal(kyma, event.ModuleEnabled, fmt.Sprintf("module %s enabled with version %s%s",
+				status.Name, status.Version, channelSuffix(status.Channel)))
+			continue
+		}
+		s.publishUpgrade(kyma, previous, status)
+	}
+
+	for i := range previousStatuses {
+		previous := &previousStatuses[i]
+		if kyma.Status.GetModuleStatus(previous.Name) == nil {
+			s.event.Normal(kyma, event.ModuleRemoved, fmt.Sprintf("module %s removed%s",
+				previous.Name, versionSuffix(previous.Version)))
+		}
+	}
+}
+
+func (s *Service) publishUpgrade(kyma *v1beta2.Kyma, previous, status *v1beta2.ModuleStatus) {
+	if status.Maintenance && !previous.Maintenance {
+		s.event.Normal(kyma, event.ModuleUpgradeBlocked, fmt.Sprintf(
+			"module %s upgrade from %s%s waits for the next maintenance window",
+			status.Name, status.Version, targetVersionSuffix(kyma, status.Name)))
+	}
+
+	if previous.Version != status.Version {
+		// A rolled back upgrade is reported by the rollback, and is neither a started nor a completed upgrade.
+		if slices.Contains(status.FailedVersions, previous.Version) {
+			return
+		}
+		s.event.Normal(kyma, event.ModuleUpgradeStarted, fmt.Sprintf("module %s upgrade from %s to %s started",
+			status.Name, previous.Version, status.Version))
+		if status.State == shared.StateReady {
+			s.publishUpgradeCompleted(kyma, status.Name, previous.Version, status.Version)
+		}
+		return
+	}
+
+	if previous.Upgrade != nil && status.Upgrade == nil && status.State == shared.StateReady {
+		s.publishUpgradeCompleted(kyma, status.Name, previous.Upgrade.PreviousVersion, status.Version)
+	}
+}
+
+func (s *Service) publishUpgradeCompleted(kyma *v1beta2.Kyma, name, previousVersion, version string) {
+	s.event.Normal(kyma, event.ModuleUpgradeCompleted, fmt.Sprintf("module %s upgrade from %s to %s completed",
+		name, previousVersion, version))
+}
+
+func targetVersionSuffix(kyma *v1beta2.Kyma, name string) string {
+	if kyma.Status.MaintenanceWindow == nil {
+		return ""
+	}
+	for _, waiting := range kyma.Status.MaintenanceWindow.WaitingModules {
+		if waiting.Name == name && waiting.TargetVersion != "" {
+			return " to " + waiting.TargetVersion
+		}
+	}
+	return ""
+}
+
+func channelSuffix(channel string) string {
+	if channel == "" {
+		return ""
+	}
+	return " from channel " + channel
+}
+
+func versionSuffix(version string) string {
+	if version == "" {
+		return ""
+	}
+	return " with version " + version
+}
+
+func findStatus(statuses []v1beta2.ModuleStatus, name string) *v1beta2.ModuleStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
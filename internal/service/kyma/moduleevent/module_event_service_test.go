@@ -0,0 +1,130 @@
+package moduleevent_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/event"
+	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/moduleevent"
+)
+
+func TestPublish_WhenModuleInstalled_RecordsModuleEnabled(t *testing.T) {
+	eventStub := &eventStub{}
+	kyma := newKyma(moduleStatus("1.0.0", shared.StateProcessing))
+
+	moduleevent.NewService(eventStub).Publish(kyma, nil)
+
+	assert.Equal(t, []recordedEvent{
+		{event.ModuleEnabled, "module module-a enabled with version 1.0.0 from channel regular"},
+	}, eventStub.events)
+}
+
+func TestPublish_WhenVersionChanged_RecordsUpgradeStarted(t *testing.T) {
+	eventStub := &eventStub{}
+	kyma := newKyma(moduleStatus("1.1.0", shared.StateProcessing))
+
+	moduleevent.NewService(eventStub).Publish(kyma, []v1beta2.ModuleStatus{moduleStatus("1.0.0", shared.StateReady)})
+
+	assert.Equal(t, []recordedEvent{
+		{event.ModuleUpgradeStarted, "module module-a upgrade from 1.0.0 to 1.1.0 started"},
+	}, eventStub.events)
+}
+
+func TestPublish_WhenVersionChangedAndReady_RecordsUpgradeStartedAndCompleted(t *testing.T) {
+	eventStub := &eventStub{}
+	kyma := newKyma(moduleStatus("1.1.0", shared.StateReady))
+
+	moduleevent.NewService(eventStub).Publish(kyma, []v1beta2.ModuleStatus{moduleStatus("1.0.0", shared.StateReady)})
+
+	assert.Equal(t, []recordedEvent{
+		{event.ModuleUpgradeStarted, "module module-a upgrade from 1.0.0 to 1.1.0 started"},
+		{event.ModuleUpgradeCompleted, "module module-a upgrade from 1.0.0 to 1.1.0 completed"},
+	}, eventStub.events)
+}
+
+func TestPublish_WhenTrackedUpgradeBecomesReady_RecordsUpgradeCompleted(t *testing.T) {
+	eventStub := &eventStub{}
+	previous := moduleStatus("1.1.0", shared.StateProcessing)
+	previous.Upgrade = &v1beta2.ModuleUpgrade{PreviousVersion: "1.0.0"}
+	kyma := newKyma(moduleStatus("1.1.0", shared.StateReady))
+
+	moduleevent.NewService(eventStub).Publish(kyma, []v1beta2.ModuleStatus{previous})
+
+	assert.Equal(t, []recordedEvent{
+		{event.ModuleUpgradeCompleted, "module module-a upgrade from 1.0.0 to 1.1.0 completed"},
+	}, eventStub.events)
+}
+
+func TestPublish_WhenUpgradeRolledBack_RecordsNoUpgradeEvent(t *testing.T) {
+	eventStub := &eventStub{}
+	current := moduleStatus("1.0.0", shared.StateWarning)
+	current.FailedVersions = []string{"1.1.0"}
+
+	moduleevent.NewService(eventStub).Publish(newKyma(current),
+		[]v1beta2.ModuleStatus{moduleStatus("1.1.0", shared.StateError)})
+
+	assert.Empty(t, eventStub.events)
+}
+
+func TestPublish_WhenWaitingForMaintenanceWindow_RecordsUpgradeBlockedOnce(t *testing.T) {
+	eventStub := &eventStub{}
+	waiting := moduleStatus("1.0.0", shared.StateReady)
+	waiting.Maintenance = true
+	kyma := newKyma(waiting)
+	kyma.Status.MaintenanceWindow = &v1beta2.MaintenanceWindowStatus{
+		WaitingModules: []v1beta2.WaitingModule{{Name: "module-a", CurrentVersion: "1.0.0", TargetVersion: "2.0.0"}},
+	}
+	service := moduleevent.NewService(eventStub)
+
+	service.Publish(kyma, []v1beta2.ModuleStatus{moduleStatus("1.0.0", shared.StateReady)})
+	service.Publish(kyma, []v1beta2.ModuleStatus{waiting})
+
+	assert.Equal(t, []recordedEvent{
+		{event.ModuleUpgradeBlocked, "module module-a upgrade from 1.0.0 to 2.0.0 waits for the next maintenance window"},
+	}, eventStub.events)
+}
+
+func TestPublish_WhenModuleStatusRemoved_RecordsModuleRemoved(t *testing.T) {
+	eventStub := &eventStub{}
+
+	moduleevent.NewService(eventStub).Publish(newKyma(),
+		[]v1beta2.ModuleStatus{moduleStatus("1.0.0", shared.StateDeleting)})
+
+	assert.Equal(t, []recordedEvent{
+		{event.ModuleRemoved, "module module-a removed with version 1.0.0"},
+	}, eventStub.events)
+}
+
+func TestPublish_WhenNothingChanged_RecordsNoEvent(t *testing.T) {
+	eventStub := &eventStub{}
+	status := moduleStatus("1.0.0", shared.StateReady)
+
+	moduleevent.NewService(eventStub).Publish(newKyma(status), []v1beta2.ModuleStatus{status})
+
+	assert.Empty(t, eventStub.events)
+}
+
+func moduleStatus(version string, state shared.State) v1beta2.ModuleStatus {
+	return v1beta2.ModuleStatus{Name: "module-a", Channel: "regular", Version: version, State: state}
+}
+
+func newKyma(statuses ...v1beta2.ModuleStatus) *v1beta2.Kyma {
+	return &v1beta2.Kyma{Status: v1beta2.KymaStatus{Modules: statuses}}
+}
+
+type recordedEvent struct {
+	reason  event.Reason
+	message string
+}
+
+type eventStub struct {
+	events []recordedEvent
+}
+
+func (e *eventStub) Normal(_ machineryruntime.Object, reason event.Reason, msg string) {
+	e.events = append(e.events, recordedEvent{reason: reason, message: msg})
+}
@@ -0,0 +1,184 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+const (
+	ConfigMapNameSuffix = "-plan"
+	ConfigMapDataKey    = "plan.yaml"
+)
+
+type Action string
+
+const (
+	ActionNone    Action = "None"
+	ActionCreate  Action = "Create"
+	ActionUpdate  Action = "Update"
+	ActionDelete  Action = "Delete"
+	ActionBlocked Action = "Blocked"
+	ActionSkipped Action = "Skipped"
+)
+
+// Plan lists the changes a reconciliation of the Kyma would apply to the Manifests of its modules.
+type Plan struct {
+	Kyma               string         `json:"kyma"`
+	ObservedGeneration int64          `json:"observedGeneration"`
+	Modules            []ModuleChange `json:"modules"`
+}
+
+type ModuleChange struct {
+	Name           string `json:"name"`
+	Action         Action `json:"action"`
+	Manifest       string `json:"manifest,omitempty"`
+	CurrentVersion string `json:"currentVersion,omitempty"`
+	DesiredVersion string `json:"desiredVersion,omitempty"`
+	CurrentChannel string `json:"currentChannel,omitempty"`
+	DesiredChannel string `json:"desiredChannel,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+type ConfigMapRepository interface {
+	Apply(ctx context.Context, configMap *apicorev1.ConfigMap) error
+}
+
+type Service struct {
+	repository ConfigMapRepository
+}
+
+func NewService(repository ConfigMapRepository) *Service {
+	return &Service{
+		repository: repository,
+	}
+}
+
+// Publish writes the plan for the Kyma into the ConfigMap named after the Kyma with the suffix "-plan".
+// The ConfigMap is owned by the Kyma, so it is garbage collected together with it.
+func (s *Service) Publish(ctx context.Context, kyma *v1beta2.Kyma, modules modulecommon.Modules,
+	changes []sync.ManifestChange,
+) error {
+	plan := Generate(kyma, modules, changes)
+	data, err := yaml.Marshal(plan)
+	if err != nil {
+		return fmt.Errorf("failed to marshal plan for Kyma %s: %w", kyma.GetName(), err)
+	}
+
+	configMap := &apicorev1.ConfigMap{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      ConfigMapName(kyma.GetName()),
+			Namespace: kyma.GetNamespace(),
+			Labels: map[string]string{
+				shared.KymaName:  kyma.GetName(),
+				shared.ManagedBy: shared.OperatorName,
+			},
+			OwnerReferences: []apimetav1.OwnerReference{
+				*apimetav1.NewControllerRef(kyma, v1beta2.GroupVersion.WithKind(string(shared.KymaKind))),
+			},
+		},
+		Data: map[string]string{ConfigMapDataKey: string(data)},
+	}
+	if err := s.repository.Apply(ctx, configMap); err != nil {
+		return fmt.Errorf("failed to publish plan for Kyma %s: %w", kyma.GetName(), err)
+	}
+	return nil
+}
+
+func ConfigMapName(kymaName string) string {
+	return kymaName + ConfigMapNameSuffix
+}
+
+// Generate creates the plan from the Manifest changes of the enabled modules and the deletion of
+// the modules that were removed from the Kyma spec.
+func Generate(kyma *v1beta2.Kyma, modules modulecommon.Modules, changes []sync.ManifestChange) Plan {
+	moduleStatusMap := kyma.GetModuleStatusMap()
+	plan := Plan{
+		Kyma:               kyma.GetName(),
+		ObservedGeneration: kyma.GetGeneration(),
+		Modules:            make([]ModuleChange, 0, len(changes)),
+	}
+
+	for _, change := range changes {
+		if !change.Module.Enabled {
+			continue
+		}
+		plan.Modules = append(plan.Modules, toModuleChange(change, moduleStatusMap[change.Module.ModuleName]))
+	}
+
+	requiredBy := modules.RequiredBy()
+	for _, moduleStatus := range kyma.GetNoLongerExistingModuleStatus() {
+		if moduleStatus.Manifest == nil {
+			continue
+		}
+		moduleChange := ModuleChange{
+			Name:           moduleStatus.Name,
+			Action:         ActionDelete,
+			Manifest:       moduleStatus.Manifest.GetName(),
+			CurrentVersion: moduleStatus.Version,
+			CurrentChannel: moduleStatus.Channel,
+		}
+		if dependents, required := requiredBy[moduleStatus.Name]; required {
+			moduleChange.Action = ActionBlocked
+			moduleChange.Reason = "module is required by " + strings.Join(dependents, ", ")
+		}
+		plan.Modules = append(plan.Modules, moduleChange)
+	}
+
+	slices.SortFunc(plan.Modules, func(a, b ModuleChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return plan
+}
+
+func toModuleChange(change sync.ManifestChange, moduleStatus *v1beta2.ModuleStatus) ModuleChange {
+	moduleChange := ModuleChange{
+		Name:           change.Module.ModuleName,
+		Manifest:       change.Module.Manifest.GetName(),
+		DesiredChannel: change.Module.TemplateInfo.DesiredChannel,
+	}
+	if moduleStatus != nil {
+		moduleChange.CurrentVersion = moduleStatus.Version
+		moduleChange.CurrentChannel = moduleStatus.Channel
+	}
+	if change.NewManifest != nil {
+		moduleChange.DesiredVersion = change.NewManifest.Spec.Version
+	}
+
+	switch change.Action {
+	case sync.ManifestActionCreate:
+		moduleChange.Action = ActionCreate
+	case sync.ManifestActionUpdate:
+		moduleChange.Action = ActionUpdate
+	case sync.ManifestActionDelete:
+		moduleChange.Action = ActionDelete
+		moduleChange.Reason = change.Module.TemplateInfo.Err.Error()
+	case sync.ManifestActionSkip:
+		moduleChange.Action = ActionSkipped
+		if errors.Is(change.Module.TemplateInfo.Err, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow) {
+			moduleChange.Action = ActionBlocked
+			moduleChange.DesiredVersion = change.Module.TemplateInfo.MaintenanceVersion
+		}
+		moduleChange.Reason = change.Module.TemplateInfo.Err.Error()
+	case sync.ManifestActionNone:
+		moduleChange.Action = ActionNone
+	}
+	rolledBackVersion := change.Module.TemplateInfo.RolledBackVersion
+	if rolledBackVersion != "" && moduleChange.Reason == "" {
+		moduleChange.Reason = fmt.Sprintf("version %s is rolled back "+
+			"as it did not become ready within the health deadline", rolledBackVersion)
+	}
+	return moduleChange
+}
@@ -0,0 +1,196 @@
+package plan_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/plan"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+const (
+	kymaName      = "test-kyma"
+	kymaNamespace = "kcp-system"
+)
+
+func TestGenerate_ReportsVersionAndChannelChanges(t *testing.T) {
+	kyma := newKyma(v1beta2.ModuleStatus{Name: "module-a", Version: "1.0.0", Channel: "regular"})
+	module := newModule("module-a", "fast", nil)
+	changes := []sync.ManifestChange{{
+		Module:      module,
+		Action:      sync.ManifestActionUpdate,
+		NewManifest: &v1beta2.Manifest{Spec: v1beta2.ManifestSpec{Version: "1.1.0"}},
+	}}
+
+	result := plan.Generate(kyma, modulecommon.Modules{module}, changes)
+
+	require.Len(t, result.Modules, 1)
+	assert.Equal(t, plan.ModuleChange{
+		Name:           "module-a",
+		Action:         plan.ActionUpdate,
+		Manifest:       "test-kyma-module-a",
+		CurrentVersion: "1.0.0",
+		DesiredVersion: "1.1.0",
+		CurrentChannel: "regular",
+		DesiredChannel: "fast",
+	}, result.Modules[0])
+}
+
+func TestGenerate_ReportsMaintenanceWindowBlock(t *testing.T) {
+	kyma := newKyma(v1beta2.ModuleStatus{Name: "module-a", Version: "1.0.0", Channel: "regular"})
+	module := newModule("module-a", "regular", moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow)
+	module.TemplateInfo.ModuleTemplate = nil
+	module.TemplateInfo.MaintenanceVersion = "1.1.0"
+	changes := []sync.ManifestChange{{Module: module, Action: sync.ManifestActionSkip}}
+
+	result := plan.Generate(kyma, modulecommon.Modules{module}, changes)
+
+	require.Len(t, result.Modules, 1)
+	assert.Equal(t, plan.ActionBlocked, result.Modules[0].Action)
+	assert.Equal(t, "1.1.0", result.Modules[0].DesiredVersion)
+	assert.Equal(t, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow.Error(), result.Modules[0].Reason)
+}
+
+func TestGenerate_ReportsRollbackOfFailedUpgrade(t *testing.T) {
+	kyma := newKyma(v1beta2.ModuleStatus{Name: "module-a", Version: "2.0.0", Channel: "regular"})
+	module := newModule("module-a", "regular", nil)
+	module.TemplateInfo.RolledBackVersion = "2.0.0"
+	changes := []sync.ManifestChange{{
+		Module:      module,
+		Action:      sync.ManifestActionUpdate,
+		NewManifest: &v1beta2.Manifest{Spec: v1beta2.ManifestSpec{Version: "1.0.0"}},
+	}}
+
+	result := plan.Generate(kyma, modulecommon.Modules{module}, changes)
+
+	require.Len(t, result.Modules, 1)
+	assert.Equal(t, plan.ActionUpdate, result.Modules[0].Action)
+	assert.Equal(t, "2.0.0", result.Modules[0].CurrentVersion)
+	assert.Equal(t, "1.0.0", result.Modules[0].DesiredVersion)
+	assert.Equal(t, "version 2.0.0 is rolled back as it did not become ready within the health deadline",
+		result.Modules[0].Reason)
+}
+
+func TestGenerate_ReportsSkippedModuleWithReason(t *testing.T) {
+	kyma := newKyma()
+	module := newModule("module-a", "regular", assert.AnError)
+	changes := []sync.ManifestChange{{Module: module, Action: sync.ManifestActionSkip}}
+
+	result := plan.Generate(kyma, modulecommon.Modules{module}, changes)
+
+	require.Len(t, result.Modules, 1)
+	assert.Equal(t, plan.ActionSkipped, result.Modules[0].Action)
+	assert.Equal(t, assert.AnError.Error(), result.Modules[0].Reason)
+}
+
+func TestGenerate_ReportsDeletionOfRemovedModules(t *testing.T) {
+	kyma := newKyma(
+		v1beta2.ModuleStatus{Name: "module-b", Version: "2.0.0", Manifest: newTrackingObject("test-kyma-module-b")},
+		v1beta2.ModuleStatus{Name: "module-c", Version: "3.0.0", Manifest: newTrackingObject("test-kyma-module-c")},
+	)
+	disabledModule := newModule("module-b", "regular", nil)
+	disabledModule.Enabled = false
+	dependentModule := newModule("module-a", "regular", nil)
+	dependentModule.TemplateInfo.Spec.DependsOn = []v1beta2.ModuleDependency{{Name: "module-c"}}
+	kyma.Spec.Modules = []v1beta2.Module{{Name: "module-a"}}
+	changes := []sync.ManifestChange{
+		{Module: dependentModule, Action: sync.ManifestActionNone},
+		{Module: disabledModule, Action: sync.ManifestActionUpdate},
+	}
+
+	result := plan.Generate(kyma, modulecommon.Modules{dependentModule, disabledModule}, changes)
+
+	require.Len(t, result.Modules, 3)
+	assert.Equal(t, "module-a", result.Modules[0].Name)
+	assert.Equal(t, plan.ActionNone, result.Modules[0].Action)
+	assert.Equal(t, "module-b", result.Modules[1].Name)
+	assert.Equal(t, plan.ActionDelete, result.Modules[1].Action)
+	assert.Equal(t, "2.0.0", result.Modules[1].CurrentVersion)
+	assert.Equal(t, "module-c", result.Modules[2].Name)
+	assert.Equal(t, plan.ActionBlocked, result.Modules[2].Action)
+	assert.Equal(t, "module is required by module-a", result.Modules[2].Reason)
+}
+
+func TestPublish_AppliesConfigMapOwnedByKyma(t *testing.T) {
+	repository := &configMapRepositoryStub{}
+	service := plan.NewService(repository)
+	kyma := newKyma()
+	module := newModule("module-a", "regular", nil)
+	changes := []sync.ManifestChange{{
+		Module:      module,
+		Action:      sync.ManifestActionCreate,
+		NewManifest: &v1beta2.Manifest{Spec: v1beta2.ManifestSpec{Version: "1.0.0"}},
+	}}
+
+	err := service.Publish(t.Context(), kyma, modulecommon.Modules{module}, changes)
+
+	require.NoError(t, err)
+	require.NotNil(t, repository.configMap)
+	assert.Equal(t, "test-kyma-plan", repository.configMap.GetName())
+	assert.Equal(t, kymaNamespace, repository.configMap.GetNamespace())
+	assert.Equal(t, kymaName, repository.configMap.GetLabels()[shared.KymaName])
+	require.Len(t, repository.configMap.GetOwnerReferences(), 1)
+	assert.Equal(t, kymaName, repository.configMap.GetOwnerReferences()[0].Name)
+
+	published := plan.Plan{}
+	require.NoError(t, yaml.Unmarshal([]byte(repository.configMap.Data[plan.ConfigMapDataKey]), &published))
+	assert.Equal(t, kymaName, published.Kyma)
+	require.Len(t, published.Modules, 1)
+	assert.Equal(t, plan.ActionCreate, published.Modules[0].Action)
+	assert.Equal(t, "1.0.0", published.Modules[0].DesiredVersion)
+}
+
+func TestPublish_WhenRepositoryFails_ReturnsError(t *testing.T) {
+	service := plan.NewService(&configMapRepositoryStub{err: assert.AnError})
+
+	err := service.Publish(t.Context(), newKyma(), nil, nil)
+
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+type configMapRepositoryStub struct {
+	configMap *apicorev1.ConfigMap
+	err       error
+}
+
+func (r *configMapRepositoryStub) Apply(_ context.Context, configMap *apicorev1.ConfigMap) error {
+	r.configMap = configMap
+	return r.err
+}
+
+func newKyma(moduleStatus ...v1beta2.ModuleStatus) *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: kymaName, Namespace: kymaNamespace, UID: "test-uid"},
+		Status:     v1beta2.KymaStatus{Modules: moduleStatus},
+	}
+}
+
+func newModule(name, channel string, templateErr error) *modulecommon.Module {
+	return &modulecommon.Module{
+		ModuleName: name,
+		Enabled:    true,
+		TemplateInfo: &templatelookup.ModuleTemplateInfo{
+			ModuleTemplate: &v1beta2.ModuleTemplate{},
+			Err:            templateErr,
+			DesiredChannel: channel,
+		},
+		Manifest: &v1beta2.Manifest{
+			ObjectMeta: apimetav1.ObjectMeta{Name: kymaName + "-" + name, Namespace: kymaNamespace},
+		},
+	}
+}
+
+func newTrackingObject(name string) *v1beta2.TrackingObject {
+	return &v1beta2.TrackingObject{PartialMeta: v1beta2.PartialMeta{Name: name, Namespace: kymaNamespace}}
+}
@@ -0,0 +1,69 @@
+package rollback
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/event"
+)
+
+var ErrHealthDeadlineExceeded = errors.New("module did not become ready within the upgrade health deadline")
+
+const moduleUpgradeRolledBackEvent event.Reason = "ModuleUpgradeRolledBack"
+
+type Service struct {
+	healthDeadline time.Duration
+	event          event.Event
+	now            func() time.Time
+}
+
+// NewService creates a Service that rolls back module upgrades not reaching the Ready state within
+// the given health deadline. A zero health deadline disables the rollback.
+func NewService(healthDeadline time.Duration, event event.Event) *Service {
+	return &Service{
+		healthDeadline: healthDeadline,
+		event:          event,
+		now:            time.Now,
+	}
+}
+
+// MarkFailedUpgrades marks the version of each module upgrade that exceeded the health deadline as failed.
+// The template lookup then resolves the ModuleTemplate from before the upgrade for the module,
+// which restores the previous Manifest spec and prevents the failed version from being retried.
+// For a Kyma in dry-run mode, the versions are marked to plan the rollback, but no Event is issued.
+func (s *Service) MarkFailedUpgrades(kyma *v1beta2.Kyma) {
+	if s.healthDeadline <= 0 {
+		return
+	}
+
+	now := s.now()
+	for i := range kyma.Status.Modules {
+		moduleStatus := &kyma.Status.Modules[i]
+		if !s.upgradeFailed(moduleStatus, now) {
+			continue
+		}
+		moduleStatus.AddFailedVersion(moduleStatus.Version)
+		if kyma.IsDryRun() {
+			continue
+		}
+		s.event.Warning(kyma, moduleUpgradeRolledBackEvent,
+			fmt.Errorf("%w: rolling back module %s from version %s to %s", ErrHealthDeadlineExceeded,
+				moduleStatus.Name, moduleStatus.Version, moduleStatus.Upgrade.PreviousVersion))
+	}
+}
+
+func (s *Service) upgradeFailed(moduleStatus *v1beta2.ModuleStatus, now time.Time) bool {
+	if moduleStatus.Upgrade == nil || moduleStatus.Version == "" {
+		return false
+	}
+	if moduleStatus.State == shared.StateReady || moduleStatus.State == shared.StateUnmanaged {
+		return false
+	}
+	if moduleStatus.IsFailedVersion(moduleStatus.Version) {
+		return false
+	}
+	return moduleStatus.Upgrade.HealthDeadlineExceeded(s.healthDeadline, now)
+}
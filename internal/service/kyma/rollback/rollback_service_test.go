@@ -0,0 +1,148 @@
+package rollback_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/event"
+	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/rollback"
+)
+
+const healthDeadline = 10 * time.Minute
+
+func TestMarkFailedUpgrades_WhenDeadlineExceeded_MarksVersionAsFailed(t *testing.T) {
+	eventStub := &eventStub{}
+	service := rollback.NewService(healthDeadline, eventStub)
+	kyma := kymaWithModuleStatus(moduleStatusWithUpgrade(shared.StateError, time.Now().Add(-time.Hour)))
+
+	service.MarkFailedUpgrades(kyma)
+
+	assert.Equal(t, []string{"2.0.0"}, kyma.Status.Modules[0].FailedVersions)
+	require.Len(t, eventStub.warnings, 1)
+	assert.ErrorIs(t, eventStub.warnings[0], rollback.ErrHealthDeadlineExceeded)
+	assert.Contains(t, eventStub.warnings[0].Error(), "from version 2.0.0 to 1.0.0")
+}
+
+func TestMarkFailedUpgrades_WhenKymaInDryRun_MarksVersionWithoutEvent(t *testing.T) {
+	eventStub := &eventStub{}
+	service := rollback.NewService(healthDeadline, eventStub)
+	kyma := kymaWithModuleStatus(moduleStatusWithUpgrade(shared.StateError, time.Now().Add(-time.Hour)))
+	kyma.SetAnnotations(map[string]string{shared.DryRunAnnotation: "true"})
+
+	service.MarkFailedUpgrades(kyma)
+
+	assert.Equal(t, []string{"2.0.0"}, kyma.Status.Modules[0].FailedVersions)
+	assert.Empty(t, eventStub.warnings)
+}
+
+func TestMarkFailedUpgrades_WhenVersionAlreadyFailed_DoesNotMarkAgain(t *testing.T) {
+	eventStub := &eventStub{}
+	service := rollback.NewService(healthDeadline, eventStub)
+	moduleStatus := moduleStatusWithUpgrade(shared.StateError, time.Now().Add(-time.Hour))
+	moduleStatus.FailedVersions = []string{"2.0.0"}
+	kyma := kymaWithModuleStatus(moduleStatus)
+
+	service.MarkFailedUpgrades(kyma)
+
+	assert.Equal(t, []string{"2.0.0"}, kyma.Status.Modules[0].FailedVersions)
+	assert.Empty(t, eventStub.warnings)
+}
+
+func TestMarkFailedUpgrades_WhenMaxFailedVersionsReached_DropsOldestVersion(t *testing.T) {
+	service := rollback.NewService(healthDeadline, &eventStub{})
+	moduleStatus := moduleStatusWithUpgrade(shared.StateError, time.Now().Add(-time.Hour))
+	for i := range v1beta2.MaxFailedVersions {
+		moduleStatus.FailedVersions = append(moduleStatus.FailedVersions, fmt.Sprintf("1.%d.0", i))
+	}
+	kyma := kymaWithModuleStatus(moduleStatus)
+
+	service.MarkFailedUpgrades(kyma)
+
+	failedVersions := kyma.Status.Modules[0].FailedVersions
+	assert.Len(t, failedVersions, v1beta2.MaxFailedVersions)
+	assert.NotContains(t, failedVersions, "1.0.0")
+	assert.Equal(t, "2.0.0", failedVersions[len(failedVersions)-1])
+}
+
+func TestMarkFailedUpgrades_DoesNotRollBack(t *testing.T) {
+	tests := []struct {
+		name           string
+		healthDeadline time.Duration
+		moduleStatus   v1beta2.ModuleStatus
+	}{
+		{
+			name:           "when deadline not exceeded",
+			healthDeadline: healthDeadline,
+			moduleStatus:   moduleStatusWithUpgrade(shared.StateError, time.Now()),
+		},
+		{
+			name:           "when module is ready",
+			healthDeadline: healthDeadline,
+			moduleStatus:   moduleStatusWithUpgrade(shared.StateReady, time.Now().Add(-time.Hour)),
+		},
+		{
+			name:           "when rollback is disabled",
+			healthDeadline: 0,
+			moduleStatus:   moduleStatusWithUpgrade(shared.StateError, time.Now().Add(-time.Hour)),
+		},
+		{
+			name:           "when no upgrade is tracked",
+			healthDeadline: healthDeadline,
+			moduleStatus: v1beta2.ModuleStatus{
+				Name:    "test-module",
+				Version: "2.0.0",
+				State:   shared.StateError,
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			eventStub := &eventStub{}
+			service := rollback.NewService(testCase.healthDeadline, eventStub)
+			kyma := kymaWithModuleStatus(testCase.moduleStatus)
+
+			service.MarkFailedUpgrades(kyma)
+
+			assert.Empty(t, kyma.Status.Modules[0].FailedVersions)
+			assert.Empty(t, eventStub.warnings)
+		})
+	}
+}
+
+func moduleStatusWithUpgrade(state shared.State, startedAt time.Time) v1beta2.ModuleStatus {
+	return v1beta2.ModuleStatus{
+		Name:    "test-module",
+		Version: "2.0.0",
+		State:   state,
+		Upgrade: &v1beta2.ModuleUpgrade{
+			PreviousVersion: "1.0.0",
+			StartedAt:       apimetav1.NewTime(startedAt),
+		},
+	}
+}
+
+func kymaWithModuleStatus(moduleStatus v1beta2.ModuleStatus) *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		Status: v1beta2.KymaStatus{
+			Modules: []v1beta2.ModuleStatus{moduleStatus},
+		},
+	}
+}
+
+type eventStub struct {
+	warnings []error
+}
+
+func (e *eventStub) Normal(_ machineryruntime.Object, _ event.Reason, _ string) {}
+
+func (e *eventStub) Warning(_ machineryruntime.Object, _ event.Reason, err error) {
+	e.warnings = append(e.warnings, err)
+}
@@ -20,7 +20,20 @@ func GenerateModuleStatusFromError(err error, moduleName, desiredChannel, fqdn s
 	}
 
 	if status == nil {
-		return newDefaultErrorStatus(moduleName, desiredChannel, fqdn, err), nil
+		newStatus := newDefaultErrorStatus(moduleName, desiredChannel, fqdn, err)
+		if errorIsWaitingForModuleDependency(err) {
+			newStatus.State = shared.StateProcessing
+		}
+		if errorIsUnresolvableModuleDependency(err) {
+			newStatus.State = shared.StateWarning
+		}
+		return newStatus, nil
+	}
+
+	if errorIsWaitingForModuleDependency(err) {
+		newModuleStatus := status.DeepCopy()
+		newModuleStatus.Message = err.Error()
+		return newModuleStatus, nil
 	}
 
 	if errorIsWaitingForMaintenanceWindow(err) {
@@ -37,7 +50,7 @@ func GenerateModuleStatusFromError(err error, moduleName, desiredChannel, fqdn s
 		return newModuleStatus, nil
 	}
 
-	if errorIsForbiddenTemplateUpdate(err) {
+	if errorIsForbiddenTemplateUpdate(err) || errorIsUnresolvableModuleDependency(err) {
 		newModuleStatus := status.DeepCopy()
 		newModuleStatus.Message = err.Error()
 		newModuleStatus.State = shared.StateWarning
@@ -45,6 +58,9 @@ func GenerateModuleStatusFromError(err error, moduleName, desiredChannel, fqdn s
 	}
 
 	newStatus := newDefaultErrorStatus(moduleName, desiredChannel, fqdn, err)
+	// Keep the rollback bookkeeping, otherwise a failed version would be retried after a lookup error.
+	newStatus.Upgrade = status.Upgrade
+	newStatus.FailedVersions = status.FailedVersions
 
 	if errorIsTemplateNotFound(err) {
 		newStatus.State = shared.StateWarning
@@ -66,6 +82,15 @@ func errorIsForbiddenTemplateUpdate(err error) bool {
 		errors.Is(err, templatelookup.ErrNoModuleReleaseMeta)
 }
 
+func errorIsWaitingForModuleDependency(err error) bool {
+	return errors.Is(err, templatelookup.ErrWaitingForModuleDependency)
+}
+
+func errorIsUnresolvableModuleDependency(err error) bool {
+	return errors.Is(err, templatelookup.ErrModuleDependencyNotMet) ||
+		errors.Is(err, templatelookup.ErrModuleDependencyCycle)
+}
+
 func errorIsTemplateNotFound(err error) bool {
 	return errors.Is(err, common.ErrNoTemplatesInListResult)
 }
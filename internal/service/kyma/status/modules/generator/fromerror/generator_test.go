@@ -173,6 +173,81 @@ func TestGenerateModuleStatusFromError_WhenCalledWithNoTemplatesInListResultErro
 	assert.Nil(t, result.Template)
 }
 
+func TestGenerateModuleStatusFromError_WhenCalledWithAnyOtherError_KeepsRollbackBookkeeping(t *testing.T) {
+	status := createStatus()
+	status.FailedVersions = []string{"2.0.0"}
+	status.Upgrade = &v1beta2.ModuleUpgrade{PreviousVersion: "1.0.0", StartedAt: apimetav1.Now()}
+
+	result, err := fromerror.GenerateModuleStatusFromError(errors.New("some error"), "some-module", "some-channel",
+		"some-fqdn", status)
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.StateError, result.State)
+	assert.Equal(t, status.FailedVersions, result.FailedVersions)
+	assert.Equal(t, status.Upgrade, result.Upgrade)
+}
+
+func TestGenerateModuleStatusFromError_WhenCalledWithWaitingForModuleDependencyError_ReturnsDeepCopyAndMessage(
+	t *testing.T,
+) {
+	status := createStatus()
+	templateError := templatelookup.ErrWaitingForModuleDependency
+
+	result, err := fromerror.GenerateModuleStatusFromError(templateError, "some-module", "some-channel",
+		"some-fqdn", status)
+
+	require.NoError(t, err)
+	expectedStatus := status.DeepCopy()
+	expectedStatus.Message = templateError.Error()
+	assert.Equal(t, expectedStatus, result)
+}
+
+func TestGenerateModuleStatusFromError_WhenCalledWithModuleDependencyErrors_ReturnsDeepCopyAndStateWarning(
+	t *testing.T,
+) {
+	for _, templateError := range []error{
+		templatelookup.ErrModuleDependencyNotMet,
+		templatelookup.ErrModuleDependencyCycle,
+	} {
+		t.Run(templateError.Error(), func(t *testing.T) {
+			status := createStatus()
+
+			result, err := fromerror.GenerateModuleStatusFromError(templateError, "some-module", "some-channel",
+				"some-fqdn", status)
+
+			require.NoError(t, err)
+			expectedStatus := status.DeepCopy()
+			expectedStatus.Message = templateError.Error()
+			expectedStatus.State = shared.StateWarning
+			assert.Equal(t, expectedStatus, result)
+		})
+	}
+}
+
+func TestGenerateModuleStatusFromError_WhenCalledWithModuleDependencyErrorAndNilStatus_ReturnsNewStatus(
+	t *testing.T,
+) {
+	tests := []struct {
+		err           error
+		expectedState shared.State
+	}{
+		{err: templatelookup.ErrWaitingForModuleDependency, expectedState: shared.StateProcessing},
+		{err: templatelookup.ErrModuleDependencyNotMet, expectedState: shared.StateWarning},
+		{err: templatelookup.ErrModuleDependencyCycle, expectedState: shared.StateWarning},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.err.Error(), func(t *testing.T) {
+			result, err := fromerror.GenerateModuleStatusFromError(testCase.err, "some-module", "some-channel",
+				"some-fqdn", nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, "some-module", result.Name)
+			assert.Equal(t, testCase.expectedState, result.State)
+			assert.Equal(t, testCase.err.Error(), result.Message)
+		})
+	}
+}
+
 func TestGenerateModuleStatusFromError_WhenCalledWithoutTemplateError_ReturnsErr(t *testing.T) {
 	_, err := fromerror.GenerateModuleStatusFromError(nil, "", "", "", &v1beta2.ModuleStatus{})
 	require.Error(t, err)
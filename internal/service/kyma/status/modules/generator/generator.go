@@ -2,6 +2,8 @@ package generator
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -21,11 +23,27 @@ type GenerateFromErrorFunc func(err error, moduleName, desiredChannel, fqdn stri
 
 type ModuleStatusGenerator struct {
 	generateFromErrorFunc GenerateFromErrorFunc
+	now                   func() time.Time
 }
 
-func NewModuleStatusGenerator(fromErrorGeneratorFunc GenerateFromErrorFunc) *ModuleStatusGenerator {
-	return &ModuleStatusGenerator{
+func NewModuleStatusGenerator(fromErrorGeneratorFunc GenerateFromErrorFunc,
+	opts ...func(*ModuleStatusGenerator) *ModuleStatusGenerator,
+) *ModuleStatusGenerator {
+	statusGenerator := &ModuleStatusGenerator{
 		generateFromErrorFunc: fromErrorGeneratorFunc,
+		now:                   time.Now,
+	}
+	for _, opt := range opts {
+		statusGenerator = opt(statusGenerator)
+	}
+	return statusGenerator
+}
+
+// WithClock replaces the clock that determines the start of a tracked module upgrade.
+func WithClock(now func() time.Time) func(*ModuleStatusGenerator) *ModuleStatusGenerator {
+	return func(m *ModuleStatusGenerator) *ModuleStatusGenerator {
+		m.now = now
+		return m
 	}
 }
 
@@ -56,11 +74,12 @@ func (m *ModuleStatusGenerator) GenerateModuleStatus(module *modulecommon.Module
 	manifestAPIVersion, manifestKind := manifest.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
 	templateAPIVersion, templateKind := module.TemplateInfo.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
 	moduleStatus := &v1beta2.ModuleStatus{
-		Name:    module.ModuleName,
-		FQDN:    module.FQDN,
-		State:   manifest.Status.State,
-		Channel: module.TemplateInfo.DesiredChannel,
-		Version: manifest.Spec.Version,
+		Name:             module.ModuleName,
+		FQDN:             module.FQDN,
+		State:            manifest.Status.State,
+		Channel:          module.TemplateInfo.DesiredChannel,
+		Version:          manifest.Spec.Version,
+		DeletionBlockers: manifest.Status.DeletionBlockers.DeepCopy(),
 		Manifest: &v1beta2.TrackingObject{
 			PartialMeta: v1beta2.PartialMeta{
 				Name:       manifest.GetName(),
@@ -98,6 +117,8 @@ func (m *ModuleStatusGenerator) GenerateModuleStatus(module *modulecommon.Module
 		}
 	}
 
+	m.trackUpgrade(moduleStatus, currentStatus, module.TemplateInfo.RolledBackVersion)
+
 	if module.IsUnmanaged {
 		moduleStatus.State = shared.StateUnmanaged
 		moduleStatus.Manifest = nil
@@ -107,3 +128,46 @@ func (m *ModuleStatusGenerator) GenerateModuleStatus(module *modulecommon.Module
 
 	return moduleStatus, nil
 }
+
+// trackUpgrade carries the upgrade tracking over from the current status. An upgrade is tracked from the moment
+// the version changes until the module becomes Ready, so that it can be rolled back once the health deadline passes.
+func (m *ModuleStatusGenerator) trackUpgrade(moduleStatus, currentStatus *v1beta2.ModuleStatus,
+	rolledBackVersion string,
+) {
+	if currentStatus == nil {
+		return
+	}
+	moduleStatus.FailedVersions = currentStatus.FailedVersions
+
+	if rolledBackVersion != "" {
+		moduleStatus.Upgrade = nil
+		if moduleStatus.State == shared.StateReady {
+			moduleStatus.State = shared.StateWarning
+		}
+		moduleStatus.Message = fmt.Sprintf("version %s was rolled back to %s "+
+			"as it did not become ready within the health deadline", rolledBackVersion, moduleStatus.Version)
+		return
+	}
+
+	if moduleStatus.State == shared.StateReady {
+		moduleStatus.Upgrade = nil
+		return
+	}
+
+	if currentStatus.Upgrade != nil {
+		moduleStatus.Upgrade = currentStatus.Upgrade.DeepCopy()
+		// Another upgrade on top of an unhealthy one keeps the last healthy version to roll back to.
+		if currentStatus.Version != moduleStatus.Version {
+			moduleStatus.Upgrade.StartedAt = apimetav1.NewTime(m.now())
+		}
+		return
+	}
+
+	if currentStatus.Version != "" && currentStatus.Version != moduleStatus.Version {
+		moduleStatus.Upgrade = &v1beta2.ModuleUpgrade{
+			PreviousVersion:  currentStatus.Version,
+			PreviousTemplate: currentStatus.Template,
+			StartedAt:        apimetav1.NewTime(m.now()),
+		}
+	}
+}
@@ -3,9 +3,11 @@ package generator_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
@@ -186,6 +188,103 @@ func TestGenerateModuleStatus_WhenModuleIsUnmanaged_StateIsUnmanagedAndTrackingO
 	assert.Nil(t, result.Resource)
 }
 
+func TestGenerateModuleStatus_WhenVersionChanges_TracksUpgrade(t *testing.T) {
+	module := createModule()
+	module.Manifest.Spec.Version = "2.0.0"
+	currentStatus := &v1beta2.ModuleStatus{
+		Version:  "1.0.0",
+		Template: &v1beta2.TrackingObject{PartialMeta: v1beta2.PartialMeta{Name: "previous-template"}},
+	}
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError, generator.WithClock(fixedClock))
+	result, err := statusGenerator.GenerateModuleStatus(module, currentStatus)
+
+	require.NoError(t, err)
+	require.NotNil(t, result.Upgrade)
+	assert.Equal(t, "1.0.0", result.Upgrade.PreviousVersion)
+	assert.Equal(t, "previous-template", result.Upgrade.PreviousTemplate.Name)
+	assert.Equal(t, apimetav1.NewTime(fixedClock()), result.Upgrade.StartedAt)
+}
+
+func TestGenerateModuleStatus_WhenUpgradedDuringUpgrade_RestartsUpgrade(t *testing.T) {
+	module := createModule()
+	module.Manifest.Spec.Version = "3.0.0"
+	currentStatus := &v1beta2.ModuleStatus{
+		Version: "2.0.0",
+		Upgrade: &v1beta2.ModuleUpgrade{
+			PreviousVersion: "1.0.0",
+			StartedAt:       apimetav1.NewTime(fixedClock().Add(-time.Hour)),
+		},
+	}
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError, generator.WithClock(fixedClock))
+	result, err := statusGenerator.GenerateModuleStatus(module, currentStatus)
+
+	require.NoError(t, err)
+	require.NotNil(t, result.Upgrade)
+	assert.Equal(t, "1.0.0", result.Upgrade.PreviousVersion)
+	assert.Equal(t, apimetav1.NewTime(fixedClock()), result.Upgrade.StartedAt)
+}
+
+func TestGenerateModuleStatus_WhenUpgradeInProgress_KeepsUpgrade(t *testing.T) {
+	module := createModule()
+	module.Manifest.Spec.Version = "2.0.0"
+	startedAt := apimetav1.NewTime(fixedClock().Add(-time.Hour))
+	currentStatus := &v1beta2.ModuleStatus{
+		Version:        "2.0.0",
+		FailedVersions: []string{"1.5.0"},
+		Upgrade:        &v1beta2.ModuleUpgrade{PreviousVersion: "1.0.0", StartedAt: startedAt},
+	}
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError, generator.WithClock(fixedClock))
+	result, err := statusGenerator.GenerateModuleStatus(module, currentStatus)
+
+	require.NoError(t, err)
+	require.NotNil(t, result.Upgrade)
+	assert.Equal(t, "1.0.0", result.Upgrade.PreviousVersion)
+	assert.Equal(t, startedAt, result.Upgrade.StartedAt)
+	assert.Equal(t, []string{"1.5.0"}, result.FailedVersions)
+}
+
+func TestGenerateModuleStatus_WhenModuleReady_FinishesUpgrade(t *testing.T) {
+	module := createModule()
+	module.Manifest.Spec.Version = "2.0.0"
+	module.Manifest.Status.State = shared.StateReady
+	currentStatus := &v1beta2.ModuleStatus{
+		Version: "2.0.0",
+		Upgrade: &v1beta2.ModuleUpgrade{PreviousVersion: "1.0.0", StartedAt: apimetav1.NewTime(fixedClock())},
+	}
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError)
+	result, err := statusGenerator.GenerateModuleStatus(module, currentStatus)
+
+	require.NoError(t, err)
+	assert.Nil(t, result.Upgrade)
+	assert.Equal(t, shared.StateReady, result.State)
+}
+
+func TestGenerateModuleStatus_WhenVersionRolledBack_SetsWarning(t *testing.T) {
+	module := createModule()
+	module.TemplateInfo.RolledBackVersion = "2.0.0"
+	module.Manifest.Spec.Version = "1.0.0"
+	module.Manifest.Status.State = shared.StateReady
+	currentStatus := &v1beta2.ModuleStatus{
+		Version:        "2.0.0",
+		FailedVersions: []string{"2.0.0"},
+		Upgrade:        &v1beta2.ModuleUpgrade{PreviousVersion: "1.0.0", StartedAt: apimetav1.NewTime(fixedClock())},
+	}
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError)
+	result, err := statusGenerator.GenerateModuleStatus(module, currentStatus)
+
+	require.NoError(t, err)
+	assert.Nil(t, result.Upgrade)
+	assert.Equal(t, shared.StateWarning, result.State)
+	assert.Equal(t, "1.0.0", result.Version)
+	assert.Equal(t, []string{"2.0.0"}, result.FailedVersions)
+	assert.Contains(t, result.Message, "version 2.0.0 was rolled back to 1.0.0")
+}
+
 // Resource creator helper functions
 
 func createModule() *modulecommon.Module {
@@ -250,3 +349,7 @@ func createClusterScopedModuleTemplate() *v1beta2.ModuleTemplate {
 var noOpGenerateFromError = func(_ error, _, _, _ string, _ *v1beta2.ModuleStatus) (*v1beta2.ModuleStatus, error) {
 	return nil, nil
 }
+
+func fixedClock() time.Time {
+	return time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+}
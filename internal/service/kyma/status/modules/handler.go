@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
@@ -98,6 +99,7 @@ func DeleteNoLongerExistingModuleStatus(ctx context.Context, kyma *v1beta2.Kyma,
 			delete(moduleStatusMap, moduleStatus.Name)
 		} else {
 			moduleStatus.State = stateFromManifest(manifestCR)
+			moduleStatus.DeletionBlockers = deletionBlockersFromManifest(manifestCR)
 		}
 	}
 	kyma.Status.Modules = convertToNewModuleStatus(moduleStatusMap)
@@ -115,6 +117,26 @@ func stateFromManifest(obj client.Object) shared.State {
 	}
 }
 
+func deletionBlockersFromManifest(obj client.Object) *shared.DeletionBlockers {
+	switch manifest := obj.(type) {
+	case *v1beta2.Manifest:
+		return manifest.Status.DeletionBlockers.DeepCopy()
+	case *unstructured.Unstructured:
+		blockers, found, err := unstructured.NestedMap(manifest.Object, "status", "deletionBlockers")
+		if !found || err != nil {
+			return nil
+		}
+		deletionBlockers := &shared.DeletionBlockers{}
+		if err := machineryruntime.DefaultUnstructuredConverter.FromUnstructured(blockers,
+			deletionBlockers); err != nil {
+			return nil
+		}
+		return deletionBlockers
+	default:
+		return nil
+	}
+}
+
 func (m *StatusHandler) getModule(ctx context.Context, module client.Object) error {
 	err := m.kcpClient.Get(ctx, client.ObjectKey{Namespace: module.GetNamespace(), Name: module.GetName()}, module)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -150,6 +151,40 @@ func TestDeleteNoLongerExistingModuleStatus(t *testing.T) {
 	}
 }
 
+func TestDeleteNoLongerExistingModuleStatus_WhenManifestDeletionBlocked_CopiesDeletionBlockers(t *testing.T) {
+	kyma := testutils.NewTestKyma("test-kyma")
+	configureModuleInKyma(kyma, []string{}, []string{ModuleToBeRemoved})
+	getModule := func(_ context.Context, module client.Object) error {
+		manifest, ok := module.(*unstructured.Unstructured)
+		require.True(t, ok)
+		manifest.Object["status"] = map[string]any{
+			"state": string(shared.StateDeleting),
+			"deletionBlockers": map[string]any{
+				"count": int64(1),
+				"resources": []any{
+					map[string]any{
+						"group": "operator.kyma-project.io", "version": "v1alpha1", "kind": "Sample",
+						"name": "sample-b", "namespace": "default",
+					},
+				},
+			},
+		}
+		return nil
+	}
+
+	modules.DeleteNoLongerExistingModuleStatus(t.Context(), kyma, getModule, nil)
+
+	require.Len(t, kyma.Status.Modules, 1)
+	moduleStatus := kyma.Status.Modules[0]
+	assert.Equal(t, shared.StateDeleting, moduleStatus.State)
+	require.NotNil(t, moduleStatus.DeletionBlockers)
+	assert.Equal(t, 1, moduleStatus.DeletionBlockers.Count)
+	require.Len(t, moduleStatus.DeletionBlockers.Resources, 1)
+	assert.Equal(t, "sample-b", moduleStatus.DeletionBlockers.Resources[0].Name)
+	assert.Equal(t, "default", moduleStatus.DeletionBlockers.Resources[0].Namespace)
+	assert.Equal(t, "Sample", moduleStatus.DeletionBlockers.Resources[0].Kind)
+}
+
 func configureModuleInKyma(
 	kyma *v1beta2.Kyma,
 	modulesInKymaSpec, modulesInKymaStatus []string,
@@ -0,0 +1,226 @@
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	apicorev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/watcher/certificate/name"
+	"github.com/kyma-project/lifecycle-manager/internal/service/watcher/certificate/secret/data"
+	"github.com/kyma-project/lifecycle-manager/pkg/log"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// fingerprintBytes is the number of leading bytes of the SHA-256 fingerprint that identify a CA in the metrics.
+const fingerprintBytes = 8
+
+var ErrInvalidCertificate = errors.New("invalid certificate")
+
+type KymaRepository interface {
+	List(ctx context.Context) (*v1beta2.KymaList, error)
+}
+
+type SecretRepository interface {
+	Get(ctx context.Context, name string) (*apicorev1.Secret, error)
+}
+
+type CertificateRepository interface {
+	GetRenewalTime(ctx context.Context, name string) (time.Time, error)
+}
+
+type Metrics interface {
+	SetSkrCertificate(kymaName string, notBefore, notAfter time.Time, renewalIn time.Duration, caInGatewayBundle bool)
+	CleanupSkrCertificate(kymaName string)
+	SetSkrCertificateScanFailed(kymaName string, failed bool)
+	ResetGatewayCABundle(caCount int)
+	SetGatewayCA(position int, fingerprint string, age time.Duration)
+	SetScanTime(scanTime time.Time)
+}
+
+// Scanner takes the inventory of the SKR certificates of all Kymas and of the gateway CA bundle,
+// and exports it as metrics.
+type Scanner struct {
+	kymaRepo          KymaRepository
+	secretRepo        SecretRepository
+	certRepo          CertificateRepository
+	metrics           Metrics
+	gatewaySecretName string
+	// scannedKymas contains the Kymas with exported SKR certificate metrics.
+	scannedKymas map[string]struct{}
+}
+
+func NewScanner(kymaRepo KymaRepository,
+	secretRepo SecretRepository,
+	certRepo CertificateRepository,
+	metrics Metrics,
+	gatewaySecretName string,
+) *Scanner {
+	return &Scanner{
+		kymaRepo:          kymaRepo,
+		secretRepo:        secretRepo,
+		certRepo:          certRepo,
+		metrics:           metrics,
+		gatewaySecretName: gatewaySecretName,
+		scannedKymas:      make(map[string]struct{}),
+	}
+}
+
+// Scan exports the CA composition of the gateway CA bundle and the validity of the SKR certificate of each Kyma.
+// It flags the Kymas whose SKR certificate CA is not part of the gateway CA bundle, as the gateway rejects
+// their watcher requests. Kymas without SKR certificate secret are skipped. A failure for a single Kyma
+// does not stop the scan, but is returned once all Kymas are scanned. The Kyma keeps the metrics of its last
+// successful scan and is flagged as failed.
+func (s *Scanner) Scan(ctx context.Context) error {
+	now := time.Now()
+	bundle, err := s.scanGatewayCABundle(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	kymas, err := s.kymaRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list Kymas: %w", err)
+	}
+
+	var errs []error
+	scannedKymas := make(map[string]struct{}, len(kymas.Items))
+	for _, kyma := range kymas.Items {
+		scanned, err := s.scanSkrCertificate(ctx, kyma.Name, bundle, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to scan SKR certificate of Kyma %s: %w", kyma.Name, err))
+			s.metrics.SetSkrCertificateScanFailed(kyma.Name, true)
+			scannedKymas[kyma.Name] = struct{}{}
+			continue
+		}
+		if scanned {
+			s.metrics.SetSkrCertificateScanFailed(kyma.Name, false)
+			scannedKymas[kyma.Name] = struct{}{}
+		}
+	}
+
+	for kymaName := range s.scannedKymas {
+		if _, ok := scannedKymas[kymaName]; !ok {
+			s.metrics.CleanupSkrCertificate(kymaName)
+		}
+	}
+	s.scannedKymas = scannedKymas
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	s.metrics.SetScanTime(now)
+	return nil
+}
+
+func (s *Scanner) scanGatewayCABundle(ctx context.Context, now time.Time) ([]*x509.Certificate, error) {
+	gatewaySecret, err := s.secretRepo.Get(ctx, s.gatewaySecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gateway secret: %w", err)
+	}
+	gatewaySecretData, err := data.NewGatewaySecretData(gatewaySecret)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := parseCertificates(gatewaySecretData.CaCert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse gateway CA bundle: %w", err)
+	}
+
+	s.metrics.ResetGatewayCABundle(len(bundle))
+	for position, caCert := range bundle {
+		s.metrics.SetGatewayCA(position, fingerprint(caCert), now.Sub(caCert.NotBefore))
+	}
+	return bundle, nil
+}
+
+func (s *Scanner) scanSkrCertificate(ctx context.Context, kymaName string, bundle []*x509.Certificate,
+	now time.Time,
+) (bool, error) {
+	certName := name.SkrCertificate(kymaName)
+	secret, err := s.secretRepo.Get(ctx, certName)
+	if util.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get SKR certificate secret: %w", err)
+	}
+
+	certs, err := parseCertificates(secret.Data[apicorev1.TLSCertKey])
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s of secret %s: %w", apicorev1.TLSCertKey, certName, err)
+	}
+	if len(certs) == 0 {
+		return false, fmt.Errorf("%w: secret %s has no %s", ErrInvalidCertificate, certName, apicorev1.TLSCertKey)
+	}
+	caCerts, err := parseCertificates(secret.Data[data.CaCertKey])
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s of secret %s: %w", data.CaCertKey, certName, err)
+	}
+
+	renewalTime, err := s.certRepo.GetRenewalTime(ctx, certName)
+	if err != nil {
+		return false, fmt.Errorf("failed to get SKR certificate renewal time: %w", err)
+	}
+
+	caInGatewayBundle := isCAInBundle(certs[0], caCerts, bundle)
+	if !caInGatewayBundle {
+		logf.FromContext(ctx).V(log.InfoLevel).Info("CA of SKR certificate is not part of the gateway CA bundle",
+			"kyma", kymaName, "certificate", certName)
+	}
+	s.metrics.SetSkrCertificate(kymaName, certs[0].NotBefore, certs[0].NotAfter, renewalTime.Sub(now),
+		caInGatewayBundle)
+	return true, nil
+}
+
+// isCAInBundle reports whether the CA of the SKR certificate is part of the bundle. The CA is taken from the
+// ca.crt of the SKR certificate secret; if the secret has none, the CA that signed the certificate is looked up.
+func isCAInBundle(cert *x509.Certificate, caCerts, bundle []*x509.Certificate) bool {
+	for _, bundleCert := range bundle {
+		if len(caCerts) == 0 {
+			if cert.CheckSignatureFrom(bundleCert) == nil {
+				return true
+			}
+			continue
+		}
+		for _, caCert := range caCerts {
+			if bytes.Equal(caCert.Raw, bundleCert.Raw) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func parseCertificates(pemData []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := bytes.TrimSpace(pemData)
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, ErrInvalidCertificate
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+		}
+		certs = append(certs, cert)
+		rest = bytes.TrimSpace(rest)
+	}
+	return certs, nil
+}
+
+func fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:fingerprintBytes])
+}
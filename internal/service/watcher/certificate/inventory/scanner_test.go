@@ -0,0 +1,356 @@
+package inventory_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/watcher/certificate/inventory"
+)
+
+const gatewaySecretName = "klm-istio-gateway"
+
+var errGeneric = errors.New("generic error")
+
+func TestScan_ExportsGatewayCABundleAndSkrCertificates(t *testing.T) {
+	previousCA := newCA(t, time.Now().Add(-48*time.Hour))
+	currentCA := newCA(t, time.Now().Add(-time.Hour))
+	cert := newLeaf(t, currentCA, time.Now().Add(-time.Minute))
+	renewalTime := time.Now().Add(time.Hour)
+	secrets := &secretRepoStub{secrets: map[string]*apicorev1.Secret{
+		gatewaySecretName:    gatewaySecret(currentCA, previousCA),
+		"kyma-1-webhook-tls": skrSecret(cert, currentCA),
+	}}
+	metrics := newMetricsStub()
+	scanner := inventory.NewScanner(kymaRepo("kyma-1"), secrets, &certRepoStub{renewalTime: renewalTime}, metrics,
+		gatewaySecretName)
+
+	err := scanner.Scan(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, metrics.caCount)
+	require.Len(t, metrics.caAges, 2)
+	assert.InDelta(t, time.Hour.Seconds(), metrics.caAges[0].Seconds(), 5)
+	assert.InDelta(t, (48 * time.Hour).Seconds(), metrics.caAges[1].Seconds(), 5)
+	require.Contains(t, metrics.skrCertificates, "kyma-1")
+	skrCert := metrics.skrCertificates["kyma-1"]
+	assert.Equal(t, cert.cert.NotBefore, skrCert.notBefore)
+	assert.Equal(t, cert.cert.NotAfter, skrCert.notAfter)
+	assert.InDelta(t, time.Hour.Seconds(), skrCert.renewalIn.Seconds(), 5)
+	assert.True(t, skrCert.caInGatewayBundle)
+	assert.False(t, metrics.scanTime.IsZero())
+}
+
+func TestScan_WhenSkrCertificateCANotInGatewayBundle_FlagsKyma(t *testing.T) {
+	bundledCA := newCA(t, time.Now().Add(-time.Hour))
+	droppedCA := newCA(t, time.Now().Add(-48*time.Hour))
+	secrets := &secretRepoStub{secrets: map[string]*apicorev1.Secret{
+		gatewaySecretName:    gatewaySecret(bundledCA),
+		"kyma-1-webhook-tls": skrSecret(newLeaf(t, droppedCA, time.Now()), droppedCA),
+		"kyma-2-webhook-tls": skrSecret(newLeaf(t, bundledCA, time.Now()), bundledCA),
+	}}
+	metrics := newMetricsStub()
+	scanner := inventory.NewScanner(kymaRepo("kyma-1", "kyma-2"), secrets, &certRepoStub{}, metrics,
+		gatewaySecretName)
+
+	err := scanner.Scan(t.Context())
+
+	require.NoError(t, err)
+	assert.False(t, metrics.skrCertificates["kyma-1"].caInGatewayBundle)
+	assert.True(t, metrics.skrCertificates["kyma-2"].caInGatewayBundle)
+}
+
+func TestScan_WhenSkrSecretHasNoCA_ChecksSignature(t *testing.T) {
+	bundledCA := newCA(t, time.Now().Add(-time.Hour))
+	otherCA := newCA(t, time.Now().Add(-time.Hour))
+	withoutCA := func(secret *apicorev1.Secret) *apicorev1.Secret {
+		delete(secret.Data, "ca.crt")
+		return secret
+	}
+	secrets := &secretRepoStub{secrets: map[string]*apicorev1.Secret{
+		gatewaySecretName:    gatewaySecret(bundledCA),
+		"kyma-1-webhook-tls": withoutCA(skrSecret(newLeaf(t, bundledCA, time.Now()), bundledCA)),
+		"kyma-2-webhook-tls": withoutCA(skrSecret(newLeaf(t, otherCA, time.Now()), otherCA)),
+	}}
+	metrics := newMetricsStub()
+	scanner := inventory.NewScanner(kymaRepo("kyma-1", "kyma-2"), secrets, &certRepoStub{}, metrics,
+		gatewaySecretName)
+
+	err := scanner.Scan(t.Context())
+
+	require.NoError(t, err)
+	assert.True(t, metrics.skrCertificates["kyma-1"].caInGatewayBundle)
+	assert.False(t, metrics.skrCertificates["kyma-2"].caInGatewayBundle)
+}
+
+func TestScan_WhenSkrSecretMissing_SkipsKyma(t *testing.T) {
+	ca := newCA(t, time.Now())
+	secrets := &secretRepoStub{secrets: map[string]*apicorev1.Secret{
+		gatewaySecretName: gatewaySecret(ca),
+	}}
+	metrics := newMetricsStub()
+	scanner := inventory.NewScanner(kymaRepo("kyma-1"), secrets, &certRepoStub{}, metrics, gatewaySecretName)
+
+	err := scanner.Scan(t.Context())
+
+	require.NoError(t, err)
+	assert.Empty(t, metrics.skrCertificates)
+}
+
+func TestScan_WhenKymaDeleted_CleansUpMetrics(t *testing.T) {
+	ca := newCA(t, time.Now())
+	secrets := &secretRepoStub{secrets: map[string]*apicorev1.Secret{
+		gatewaySecretName:    gatewaySecret(ca),
+		"kyma-1-webhook-tls": skrSecret(newLeaf(t, ca, time.Now()), ca),
+	}}
+	metrics := newMetricsStub()
+	kymas := kymaRepo("kyma-1")
+	scanner := inventory.NewScanner(kymas, secrets, &certRepoStub{}, metrics, gatewaySecretName)
+	require.NoError(t, scanner.Scan(t.Context()))
+	require.Contains(t, metrics.skrCertificates, "kyma-1")
+
+	kymas.kymas.Items = nil
+	err := scanner.Scan(t.Context())
+
+	require.NoError(t, err)
+	assert.NotContains(t, metrics.skrCertificates, "kyma-1")
+}
+
+func TestScan_WhenSkrCertificateFails_ScansRemainingKymas(t *testing.T) {
+	ca := newCA(t, time.Now())
+	invalid := skrSecret(newLeaf(t, ca, time.Now()), ca)
+	invalid.Data[apicorev1.TLSCertKey] = []byte("invalid")
+	secrets := &secretRepoStub{secrets: map[string]*apicorev1.Secret{
+		gatewaySecretName:    gatewaySecret(ca),
+		"kyma-1-webhook-tls": invalid,
+		"kyma-2-webhook-tls": skrSecret(newLeaf(t, ca, time.Now()), ca),
+	}}
+	metrics := newMetricsStub()
+	scanner := inventory.NewScanner(kymaRepo("kyma-1", "kyma-2"), secrets, &certRepoStub{}, metrics,
+		gatewaySecretName)
+
+	err := scanner.Scan(t.Context())
+
+	require.ErrorIs(t, err, inventory.ErrInvalidCertificate)
+	require.ErrorContains(t, err, "kyma-1")
+	assert.Contains(t, metrics.skrCertificates, "kyma-2")
+	assert.True(t, metrics.scanFailed["kyma-1"])
+	assert.False(t, metrics.scanFailed["kyma-2"])
+	assert.True(t, metrics.scanTime.IsZero())
+}
+
+func TestScan_WhenSkrCertificateFailsAfterSuccessfulScan_KeepsMetrics(t *testing.T) {
+	ca := newCA(t, time.Now())
+	skrCertSecret := skrSecret(newLeaf(t, ca, time.Now()), ca)
+	secrets := &secretRepoStub{secrets: map[string]*apicorev1.Secret{
+		gatewaySecretName:    gatewaySecret(ca),
+		"kyma-1-webhook-tls": skrCertSecret,
+	}}
+	metrics := newMetricsStub()
+	scanner := inventory.NewScanner(kymaRepo("kyma-1"), secrets, &certRepoStub{}, metrics, gatewaySecretName)
+	require.NoError(t, scanner.Scan(t.Context()))
+	lastScanned := metrics.skrCertificates["kyma-1"]
+
+	skrCertSecret.Data[apicorev1.TLSCertKey] = []byte("invalid")
+	err := scanner.Scan(t.Context())
+
+	require.ErrorIs(t, err, inventory.ErrInvalidCertificate)
+	assert.Equal(t, lastScanned, metrics.skrCertificates["kyma-1"])
+	assert.True(t, metrics.scanFailed["kyma-1"])
+}
+
+func TestScan_WhenGatewaySecretMissing_ReturnsError(t *testing.T) {
+	scanner := inventory.NewScanner(kymaRepo("kyma-1"), &secretRepoStub{}, &certRepoStub{}, newMetricsStub(),
+		gatewaySecretName)
+
+	err := scanner.Scan(t.Context())
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "failed to get gateway secret")
+}
+
+func TestScan_WhenRenewalTimeFails_ReturnsError(t *testing.T) {
+	ca := newCA(t, time.Now())
+	secrets := &secretRepoStub{secrets: map[string]*apicorev1.Secret{
+		gatewaySecretName:    gatewaySecret(ca),
+		"kyma-1-webhook-tls": skrSecret(newLeaf(t, ca, time.Now()), ca),
+	}}
+	scanner := inventory.NewScanner(kymaRepo("kyma-1"), secrets, &certRepoStub{err: errGeneric}, newMetricsStub(),
+		gatewaySecretName)
+
+	err := scanner.Scan(t.Context())
+
+	require.ErrorIs(t, err, errGeneric)
+}
+
+// Test helpers and stubs
+
+type certificate struct {
+	cert *x509.Certificate
+	key  *rsa.PrivateKey
+	pem  []byte
+}
+
+func newCA(t *testing.T, notBefore time.Time) *certificate {
+	t.Helper()
+	return newCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "klm-watcher-selfsigned-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(90 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+}
+
+func newLeaf(t *testing.T, ca *certificate, notBefore time.Time) *certificate {
+	t.Helper()
+	return newCertificate(t, &x509.Certificate{
+		Subject:   pkix.Name{CommonName: "runtime-id"},
+		NotBefore: notBefore,
+		NotAfter:  notBefore.Add(30 * 24 * time.Hour),
+	}, ca)
+}
+
+func newCertificate(t *testing.T, template *x509.Certificate, issuer *certificate) *certificate {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template.SerialNumber = serialNumber
+	template.NotBefore = template.NotBefore.Truncate(time.Second).UTC()
+	template.NotAfter = template.NotAfter.Truncate(time.Second).UTC()
+
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &certificate{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func gatewaySecret(cas ...*certificate) *apicorev1.Secret {
+	var bundle []byte
+	for _, ca := range cas {
+		bundle = append(bundle, ca.pem...)
+	}
+	return &apicorev1.Secret{
+		ObjectMeta: apimetav1.ObjectMeta{Name: gatewaySecretName},
+		Data:       map[string][]byte{"ca.crt": bundle},
+	}
+}
+
+func skrSecret(cert, ca *certificate) *apicorev1.Secret {
+	return &apicorev1.Secret{
+		Data: map[string][]byte{
+			apicorev1.TLSCertKey:       cert.pem,
+			apicorev1.TLSPrivateKeyKey: []byte("key"),
+			"ca.crt":                   ca.pem,
+		},
+	}
+}
+
+type kymaRepoStub struct {
+	kymas *v1beta2.KymaList
+}
+
+func kymaRepo(names ...string) *kymaRepoStub {
+	kymas := &v1beta2.KymaList{}
+	for _, name := range names {
+		kymas.Items = append(kymas.Items, v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{Name: name}})
+	}
+	return &kymaRepoStub{kymas: kymas}
+}
+
+func (r *kymaRepoStub) List(_ context.Context) (*v1beta2.KymaList, error) {
+	return r.kymas, nil
+}
+
+type secretRepoStub struct {
+	secrets map[string]*apicorev1.Secret
+}
+
+func (r *secretRepoStub) Get(_ context.Context, name string) (*apicorev1.Secret, error) {
+	if secret, ok := r.secrets[name]; ok {
+		return secret, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+}
+
+type certRepoStub struct {
+	renewalTime time.Time
+	err         error
+}
+
+func (r *certRepoStub) GetRenewalTime(_ context.Context, _ string) (time.Time, error) {
+	return r.renewalTime, r.err
+}
+
+type skrCertificate struct {
+	notBefore, notAfter time.Time
+	renewalIn           time.Duration
+	caInGatewayBundle   bool
+}
+
+type metricsStub struct {
+	skrCertificates map[string]skrCertificate
+	scanFailed      map[string]bool
+	caCount         int
+	caAges          map[int]time.Duration
+	scanTime        time.Time
+}
+
+func newMetricsStub() *metricsStub {
+	return &metricsStub{skrCertificates: map[string]skrCertificate{}, scanFailed: map[string]bool{}}
+}
+
+func (m *metricsStub) SetSkrCertificate(kymaName string, notBefore, notAfter time.Time, renewalIn time.Duration,
+	caInGatewayBundle bool,
+) {
+	m.skrCertificates[kymaName] = skrCertificate{notBefore, notAfter, renewalIn, caInGatewayBundle}
+}
+
+func (m *metricsStub) CleanupSkrCertificate(kymaName string) {
+	delete(m.skrCertificates, kymaName)
+	delete(m.scanFailed, kymaName)
+}
+
+func (m *metricsStub) SetSkrCertificateScanFailed(kymaName string, failed bool) {
+	m.scanFailed[kymaName] = failed
+}
+
+func (m *metricsStub) ResetGatewayCABundle(caCount int) {
+	m.caCount = caCount
+	m.caAges = map[int]time.Duration{}
+}
+
+func (m *metricsStub) SetGatewayCA(position int, _ string, age time.Duration) {
+	m.caAges[position] = age
+}
+
+func (m *metricsStub) SetScanTime(scanTime time.Time) {
+	m.scanTime = scanTime
+}
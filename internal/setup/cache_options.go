@@ -15,6 +15,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/common"
 	certmanagercertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/certmanager/certificate" //nolint:revive // not for import
 	gcmcertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/gcm/certificate"
+	nativecertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/native/certificate" //nolint:revive // not for import
 )
 
 const bootstrapFailedExitCode = 1
@@ -117,6 +118,7 @@ func getCertManagementCacheObjects(certificateManagement string, setupLog logr.L
 	cacheObjects, ok := map[string][]client.Object{
 		certmanagerv1.SchemeGroupVersion.String(): certmanagercertificate.GetCacheObjects(),
 		gcertv1alpha1.SchemeGroupVersion.String(): gcmcertificate.GetCacheObjects(),
+		nativecertificate.CertificateManagement:   nativecertificate.GetCacheObjects(),
 	}[certificateManagement]
 
 	if !ok {
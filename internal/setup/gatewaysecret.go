@@ -12,6 +12,7 @@ import (
 	certmanagercertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/certmanager/certificate" //nolint:revive // not for import
 	"github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/config"
 	gcmcertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/gcm/certificate"
+	nativecertificate "github.com/kyma-project/lifecycle-manager/internal/repository/watcher/certificate/native/certificate" //nolint:revive // not for import
 )
 
 //nolint:ireturn // chosen implementation shall be abstracted
@@ -36,6 +37,12 @@ func SetupCertInterface(kcpClient client.Client, flagVar *flags.FlagVar) (gatewa
 			flagVar.SelfSignedCertIssuerNamespace,
 			certificateConfig,
 		)
+	case nativecertificate.CertificateManagement:
+		return nativecertificate.NewRepository(kcpClient,
+			shared.CASecretName,
+			shared.IstioNamespace,
+			certificateConfig,
+		)
 	default:
 		return nil, common.ErrUnsupportedCertificateManagementSystem
 	}
@@ -12,6 +12,7 @@ func Test_AnnotationHasExternalDependencies(t *testing.T) {
 	t.Parallel()
 	assert.Equal(t, "operator.kyma-project.io/is-cluster-scoped", shared.IsClusterScopedAnnotation)
 	assert.Equal(t, "skr-domain", shared.SkrDomainAnnotation)
+	assert.Equal(t, "operator.kyma-project.io/dry-run", shared.DryRunAnnotation)
 }
 
 func Test_LabelHasExternalDependencies(t *testing.T) {
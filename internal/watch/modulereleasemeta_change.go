@@ -3,6 +3,7 @@ package watch
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -77,7 +78,7 @@ func (m TypedModuleReleaseMetaEventHandler[object, request]) Update(ctx context.
 }
 
 // DiffModuleReleaseMetaChannels determines the difference between the old and new ModuleReleaseMeta channels.
-// It returns a map of the channels that have been updated or added.
+// It returns a map of the channels that have been updated or added, including changes to a channel's rollout.
 func DiffModuleReleaseMetaChannels(
 	oldModuleReleaseMeta, newModuleReleaseMeta *v1beta2.ModuleReleaseMeta,
 ) map[string]v1beta2.ChannelVersionAssignment {
@@ -92,7 +93,8 @@ func DiffModuleReleaseMetaChannels(
 	for _, newChannel := range newModuleReleaseMeta.Spec.Channels {
 		newChannels[newChannel.Channel] = newChannel
 		oldChannel, ok := oldChannels[newChannel.Channel]
-		if !ok || oldChannel.Version != newChannel.Version {
+		if !ok || oldChannel.Version != newChannel.Version ||
+			!equality.Semantic.DeepEqual(oldChannel.Rollout, newChannel.Rollout) {
 			diff[newChannel.Channel] = newChannel
 		}
 	}
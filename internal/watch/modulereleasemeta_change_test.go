@@ -12,6 +12,7 @@ import (
 )
 
 func Test_DiffModuleReleaseMetaChannels(t *testing.T) {
+	fivePercent, fiftyPercent := int32(5), int32(50)
 	type args struct {
 		oldModuleReleaseMeta *v1beta2.ModuleReleaseMeta
 		newModuleReleaseMeta *v1beta2.ModuleReleaseMeta
@@ -291,6 +292,101 @@ func Test_DiffModuleReleaseMetaChannels(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Rollout added to channel",
+			args: args{
+				oldModuleReleaseMeta: &v1beta2.ModuleReleaseMeta{
+					Spec: v1beta2.ModuleReleaseMetaSpec{
+						Channels: []v1beta2.ChannelVersionAssignment{
+							{
+								Channel: "regular",
+								Version: "1.0.0",
+							},
+						},
+					},
+				},
+				newModuleReleaseMeta: &v1beta2.ModuleReleaseMeta{
+					Spec: v1beta2.ModuleReleaseMetaSpec{
+						Channels: []v1beta2.ChannelVersionAssignment{
+							{
+								Channel: "regular",
+								Version: "1.0.0",
+								Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &fivePercent},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]v1beta2.ChannelVersionAssignment{
+				"regular": {
+					Channel: "regular",
+					Version: "1.0.0",
+					Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &fivePercent},
+				},
+			},
+		},
+		{
+			name: "Rollout percentage increased",
+			args: args{
+				oldModuleReleaseMeta: &v1beta2.ModuleReleaseMeta{
+					Spec: v1beta2.ModuleReleaseMetaSpec{
+						Channels: []v1beta2.ChannelVersionAssignment{
+							{
+								Channel: "regular",
+								Version: "1.0.0",
+								Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &fivePercent},
+							},
+						},
+					},
+				},
+				newModuleReleaseMeta: &v1beta2.ModuleReleaseMeta{
+					Spec: v1beta2.ModuleReleaseMetaSpec{
+						Channels: []v1beta2.ChannelVersionAssignment{
+							{
+								Channel: "regular",
+								Version: "1.0.0",
+								Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &fiftyPercent},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]v1beta2.ChannelVersionAssignment{
+				"regular": {
+					Channel: "regular",
+					Version: "1.0.0",
+					Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &fiftyPercent},
+				},
+			},
+		},
+		{
+			name: "Rollout unchanged",
+			args: args{
+				oldModuleReleaseMeta: &v1beta2.ModuleReleaseMeta{
+					Spec: v1beta2.ModuleReleaseMetaSpec{
+						Channels: []v1beta2.ChannelVersionAssignment{
+							{
+								Channel: "regular",
+								Version: "1.0.0",
+								Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &fivePercent},
+							},
+						},
+					},
+				},
+				newModuleReleaseMeta: &v1beta2.ModuleReleaseMeta{
+					Spec: v1beta2.ModuleReleaseMetaSpec{
+						Channels: []v1beta2.ChannelVersionAssignment{
+							{
+								Channel: "regular",
+								Version: "1.0.0",
+								Rollout: &v1beta2.ChannelRollout{Version: "1.1.0", Percentage: &fivePercent},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]v1beta2.ChannelVersionAssignment{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
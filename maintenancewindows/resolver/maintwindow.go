@@ -14,6 +14,20 @@ import (
 const (
 	timeOnlyFormat = "15:04:05Z07:00"
 	time24Hours    = 24 * time.Hour
+	// intersectionLookahead bounds the search for an intersection of recurring windows.
+	intersectionLookahead = 8 * time24Hours
+)
+
+// CustomerWindowMode defines how a policy rule treats the maintenance window of a runtime.
+type CustomerWindowMode string
+
+const (
+	// CustomerWindowsIgnore uses the windows of the rule only.
+	CustomerWindowsIgnore CustomerWindowMode = ""
+	// CustomerWindowsOverride uses the window of the runtime instead of the windows of the rule.
+	CustomerWindowsOverride CustomerWindowMode = "Override"
+	// CustomerWindowsIntersect uses the times in which the window of the runtime overlaps the windows of the rule.
+	CustomerWindowsIntersect CustomerWindowMode = "Intersect"
 )
 
 var (
@@ -34,8 +48,9 @@ func (rw ResolvedWindow) String() string {
 }
 
 type MaintenanceWindowPolicy struct {
-	Rules   []MaintenancePolicyRule `json:"rules"`
-	Default MaintenanceWindow       `json:"default"`
+	Rules     []MaintenancePolicyRule     `json:"rules"`
+	Default   MaintenanceWindow           `json:"default"`
+	Blackouts []MaintenancePolicyBlackout `json:"blackouts,omitempty"`
 }
 
 // options.
@@ -152,7 +167,7 @@ func (mwp *MaintenanceWindowPolicy) Resolve(runtime *Runtime, opts ...any) (*Res
 		// this policy is matching
 
 		// we need to find the first window in the future
-		window := policyrule.Windows.LookupAvailable(&options)
+		window := policyrule.LookupAvailable(runtime, &options)
 		if window != nil {
 			return window, nil
 		}
@@ -177,10 +192,55 @@ func (mwp *MaintenanceWindowPolicy) Resolve(runtime *Runtime, opts ...any) (*Res
 	return nil, ErrNoWindowFound
 }
 
+// ActiveBlackout returns the blackout period of the policy the given runtime is in at the given time.
+// The second return value is false if no blackout period is active.
+func (mwp *MaintenanceWindowPolicy) ActiveBlackout(runtime *Runtime, at time.Time) (ResolvedWindow, bool) {
+	for _, blackout := range mwp.Blackouts {
+		if blackout.Match != nil && !blackout.Match.Match(runtime) {
+			continue
+		}
+		for _, window := range blackout.Windows {
+			if rw, active := window.ActiveAt(at); active {
+				return rw, true
+			}
+		}
+	}
+	return ResolvedWindow{}, false
+}
+
+// MaintenancePolicyBlackout defines periods in which no module version change is applied to the matched runtimes.
+// If Match is nil, the blackout applies to all runtimes.
+type MaintenancePolicyBlackout struct {
+	Match   *MaintenancePolicyMatch `json:"match,omitempty"`
+	Windows MaintenanceWindows      `json:"windows"`
+}
+
 type MaintenancePolicyRule struct {
-	Match   MaintenancePolicyMatch `json:"match"`
-	Windows MaintenanceWindows     `json:"windows"`
+	Match           MaintenancePolicyMatch `json:"match"`
+	Windows         MaintenanceWindows     `json:"windows"`
+	CustomerWindows CustomerWindowMode     `json:"customerWindows,omitempty"`
+}
+
+// LookupAvailable returns the next window of the rule for the runtime, taking the window of the runtime
+// into account according to the CustomerWindows mode of the rule.
+func (mpr *MaintenancePolicyRule) LookupAvailable(runtime *Runtime, opts *resolveOptions) *ResolvedWindow {
+	customerWindow, ok := runtime.CustomerWindow()
+	if !ok {
+		return mpr.Windows.LookupAvailable(opts)
+	}
+
+	switch mpr.CustomerWindows {
+	case CustomerWindowsOverride:
+		return customerWindow.NextWindow(opts)
+	case CustomerWindowsIntersect:
+		return mpr.Windows.LookupIntersection(&customerWindow, opts)
+	case CustomerWindowsIgnore:
+		return mpr.Windows.LookupAvailable(opts)
+	}
+	// unknown modes ignore the window of the runtime
+	return mpr.Windows.LookupAvailable(opts)
 }
+
 type MaintenanceWindows []MaintenanceWindow
 
 func (mws *MaintenanceWindows) LookupAvailable(opts *resolveOptions) *ResolvedWindow {
@@ -192,6 +252,17 @@ func (mws *MaintenanceWindows) LookupAvailable(opts *resolveOptions) *ResolvedWi
 	return nil
 }
 
+// LookupIntersection returns the first intersection of a window with the given window
+// that is at least as long as the minimum window size.
+func (mws *MaintenanceWindows) LookupIntersection(other *MaintenanceWindow, opts *resolveOptions) *ResolvedWindow {
+	for _, mw := range *mws {
+		if window := mw.NextIntersection(other, opts); window != nil {
+			return window
+		}
+	}
+	return nil
+}
+
 type MaintenancePolicyMatch struct {
 	GlobalAccountID *Regexp `json:"globalAccountID,omitempty"` //nolint:tagliatelle,revive //changing that now would break the API
 	Plan            *Regexp `json:"plan,omitempty"`
@@ -293,21 +364,11 @@ func (mw *MaintenanceWindow) NextWindow(opts *resolveOptions) *ResolvedWindow {
 		// right here begin and end are simply times within the duration of a day
 		// logic is, we construct today's begin and end timestamps with the supplied
 		// time, and we keep on stepping it day by day until we hit one of the windows
-		begin := time.Date(opts.time.Year(), opts.time.Month(), opts.time.Day(),
-			mw.Begin.T().Hour(), mw.Begin.T().Minute(), mw.Begin.T().Second(),
-			0, mw.Begin.T().Location())
-		end := time.Date(opts.time.Year(), opts.time.Month(), opts.time.Day(),
-			mw.End.T().Hour(), mw.End.T().Minute(), mw.End.T().Second(),
-			0, mw.End.T().Location())
+		begin, end := mw.occurrenceOn(opts.time)
 
 		// next day diff
 		incr := time24Hours
 
-		// if it goes through midnight
-		if end.Before(begin) || end.Equal(begin) {
-			end = end.Add(incr)
-		}
-
 		// now get the next suitable
 		// days are weekdays, and there's a total of 7 of them, so iterating ahead
 		// of that would be getting the next cycle, so we stop at a week's lookahead
@@ -329,30 +390,130 @@ func (mw *MaintenanceWindow) NextWindow(opts *resolveOptions) *ResolvedWindow {
 	return nil
 }
 
+// ActiveAt returns the occurrence of the window that contains the given time.
+// The second return value is false if the window is not active at that time.
+func (mw *MaintenanceWindow) ActiveAt(at time.Time) (ResolvedWindow, bool) {
+	if len(mw.Days) == 0 {
+		begin, end := mw.Begin.T(), mw.End.T()
+		return ResolvedWindow{Begin: begin, End: end}, !at.Before(begin) && at.Before(end)
+	}
+
+	// a recurring window containing the time began either on the same day
+	// or, if it goes through midnight, on the day before
+	day := at.In(mw.Begin.T().Location())
+	for _, date := range []time.Time{day.AddDate(0, 0, -1), day} {
+		begin, end := mw.occurrenceOn(date)
+		if !slices.Contains(mw.Days, begin.Weekday().String()[0:3]) {
+			continue
+		}
+		if !at.Before(begin) && at.Before(end) {
+			return ResolvedWindow{Begin: begin, End: end}, true
+		}
+	}
+	return ResolvedWindow{}, false
+}
+
+// Duration returns the length of a single occurrence of the window.
+func (mw *MaintenanceWindow) Duration() time.Duration {
+	if len(mw.Days) == 0 {
+		return mw.End.T().Sub(mw.Begin.T())
+	}
+	begin, end := mw.occurrenceOn(mw.Begin.T())
+	return end.Sub(begin)
+}
+
+// NextIntersection returns the next time in which the window overlaps the given window
+// for at least the minimum window size.
+func (mw *MaintenanceWindow) NextIntersection(other *MaintenanceWindow, opts *resolveOptions) *ResolvedWindow {
+	until := opts.time.Add(intersectionLookahead)
+	if len(mw.Days) == 0 {
+		until = mw.End.T()
+	}
+
+	for _, occurrence := range mw.occurrencesBetween(opts.time, until) {
+		for _, otherOccurrence := range other.occurrencesBetween(occurrence.Begin, occurrence.End) {
+			begin := laterOf(occurrence.Begin, otherOccurrence.Begin)
+			end := earlierOf(occurrence.End, otherOccurrence.End)
+			if end.Sub(begin) < opts.minDuration {
+				continue
+			}
+			if rw := windowWithin(opts, begin, end); rw != nil {
+				return rw
+			}
+		}
+	}
+	return nil
+}
+
+// occurrencesBetween returns the occurrences of the window overlapping the given time range in chronological order.
+func (mw *MaintenanceWindow) occurrencesBetween(from, until time.Time) []ResolvedWindow {
+	if len(mw.Days) == 0 {
+		if mw.Begin.T().Before(until) && mw.End.T().After(from) {
+			return []ResolvedWindow{{Begin: mw.Begin.T(), End: mw.End.T()}}
+		}
+		return nil
+	}
+
+	var occurrences []ResolvedWindow
+	// an occurrence going through midnight may have begun on the day before
+	for date := from.In(mw.Begin.T().Location()).AddDate(0, 0, -1); date.Before(until); date = date.AddDate(0, 0, 1) {
+		begin, end := mw.occurrenceOn(date)
+		if !slices.Contains(mw.Days, begin.Weekday().String()[0:3]) {
+			continue
+		}
+		if begin.Before(until) && end.After(from) {
+			occurrences = append(occurrences, ResolvedWindow{Begin: begin, End: end})
+		}
+	}
+	return occurrences
+}
+
+// occurrenceOn returns the begin and end of a recurring window starting on the date of the given time.
+func (mw *MaintenanceWindow) occurrenceOn(date time.Time) (time.Time, time.Time) {
+	begin := time.Date(date.Year(), date.Month(), date.Day(),
+		mw.Begin.T().Hour(), mw.Begin.T().Minute(), mw.Begin.T().Second(),
+		0, mw.Begin.T().Location())
+	end := time.Date(date.Year(), date.Month(), date.Day(),
+		mw.End.T().Hour(), mw.End.T().Minute(), mw.End.T().Second(),
+		0, mw.End.T().Location())
+
+	// if it goes through midnight
+	if end.Before(begin) || end.Equal(begin) {
+		end = end.Add(time24Hours)
+	}
+	return begin, end
+}
+
 // WindowTime is a time.Time alias used for (un)marshalling.
 type WindowTime time.Time
 
 func (wt *WindowTime) UnmarshalJSON(data []byte) error {
-	trimmed := string(bytes.Trim(data, `"`))
+	parsed, err := ParseWindowTime(string(bytes.Trim(data, `"`)))
+	if err != nil {
+		return err
+	}
+	*wt = parsed
+	return nil
+}
 
+// ParseWindowTime parses an ISO8601 timestamp or a time-only value with timezone into a WindowTime.
+func ParseWindowTime(value string) (WindowTime, error) {
 	// try the fullformat first
-	tParsed, err := time.Parse(time.RFC3339, trimmed)
+	tParsed, err := time.Parse(time.RFC3339, value)
 	if err == nil {
-		*wt = WindowTime(tParsed)
-		return nil
+		return WindowTime(tParsed), nil
 	}
 
 	// now try the time-only format
-	tParsed, err = time.Parse(timeOnlyFormat, trimmed)
+	tParsed, err = time.Parse(timeOnlyFormat, value)
 	if err == nil {
-		*wt = WindowTime(tParsed)
-		return nil
+		return WindowTime(tParsed), nil
 	}
 
-	return &json.UnsupportedValueError{
-		Value: reflect.ValueOf(trimmed),
+	return WindowTime{}, &json.UnsupportedValueError{
+		Value: reflect.ValueOf(value),
 		Str: fmt.Sprintf("Unable to parse value \"%s\" as ISO8601 or timeonly-with-tz",
-			trimmed),
+			value),
 	}
 }
 
@@ -361,6 +522,20 @@ func (wt *WindowTime) T() time.Time {
 }
 
 // utility functions.
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlierOf(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
 func windowWithin(opts *resolveOptions, begin time.Time, end time.Time) *ResolvedWindow {
 	if !opts.ongoing {
 		// simple, just verify whether the begin is in the future
@@ -330,3 +330,223 @@ func Test_MPMString(t *testing.T) {
 	)
 	require.Equal(t, expected, data.String())
 }
+
+func Test_ParseWindowTime(t *testing.T) {
+	timestamp, err := resolver.ParseWindowTime("2026-01-10T02:00:00Z")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 10, 2, 0, 0, 0, time.UTC), timestamp.T())
+
+	timeOnly, err := resolver.ParseWindowTime("02:30:00+01:00")
+	require.NoError(t, err)
+	require.Equal(t, 1, timeOnly.T().UTC().Hour())
+	require.Equal(t, 30, timeOnly.T().Minute())
+
+	_, err = resolver.ParseWindowTime("tomorrow")
+	require.Error(t, err)
+}
+
+func Test_ActiveBlackout(t *testing.T) {
+	policy, err := resolver.NewMaintenanceWindowPolicyFromJSON([]byte(`{
+		"rules": [],
+		"default": {"days": ["Sat"], "begin": "01:00:00Z", "end": "05:00:00Z"},
+		"blackouts": [
+			{
+				"windows": [{"begin": "2025-12-20T00:00:00Z", "end": "2026-01-05T00:00:00Z"}]
+			},
+			{
+				"match": {"plan": "aws"},
+				"windows": [{"days": ["Fri"], "begin": "22:00:00Z", "end": "04:00:00Z"}]
+			}
+		]
+	}`))
+	require.NoError(t, err)
+	awsRuntime := createRuntime("", "aws", "", "")
+	azureRuntime := createRuntime("", "azure", "", "")
+
+	tests := []struct {
+		name     string
+		runtime  resolver.Runtime
+		at       string
+		active   bool
+		expected resolver.ResolvedWindow
+	}{
+		{
+			name:     "absolute blackout applies to all runtimes",
+			runtime:  azureRuntime,
+			at:       "2025-12-24T12:00:00Z",
+			active:   true,
+			expected: resWin("2025-12-20T00:00:00Z", "2026-01-05T00:00:00Z"),
+		},
+		{
+			name:    "absolute blackout has ended",
+			runtime: azureRuntime,
+			at:      "2026-01-05T00:00:00Z",
+			active:  false,
+		},
+		{
+			name:     "recurring blackout on its day",
+			runtime:  awsRuntime,
+			at:       "2026-01-09T23:00:00Z",
+			active:   true,
+			expected: resWin("2026-01-09T22:00:00Z", "2026-01-10T04:00:00Z"),
+		},
+		{
+			name:     "recurring blackout through midnight",
+			runtime:  awsRuntime,
+			at:       "2026-01-10T03:00:00Z",
+			active:   true,
+			expected: resWin("2026-01-09T22:00:00Z", "2026-01-10T04:00:00Z"),
+		},
+		{
+			name:    "recurring blackout on another day",
+			runtime: awsRuntime,
+			at:      "2026-01-10T23:00:00Z",
+			active:  false,
+		},
+		{
+			name:    "recurring blackout of unmatched runtime",
+			runtime: azureRuntime,
+			at:      "2026-01-09T23:00:00Z",
+			active:  false,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			window, active := policy.ActiveBlackout(&testCase.runtime, time.Time(at(testCase.at)))
+
+			require.Equal(t, testCase.active, active)
+			if testCase.active {
+				require.True(t, testCase.expected.Begin.Equal(window.Begin), "begin: %s", window.Begin)
+				require.True(t, testCase.expected.End.Equal(window.End), "end: %s", window.End)
+			}
+		})
+	}
+}
+
+func Test_Resolve_CustomerWindows(t *testing.T) {
+	policy, err := resolver.NewMaintenanceWindowPolicyFromJSON([]byte(`{
+		"rules": [
+			{
+				"match": {"plan": "override"},
+				"customerWindows": "Override",
+				"windows": [{"days": ["Sat"], "begin": "01:00:00Z", "end": "05:00:00Z"}]
+			},
+			{
+				"match": {"plan": "intersect"},
+				"customerWindows": "Intersect",
+				"windows": [{"days": ["Sat"], "begin": "01:00:00Z", "end": "05:00:00Z"}]
+			},
+			{
+				"match": {"plan": "ignore"},
+				"windows": [{"days": ["Sat"], "begin": "01:00:00Z", "end": "05:00:00Z"}]
+			}
+		],
+		"default": {"days": ["Sun"], "begin": "01:00:00Z", "end": "05:00:00Z"}
+	}`))
+	require.NoError(t, err)
+
+	saturdayNight := customerRuntime("", "03:00:00Z", "07:00:00Z", "Sat")
+	tests := []struct {
+		name     string
+		runtime  resolver.Runtime
+		opts     []any
+		expected *resolver.ResolvedWindow
+	}{
+		{
+			name:     "override uses the customer window",
+			runtime:  customerRuntime("override", "03:00:00Z", "07:00:00Z", "Sat"),
+			expected: ptr(resWin("2026-01-10T03:00:00Z", "2026-01-10T07:00:00Z")),
+		},
+		{
+			name:     "override without customer window uses the rule windows",
+			runtime:  createRuntime("", "override", "", ""),
+			expected: ptr(resWin("2026-01-10T01:00:00Z", "2026-01-10T05:00:00Z")),
+		},
+		{
+			name:     "intersect uses the overlap",
+			runtime:  withPlan(saturdayNight, "intersect"),
+			expected: ptr(resWin("2026-01-10T03:00:00Z", "2026-01-10T05:00:00Z")),
+		},
+		{
+			name:     "intersect skips overlaps shorter than the minimum window size",
+			runtime:  withPlan(saturdayNight, "intersect"),
+			opts:     []any{resolver.MinWindowSize(3 * time.Hour)},
+			expected: ptr(resWin("2026-01-11T01:00:00Z", "2026-01-11T05:00:00Z")),
+		},
+		{
+			name:     "intersect without overlap falls back to the default",
+			runtime:  customerRuntime("intersect", "10:00:00Z", "12:00:00Z", "Sat"),
+			expected: ptr(resWin("2026-01-11T01:00:00Z", "2026-01-11T05:00:00Z")),
+		},
+		{
+			name:     "intersect with an absolute customer window",
+			runtime:  customerRuntime("intersect", "2026-01-10T04:00:00Z", "2026-01-10T08:00:00Z", ""),
+			expected: ptr(resWin("2026-01-10T04:00:00Z", "2026-01-10T05:00:00Z")),
+		},
+		{
+			name:     "ignore uses the rule windows",
+			runtime:  withPlan(saturdayNight, "ignore"),
+			expected: ptr(resWin("2026-01-10T01:00:00Z", "2026-01-10T05:00:00Z")),
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			opts := append([]any{at("2026-01-08T12:00:00Z")}, testCase.opts...)
+
+			window, err := policy.Resolve(&testCase.runtime, opts...)
+
+			require.NoError(t, err)
+			require.True(t, testCase.expected.Begin.Equal(window.Begin), "begin: %s", window.Begin)
+			require.True(t, testCase.expected.End.Equal(window.End), "end: %s", window.End)
+		})
+	}
+}
+
+func Test_MaintenanceWindow_Duration(t *testing.T) {
+	recurring := resolver.MaintenanceWindow{
+		Days:  []string{"Sat"},
+		Begin: windowTime(t, "22:00:00Z"),
+		End:   windowTime(t, "02:00:00Z"),
+	}
+	absolute := resolver.MaintenanceWindow{
+		Begin: windowTime(t, "2026-01-10T02:00:00Z"),
+		End:   windowTime(t, "2026-01-10T02:30:00Z"),
+	}
+
+	require.Equal(t, 4*time.Hour, recurring.Duration())
+	require.Equal(t, 30*time.Minute, absolute.Duration())
+}
+
+func customerRuntime(plan, begin, end, days string) resolver.Runtime {
+	runtime := createRuntime("", plan, "", "")
+	beginTime, err := resolver.ParseWindowTime(begin)
+	if err != nil {
+		panic(err.Error())
+	}
+	endTime, err := resolver.ParseWindowTime(end)
+	if err != nil {
+		panic(err.Error())
+	}
+	runtime.MaintenanceWindowBegin = beginTime.T()
+	runtime.MaintenanceWindowEnd = endTime.T()
+	if days != "" {
+		runtime.MaintenanceDays = strings.Split(days, ",")
+	}
+	return runtime
+}
+
+func withPlan(runtime resolver.Runtime, plan string) resolver.Runtime {
+	runtime.Plan = plan
+	return runtime
+}
+
+func windowTime(t *testing.T, value string) resolver.WindowTime {
+	t.Helper()
+	parsed, err := resolver.ParseWindowTime(value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -33,6 +33,19 @@ type Runtime struct {
 	MaintenanceDays        []string
 }
 
+// CustomerWindow returns the maintenance window chosen for the runtime.
+// The second return value is false if the runtime has no window of its own.
+func (r *Runtime) CustomerWindow() (MaintenanceWindow, bool) {
+	if r.MaintenanceWindowBegin.IsZero() || r.MaintenanceWindowEnd.IsZero() {
+		return MaintenanceWindow{}, false
+	}
+	return MaintenanceWindow{
+		Days:  r.MaintenanceDays,
+		Begin: WindowTime(r.MaintenanceWindowBegin),
+		End:   WindowTime(r.MaintenanceWindowEnd),
+	}, true
+}
+
 // GetMaintenancePolicyPool extracts and returns the maintenance policies we have under the policy directory.
 func GetMaintenancePolicyPool() (map[string]*[]byte, error) {
 	pool := map[string]*[]byte{}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"strings"
@@ -25,6 +26,45 @@ type (
 	}
 )
 
+// ContainsRolledBackModule returns true if the upgrade of any module was rolled back.
+func (m Modules) ContainsRolledBackModule() bool {
+	for _, module := range m {
+		if module.TemplateInfo != nil && module.TemplateInfo.RolledBackVersion != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiredBy maps the name of each module that other modules depend on to the names of the depending modules.
+// Modules that are being deleted are included, so that a module is deleted only after its dependents.
+func (m Modules) RequiredBy() map[string][]string {
+	requiredBy := make(map[string][]string)
+	for _, module := range m {
+		if module.TemplateInfo == nil || module.TemplateInfo.ModuleTemplate == nil {
+			continue
+		}
+		for _, dependency := range module.TemplateInfo.Spec.DependsOn {
+			requiredBy[dependency.Name] = append(requiredBy[dependency.Name], module.ModuleName)
+		}
+	}
+	return requiredBy
+}
+
+// ContainsUnresolvableDependency returns true if the dependencies of any module are cyclic or not met.
+func (m Modules) ContainsUnresolvableDependency() bool {
+	for _, module := range m {
+		if module.TemplateInfo == nil {
+			continue
+		}
+		if errors.Is(module.TemplateInfo.Err, templatelookup.ErrModuleDependencyCycle) ||
+			errors.Is(module.TemplateInfo.Err, templatelookup.ErrModuleDependencyNotMet) {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *Module) Logger(base logr.Logger) logr.Logger {
 	return base.WithValues(
 		"fqdn", m.FQDN,
@@ -34,6 +74,31 @@ func (m *Module) Logger(base logr.Logger) logr.Logger {
 	)
 }
 
+// runtimeLabels are the labels describing the runtime that are copied from the Kyma to its Manifests,
+// so that the manifest templating can use them.
+var runtimeLabels = []string{
+	shared.GlobalAccountIDLabel,
+	shared.SubAccountIDLabel,
+	shared.RegionLabel,
+	shared.PlatformRegionLabel,
+	shared.PlanLabel,
+	shared.RuntimeIDLabel,
+}
+
+// HasSameRuntimeLabels reports whether both Manifests carry the same runtime labels.
+// As the runtime labels are the values of the manifest templating, a Manifest with different runtime labels
+// must be updated even if its spec is unchanged.
+func HasSameRuntimeLabels(first, second *v1beta2.Manifest) bool {
+	for _, runtimeLabel := range runtimeLabels {
+		firstValue, firstFound := first.GetLabels()[runtimeLabel]
+		secondValue, secondFound := second.GetLabels()[runtimeLabel]
+		if firstFound != secondFound || firstValue != secondValue {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *Module) ApplyDefaultMetaToManifest(kyma *v1beta2.Kyma) {
 	lbls := m.Manifest.GetLabels()
 	if lbls == nil {
@@ -50,6 +115,12 @@ func (m *Module) ApplyDefaultMetaToManifest(kyma *v1beta2.Kyma) {
 		lbls[shared.ChannelLabel] = m.TemplateInfo.DesiredChannel
 	}
 
+	for _, runtimeLabel := range runtimeLabels {
+		if value, ok := kyma.GetLabels()[runtimeLabel]; ok {
+			lbls[runtimeLabel] = value
+		}
+	}
+
 	lbls[shared.ManagedBy] = shared.OperatorName
 	if m.TemplateInfo.Spec.Mandatory {
 		lbls[shared.IsMandatoryModule] = shared.EnableLabelValue
@@ -108,6 +108,112 @@ func TestApplyDefaultMetaToManifest_WhenCalledWithUnmanaged_SetsUnmanagedAnnotat
 	assert.Equal(t, "true", resultAnnotations["operator.kyma-project.io/is-unmanaged"])
 }
 
+func TestContainsRolledBackModule_WhenNoModuleWasRolledBack_ReturnsFalse(t *testing.T) {
+	modules := modulecommon.Modules{createModule(), {ModuleName: "without-template-info"}}
+
+	assert.False(t, modules.ContainsRolledBackModule())
+}
+
+func TestContainsRolledBackModule_WhenModuleWasRolledBack_ReturnsTrue(t *testing.T) {
+	rolledBackModule := createModule()
+	rolledBackModule.TemplateInfo.RolledBackVersion = "2.0.0"
+	modules := modulecommon.Modules{createModule(), rolledBackModule}
+
+	assert.True(t, modules.ContainsRolledBackModule())
+}
+
+func TestRequiredBy_ReturnsDependingModules(t *testing.T) {
+	serverless := createModule()
+	serverless.ModuleName = "serverless"
+	serverless.TemplateInfo.Spec.DependsOn = []v1beta2.ModuleDependency{{Name: "istio"}}
+	eventing := createModule()
+	eventing.ModuleName = "eventing"
+	eventing.TemplateInfo.Spec.DependsOn = []v1beta2.ModuleDependency{{Name: "istio"}, {Name: "nats"}}
+	modules := modulecommon.Modules{serverless, eventing, {ModuleName: "without-template-info"}}
+
+	requiredBy := modules.RequiredBy()
+
+	assert.Equal(t, map[string][]string{
+		"istio": {"serverless", "eventing"},
+		"nats":  {"eventing"},
+	}, requiredBy)
+}
+
+func TestContainsUnresolvableDependency(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "without error", err: nil, expected: false},
+		{name: "waiting for dependency", err: templatelookup.ErrWaitingForModuleDependency, expected: false},
+		{name: "dependency not met", err: templatelookup.ErrModuleDependencyNotMet, expected: true},
+		{name: "dependency cycle", err: templatelookup.ErrModuleDependencyCycle, expected: true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			module := createModule()
+			module.TemplateInfo.Err = testCase.err
+
+			assert.Equal(t, testCase.expected, modulecommon.Modules{module}.ContainsUnresolvableDependency())
+		})
+	}
+}
+
+func TestApplyDefaultMetaToManifest_WhenCalled_CopiesRuntimeLabels(t *testing.T) {
+	module := createModule()
+	kyma := &v1beta2.Kyma{}
+	kyma.SetLabels(map[string]string{
+		"kyma-project.io/region":           "europe-west1",
+		"kyma-project.io/broker-plan-name": "gcp",
+		"kyma-project.io/team":             "some-team",
+	})
+
+	module.ApplyDefaultMetaToManifest(kyma)
+
+	resultLabels := module.Manifest.GetLabels()
+	assert.Equal(t, "europe-west1", resultLabels["kyma-project.io/region"])
+	assert.Equal(t, "gcp", resultLabels["kyma-project.io/broker-plan-name"])
+	assert.NotContains(t, resultLabels, "kyma-project.io/global-account-id")
+	assert.NotContains(t, resultLabels, "kyma-project.io/team")
+}
+
+func TestHasSameRuntimeLabels(t *testing.T) {
+	testCases := []struct {
+		name     string
+		first    map[string]string
+		second   map[string]string
+		expected bool
+	}{
+		{
+			name:     "same runtime labels and different other labels",
+			first:    map[string]string{"kyma-project.io/region": "europe-west1", "kyma-project.io/team": "a"},
+			second:   map[string]string{"kyma-project.io/region": "europe-west1"},
+			expected: true,
+		},
+		{
+			name:     "changed runtime label",
+			first:    map[string]string{"kyma-project.io/region": "europe-west1"},
+			second:   map[string]string{"kyma-project.io/region": "us-east1"},
+			expected: false,
+		},
+		{
+			name:     "missing runtime label",
+			first:    map[string]string{"kyma-project.io/region": "europe-west1"},
+			second:   nil,
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			first := &v1beta2.Manifest{ObjectMeta: apimetav1.ObjectMeta{Labels: testCase.first}}
+			second := &v1beta2.Manifest{ObjectMeta: apimetav1.ObjectMeta{Labels: testCase.second}}
+
+			assert.Equal(t, testCase.expected, modulecommon.HasSameRuntimeLabels(first, second))
+		})
+	}
+}
+
 func createModule() *modulecommon.Module {
 	return &modulecommon.Module{
 		Manifest: &v1beta2.Manifest{
@@ -0,0 +1,94 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+type ManifestAction string
+
+const (
+	ManifestActionNone   ManifestAction = "None"
+	ManifestActionCreate ManifestAction = "Create"
+	ManifestActionUpdate ManifestAction = "Update"
+	ManifestActionDelete ManifestAction = "Delete"
+	ManifestActionSkip   ManifestAction = "Skip"
+)
+
+// ManifestChange describes the change ReconcileManifests would apply to the Manifest of a module.
+type ManifestChange struct {
+	Module            *modulecommon.Module
+	Action            ManifestAction
+	NewManifest       *v1beta2.Manifest
+	ManifestInCluster *v1beta2.Manifest
+}
+
+// Plan determines the changes ReconcileManifests would apply to the Manifests of the given modules.
+// It only reads from the cluster, so it can be used to preview a reconciliation.
+func (r *Runner) Plan(ctx context.Context, kyma *v1beta2.Kyma,
+	modules modulecommon.Modules,
+) ([]ManifestChange, error) {
+	moduleStatusMap := kyma.GetModuleStatusMap()
+	changes := make([]ManifestChange, 0, len(modules))
+	for _, module := range modules {
+		if module.TemplateInfo == nil {
+			continue
+		}
+		if errors.Is(module.TemplateInfo.Err, templatelookup.ErrTemplateNotAllowed) {
+			change, err := r.planDeletion(ctx, module)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, change)
+			continue
+		}
+		if module.TemplateInfo.Err != nil {
+			changes = append(changes, ManifestChange{Module: module, Action: ManifestActionSkip})
+			continue
+		}
+
+		newManifest, manifestInCluster, err := r.prepareManifest(ctx, kyma, module)
+		if err != nil {
+			return nil, fmt.Errorf("could not plan module %s: %w", module.ModuleName, err)
+		}
+		changes = append(changes, ManifestChange{
+			Module:            module,
+			Action:            planAction(module, manifestInCluster, newManifest, moduleStatusMap[module.ModuleName]),
+			NewManifest:       newManifest,
+			ManifestInCluster: manifestInCluster,
+		})
+	}
+	return changes, nil
+}
+
+func (r *Runner) planDeletion(ctx context.Context, module *modulecommon.Module) (ManifestChange, error) {
+	manifestInCluster, err := r.getManifest(ctx, module.Manifest.GetName(), module.Manifest.GetNamespace())
+	if err != nil {
+		return ManifestChange{}, err
+	}
+	if manifestInCluster == nil {
+		return ManifestChange{Module: module, Action: ManifestActionNone}, nil
+	}
+	return ManifestChange{Module: module, Action: ManifestActionDelete, ManifestInCluster: manifestInCluster}, nil
+}
+
+// planAction mirrors doUpdateWithStrategy.
+func planAction(module *modulecommon.Module, manifestInCluster, newManifest *v1beta2.Manifest,
+	moduleStatus *v1beta2.ModuleStatus,
+) ManifestAction {
+	if !NeedToUpdate(manifestInCluster, newManifest, moduleStatus, module) {
+		return ManifestActionNone
+	}
+	if manifestInCluster == nil {
+		if module.Enabled {
+			return ManifestActionCreate
+		}
+		return ManifestActionNone
+	}
+	return ManifestActionUpdate
+}
@@ -0,0 +1,141 @@
+package sync_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	k8sclientscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+const (
+	planKymaName       = "test-kyma"
+	planNamespace      = "kcp-system"
+	planModuleName     = "test-module"
+	planManifestName   = "test-kyma-test-module"
+	planRegularChannel = "regular"
+)
+
+func TestPlan_WhenManifestDoesNotExist_ReturnsCreate(t *testing.T) {
+	runner := sync.New(newPlanClient(t))
+	module := newPlanModule("1.0.0", nil)
+
+	changes, err := runner.Plan(t.Context(), newPlanKyma(), modulecommon.Modules{module})
+
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, sync.ManifestActionCreate, changes[0].Action)
+	assert.Nil(t, changes[0].ManifestInCluster)
+	assert.Equal(t, "1.0.0", changes[0].NewManifest.Spec.Version)
+}
+
+func TestPlan_WhenManifestHasOtherVersion_ReturnsUpdate(t *testing.T) {
+	runner := sync.New(newPlanClient(t, newPlanManifest("1.0.0")))
+	module := newPlanModule("1.1.0", nil)
+
+	changes, err := runner.Plan(t.Context(), newPlanKyma(), modulecommon.Modules{module})
+
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, sync.ManifestActionUpdate, changes[0].Action)
+	assert.Equal(t, "1.0.0", changes[0].ManifestInCluster.Spec.Version)
+	assert.Equal(t, "1.1.0", changes[0].NewManifest.Spec.Version)
+}
+
+func TestPlan_WhenManifestIsUpToDate_ReturnsNone(t *testing.T) {
+	runner := sync.New(newPlanClient(t, newPlanManifest("1.0.0")))
+	module := newPlanModule("1.0.0", nil)
+
+	changes, err := runner.Plan(t.Context(), newPlanKyma(), modulecommon.Modules{module})
+
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, sync.ManifestActionNone, changes[0].Action)
+}
+
+func TestPlan_WhenTemplateIsNotAllowed_ReturnsDeleteForExistingManifest(t *testing.T) {
+	runner := sync.New(newPlanClient(t, newPlanManifest("1.0.0")))
+	module := newPlanModule("1.0.0", templatelookup.ErrTemplateNotAllowed)
+
+	changes, err := runner.Plan(t.Context(), newPlanKyma(), modulecommon.Modules{module})
+
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, sync.ManifestActionDelete, changes[0].Action)
+}
+
+func TestPlan_WhenTemplateHasError_ReturnsSkip(t *testing.T) {
+	runner := sync.New(newPlanClient(t))
+	module := newPlanModule("1.0.0", assert.AnError)
+
+	changes, err := runner.Plan(t.Context(), newPlanKyma(), modulecommon.Modules{module})
+
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, sync.ManifestActionSkip, changes[0].Action)
+}
+
+func TestPlan_DoesNotWriteToCluster(t *testing.T) {
+	clnt := newPlanClient(t, newPlanManifest("1.0.0"))
+	runner := sync.New(clnt)
+	module := newPlanModule("1.1.0", nil)
+
+	_, err := runner.Plan(t.Context(), newPlanKyma(), modulecommon.Modules{module})
+
+	require.NoError(t, err)
+	manifest := &v1beta2.Manifest{}
+	require.NoError(t, clnt.Get(t.Context(),
+		client.ObjectKey{Name: planManifestName, Namespace: planNamespace}, manifest))
+	assert.Equal(t, "1.0.0", manifest.Spec.Version)
+}
+
+func newPlanClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, k8sclientscheme.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newPlanKyma() *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: planKymaName, Namespace: planNamespace},
+	}
+}
+
+func newPlanManifest(version string) *v1beta2.Manifest {
+	return &v1beta2.Manifest{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      planManifestName,
+			Namespace: planNamespace,
+			Labels:    map[string]string{shared.ChannelLabel: planRegularChannel},
+		},
+		Spec: v1beta2.ManifestSpec{Version: version},
+	}
+}
+
+func newPlanModule(version string, templateErr error) *modulecommon.Module {
+	return &modulecommon.Module{
+		ModuleName: planModuleName,
+		Enabled:    true,
+		TemplateInfo: &templatelookup.ModuleTemplateInfo{
+			ModuleTemplate: &v1beta2.ModuleTemplate{},
+			Err:            templateErr,
+			DesiredChannel: planRegularChannel,
+		},
+		Manifest: &v1beta2.Manifest{
+			ObjectMeta: apimetav1.ObjectMeta{Name: planManifestName, Namespace: planNamespace},
+			Spec:       v1beta2.ManifestSpec{Version: version},
+		},
+	}
+}
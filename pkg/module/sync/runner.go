@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/json"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -85,30 +87,42 @@ func (r *Runner) ReconcileManifests(ctx context.Context, kyma *v1beta2.Kyma,
 func (r *Runner) updateManifest(ctx context.Context, kyma *v1beta2.Kyma,
 	module *modulecommon.Module,
 ) error {
-	if err := r.setupModule(module, kyma); err != nil {
+	newManifest, manifestInCluster, err := r.prepareManifest(ctx, kyma, module)
+	if err != nil {
+		return err
+	}
+
+	moduleStatus := kyma.GetModuleStatusMap()[module.ModuleName]
+	if err := r.doUpdateWithStrategy(ctx, module, manifestInCluster, newManifest, moduleStatus); err != nil {
 		return err
 	}
+	module.Manifest = newManifest
+	module.Manifest.Status = getManifestStatus(newManifest, manifestInCluster)
+	return nil
+}
+
+// prepareManifest returns the desired Manifest of the module together with the Manifest in the cluster,
+// which is nil if the Manifest does not exist yet.
+func (r *Runner) prepareManifest(ctx context.Context, kyma *v1beta2.Kyma,
+	module *modulecommon.Module,
+) (*v1beta2.Manifest, *v1beta2.Manifest, error) {
+	if err := r.setupModule(module, kyma); err != nil {
+		return nil, nil, err
+	}
 	obj, err := r.converter.ConvertToVersion(module.Manifest, r.versioner)
 	if err != nil {
-		return fmt.Errorf("failed to convert object to version: %w", err)
+		return nil, nil, fmt.Errorf("failed to convert object to version: %w", err)
 	}
 	newManifest, ok := obj.(*v1beta2.Manifest)
 	if !ok {
-		return common.ErrTypeAssert
+		return nil, nil, common.ErrTypeAssert
 	}
 
-	moduleStatus := kyma.GetModuleStatusMap()[module.ModuleName]
 	manifestInCluster, err := r.getManifest(ctx, newManifest.GetName(), newManifest.GetNamespace())
 	if err != nil {
-		return err
-	}
-
-	if err := r.doUpdateWithStrategy(ctx, module, manifestInCluster, newManifest, moduleStatus); err != nil {
-		return err
+		return nil, nil, err
 	}
-	module.Manifest = newManifest
-	module.Manifest.Status = getManifestStatus(newManifest, manifestInCluster)
-	return nil
+	return newManifest, manifestInCluster, nil
 }
 
 func getManifestStatus(manifest, manifestInCluster *v1beta2.Manifest) shared.Status {
@@ -195,7 +209,9 @@ func NeedToUpdate(manifestInCluster, newManifest *v1beta2.Manifest, moduleInStat
 	}
 
 	diffInSpec := newManifest.Spec.Version != manifestInCluster.Spec.Version ||
-		!newManifest.IsSameChannel(manifestInCluster)
+		!newManifest.IsSameChannel(manifestInCluster) ||
+		!isSameResourceOverride(newManifest.Spec.ResourceOverride, manifestInCluster.Spec.ResourceOverride) ||
+		!modulecommon.HasSameRuntimeLabels(newManifest, manifestInCluster)
 	if manifestInCluster.IsMandatoryModule() || moduleInStatus == nil {
 		return diffInSpec
 	}
@@ -205,6 +221,21 @@ func NeedToUpdate(manifestInCluster, newManifest *v1beta2.Manifest, moduleInStat
 	return diffInTemplate || diffInSpec
 }
 
+func isSameResourceOverride(first, second *machineryruntime.RawExtension) bool {
+	var firstObject, secondObject any
+	if first != nil && len(first.Raw) > 0 {
+		if err := json.Unmarshal(first.Raw, &firstObject); err != nil {
+			return false
+		}
+	}
+	if second != nil && len(second.Raw) > 0 {
+		if err := json.Unmarshal(second.Raw, &secondObject); err != nil {
+			return false
+		}
+	}
+	return equality.Semantic.DeepEqual(firstObject, secondObject)
+}
+
 func (r *Runner) deleteManifest(ctx context.Context, module *modulecommon.Module) error {
 	err := r.Delete(ctx, module.Manifest)
 	if util.IsNotFound(err) {
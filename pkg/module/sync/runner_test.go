@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
@@ -281,6 +282,98 @@ func TestNeedToUpdate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"When module config changed, expect update",
+			args{
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.ChannelLabel: "regular"},
+					},
+					Spec: v1beta2.ManifestSpec{
+						Version:          "0.1",
+						ResourceOverride: &machineryruntime.RawExtension{Raw: []byte(`{"spec":{"replicas":1}}`)},
+					},
+				},
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.ChannelLabel: "regular"},
+					},
+					Spec: v1beta2.ManifestSpec{
+						Version:          "0.1",
+						ResourceOverride: &machineryruntime.RawExtension{Raw: []byte(`{"spec":{"replicas":3}}`)},
+					},
+				},
+				nil,
+				&modulecommon.Module{},
+			},
+			true,
+		},
+		{
+			"When module config is equal in different formatting, expect no update",
+			args{
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.ChannelLabel: "regular"},
+					},
+					Spec: v1beta2.ManifestSpec{
+						Version:          "0.1",
+						ResourceOverride: &machineryruntime.RawExtension{Raw: []byte(`{"spec":{"a":1,"b":2}}`)},
+					},
+				},
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.ChannelLabel: "regular"},
+					},
+					Spec: v1beta2.ManifestSpec{
+						Version:          "0.1",
+						ResourceOverride: &machineryruntime.RawExtension{Raw: []byte(`{"spec": {"b": 2, "a": 1}}`)},
+					},
+				},
+				nil,
+				&modulecommon.Module{},
+			},
+			false,
+		},
+		{
+			"When runtime label changed, expect update",
+			args{
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.ChannelLabel: "regular", shared.RegionLabel: "europe-west1"},
+					},
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.ChannelLabel: "regular", shared.RegionLabel: "us-east1"},
+					},
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				nil,
+				&modulecommon.Module{},
+			},
+			true,
+		},
+		{
+			"When runtime label is added, expect update",
+			args{
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.ChannelLabel: "regular"},
+					},
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.ChannelLabel: "regular", shared.RegionLabel: "us-east1"},
+					},
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				nil,
+				&modulecommon.Module{},
+			},
+			true,
+		},
 		{
 			"When moduleTemplate Generation updated, expect update",
 			args{
@@ -0,0 +1,169 @@
+package templatelookup
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var (
+	ErrModuleDependencyCycle      = errors.New("module dependencies are cyclic")
+	ErrModuleDependencyNotMet     = errors.New("module dependency not met")
+	ErrWaitingForModuleDependency = errors.New("waiting for module dependency to become ready")
+)
+
+// markModuleDependencies validates the dependencies of all enabled modules in topological order.
+// A module in a dependency cycle or with an unmet dependency is marked with an error, which is propagated
+// to all modules that depend on it. A module that is not installed yet waits until its dependencies are Ready,
+// so that modules are installed in dependency order.
+func markModuleDependencies(kyma *v1beta2.Kyma, templates ModuleTemplatesByModuleName) {
+	enabledModules := make(map[string]bool, len(kyma.Spec.Modules))
+	for _, module := range kyma.Spec.Modules {
+		enabledModules[module.Name] = true
+	}
+
+	order, cyclic := sortByDependencies(templates, enabledModules)
+	for _, moduleName := range cyclic {
+		templateInfo := templates[moduleName]
+		if templateInfo.Err == nil {
+			templateInfo.Err = fmt.Errorf("%w: module %s is part of or depends on a cycle",
+				ErrModuleDependencyCycle, moduleName)
+		}
+	}
+
+	moduleStatusMap := kyma.GetModuleStatusMap()
+	for _, moduleName := range order {
+		templateInfo := templates[moduleName]
+		if templateInfo.Err != nil {
+			continue
+		}
+		for _, dependency := range templateInfo.Spec.DependsOn {
+			err := checkDependency(moduleName, dependency, templates, enabledModules, moduleStatusMap)
+			if err != nil {
+				templateInfo.Err = err
+				break
+			}
+		}
+	}
+}
+
+// sortByDependencies returns the names of the enabled modules in an order in which each module follows
+// the modules it depends on. Modules that are part of a cycle, or depend on one, are returned separately.
+func sortByDependencies(templates ModuleTemplatesByModuleName,
+	enabledModules map[string]bool,
+) ([]string, []string) {
+	dependents := make(map[string][]string)
+	pendingDependencies := make(map[string]int)
+	for moduleName, templateInfo := range templates {
+		if !enabledModules[moduleName] || templateInfo == nil || templateInfo.ModuleTemplate == nil {
+			continue
+		}
+		if _, found := pendingDependencies[moduleName]; !found {
+			pendingDependencies[moduleName] = 0
+		}
+		for _, dependency := range templateInfo.Spec.DependsOn {
+			if !isSortable(templates, enabledModules, dependency.Name) {
+				continue
+			}
+			dependents[dependency.Name] = append(dependents[dependency.Name], moduleName)
+			pendingDependencies[moduleName]++
+		}
+	}
+
+	var ready []string
+	for moduleName, pending := range pendingDependencies {
+		if pending == 0 {
+			ready = append(ready, moduleName)
+		}
+	}
+
+	order := make([]string, 0, len(pendingDependencies))
+	for len(ready) > 0 {
+		slices.Sort(ready)
+		moduleName := ready[0]
+		ready = ready[1:]
+		order = append(order, moduleName)
+		for _, dependent := range dependents[moduleName] {
+			pendingDependencies[dependent]--
+			if pendingDependencies[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	var cyclic []string
+	for moduleName, pending := range pendingDependencies {
+		if pending > 0 {
+			cyclic = append(cyclic, moduleName)
+		}
+	}
+	slices.Sort(cyclic)
+	return order, cyclic
+}
+
+func isSortable(templates ModuleTemplatesByModuleName, enabledModules map[string]bool, moduleName string) bool {
+	templateInfo, found := templates[moduleName]
+	return found && enabledModules[moduleName] && templateInfo != nil && templateInfo.ModuleTemplate != nil
+}
+
+func checkDependency(moduleName string, dependency v1beta2.ModuleDependency,
+	templates ModuleTemplatesByModuleName, enabledModules map[string]bool,
+	moduleStatusMap map[string]*v1beta2.ModuleStatus,
+) error {
+	dependencyInfo, found := templates[dependency.Name]
+	if !found || !enabledModules[dependency.Name] {
+		return fmt.Errorf("%w: module %s requires module %s which is not enabled",
+			ErrModuleDependencyNotMet, moduleName, dependency.Name)
+	}
+	if errors.Is(dependencyInfo.Err, ErrWaitingForModuleDependency) {
+		return fmt.Errorf("%w: module %s waits for module %s",
+			ErrWaitingForModuleDependency, moduleName, dependency.Name)
+	}
+	if dependencyInfo.Err != nil {
+		return fmt.Errorf("%w: module %s requires module %s which is not available",
+			ErrModuleDependencyNotMet, moduleName, dependency.Name)
+	}
+
+	dependencyVersion := dependencyInfo.Spec.Version
+	if dependency.Version != "" {
+		if err := checkVersionConstraint(dependency.Version, dependencyVersion); err != nil {
+			return fmt.Errorf("%w: module %s requires module %s in version %q: %w",
+				ErrModuleDependencyNotMet, moduleName, dependency.Name, dependency.Version, err)
+		}
+	}
+
+	if isInstalled(moduleStatusMap[moduleName]) {
+		return nil
+	}
+	dependencyStatus := moduleStatusMap[dependency.Name]
+	if dependencyStatus == nil || dependencyStatus.State != shared.StateReady ||
+		dependencyStatus.Version != dependencyVersion {
+		return fmt.Errorf("%w: module %s waits for module %s in version %s",
+			ErrWaitingForModuleDependency, moduleName, dependency.Name, dependencyVersion)
+	}
+	return nil
+}
+
+func checkVersionConstraint(constraint, version string) error {
+	versionConstraint, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return fmt.Errorf("invalid version constraint: %w", err)
+	}
+	resolvedVersion, err := semver.NewVersion(version)
+	if err != nil {
+		return fmt.Errorf("invalid version %q resolved: %w", version, err)
+	}
+	if ok, errs := versionConstraint.Validate(resolvedVersion); !ok {
+		return fmt.Errorf("version %s resolved: %w", version, errors.Join(errs...))
+	}
+	return nil
+}
+
+func isInstalled(moduleStatus *v1beta2.ModuleStatus) bool {
+	return moduleStatus != nil && moduleStatus.Manifest != nil
+}
@@ -0,0 +1,199 @@
+package templatelookup_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	descriptorcache "github.com/kyma-project/lifecycle-manager/internal/descriptor/cache"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/builder"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils/service/componentdescriptor"
+)
+
+const (
+	istio      = "istio"
+	serverless = "serverless"
+	nats       = "nats"
+)
+
+type dependencyTestModule struct {
+	name      string
+	version   string
+	dependsOn []v1beta2.ModuleDependency
+}
+
+func TestTemplateLookup_GetRegularTemplates_WhenDependencyIsReady_ReturnsNoError(t *testing.T) {
+	lookup := newDependencyTemplateLookup(t,
+		dependencyTestModule{name: istio, version: version2},
+		dependencyTestModule{
+			name: serverless, version: version1,
+			dependsOn: []v1beta2.ModuleDependency{{Name: istio, Version: ">=2.0.0"}},
+		})
+	kyma := builder.NewKymaBuilder().
+		WithEnabledModule(testutils.NewTestModuleWithFixName(istio, "regular", "")).
+		WithEnabledModule(testutils.NewTestModuleWithFixName(serverless, "regular", "")).
+		WithModuleStatus(v1beta2.ModuleStatus{Name: istio, Version: version2, State: shared.StateReady}).
+		Build()
+
+	templates := lookup.GetRegularTemplates(t.Context(), kyma)
+
+	require.NoError(t, templates[istio].Err)
+	require.NoError(t, templates[serverless].Err)
+}
+
+func TestTemplateLookup_GetRegularTemplates_WhenDependencyIsNotReady_WaitsForDependency(t *testing.T) {
+	lookup := newDependencyTemplateLookup(t,
+		dependencyTestModule{name: istio, version: version2},
+		dependencyTestModule{
+			name: serverless, version: version1,
+			dependsOn: []v1beta2.ModuleDependency{{Name: istio}},
+		},
+		dependencyTestModule{
+			name: nats, version: version1,
+			dependsOn: []v1beta2.ModuleDependency{{Name: serverless}},
+		})
+	kyma := builder.NewKymaBuilder().
+		WithEnabledModule(testutils.NewTestModuleWithFixName(istio, "regular", "")).
+		WithEnabledModule(testutils.NewTestModuleWithFixName(serverless, "regular", "")).
+		WithEnabledModule(testutils.NewTestModuleWithFixName(nats, "regular", "")).
+		WithModuleStatus(v1beta2.ModuleStatus{Name: istio, Version: version2, State: shared.StateProcessing}).
+		Build()
+
+	templates := lookup.GetRegularTemplates(t.Context(), kyma)
+
+	require.NoError(t, templates[istio].Err)
+	require.ErrorIs(t, templates[serverless].Err, templatelookup.ErrWaitingForModuleDependency)
+	require.ErrorIs(t, templates[nats].Err, templatelookup.ErrWaitingForModuleDependency)
+}
+
+func TestTemplateLookup_GetRegularTemplates_WhenDependentIsInstalled_DoesNotWait(t *testing.T) {
+	lookup := newDependencyTemplateLookup(t,
+		dependencyTestModule{name: istio, version: version2},
+		dependencyTestModule{
+			name: serverless, version: version1,
+			dependsOn: []v1beta2.ModuleDependency{{Name: istio}},
+		})
+	kyma := builder.NewKymaBuilder().
+		WithEnabledModule(testutils.NewTestModuleWithFixName(istio, "regular", "")).
+		WithEnabledModule(testutils.NewTestModuleWithFixName(serverless, "regular", "")).
+		WithModuleStatus(v1beta2.ModuleStatus{Name: istio, Version: version2, State: shared.StateWarning}).
+		WithModuleStatus(v1beta2.ModuleStatus{
+			Name: serverless, Version: version1, State: shared.StateReady,
+			Manifest: &v1beta2.TrackingObject{PartialMeta: v1beta2.PartialMeta{Name: serverless}},
+		}).
+		Build()
+
+	templates := lookup.GetRegularTemplates(t.Context(), kyma)
+
+	require.NoError(t, templates[serverless].Err)
+}
+
+func TestTemplateLookup_GetRegularTemplates_WhenDependencyIsNotMet_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name            string
+		dependency      v1beta2.ModuleDependency
+		enabledModules  []string
+		wantErrContains string
+	}{
+		{
+			name:            "when dependency is not enabled",
+			dependency:      v1beta2.ModuleDependency{Name: istio},
+			enabledModules:  []string{serverless},
+			wantErrContains: "requires module istio which is not enabled",
+		},
+		{
+			name:            "when dependency version does not match the constraint",
+			dependency:      v1beta2.ModuleDependency{Name: istio, Version: ">=3.0.0"},
+			enabledModules:  []string{istio, serverless},
+			wantErrContains: "requires module istio in version \">=3.0.0\"",
+		},
+		{
+			name:            "when version constraint is invalid",
+			dependency:      v1beta2.ModuleDependency{Name: istio, Version: "not-a-constraint"},
+			enabledModules:  []string{istio, serverless},
+			wantErrContains: "invalid version constraint",
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			lookup := newDependencyTemplateLookup(t,
+				dependencyTestModule{name: istio, version: version2},
+				dependencyTestModule{
+					name: serverless, version: version1,
+					dependsOn: []v1beta2.ModuleDependency{testCase.dependency},
+				})
+			kymaBuilder := builder.NewKymaBuilder().
+				WithModuleStatus(v1beta2.ModuleStatus{Name: istio, Version: version2, State: shared.StateReady})
+			for _, moduleName := range testCase.enabledModules {
+				kymaBuilder = kymaBuilder.WithEnabledModule(testutils.NewTestModuleWithFixName(moduleName, "regular", ""))
+			}
+
+			templates := lookup.GetRegularTemplates(t.Context(), kymaBuilder.Build())
+
+			require.ErrorIs(t, templates[serverless].Err, templatelookup.ErrModuleDependencyNotMet)
+			assert.Contains(t, templates[serverless].Err.Error(), testCase.wantErrContains)
+		})
+	}
+}
+
+func TestTemplateLookup_GetRegularTemplates_WhenDependenciesAreCyclic_ReturnsError(t *testing.T) {
+	lookup := newDependencyTemplateLookup(t,
+		dependencyTestModule{
+			name: istio, version: version2,
+			dependsOn: []v1beta2.ModuleDependency{{Name: serverless}},
+		},
+		dependencyTestModule{
+			name: serverless, version: version1,
+			dependsOn: []v1beta2.ModuleDependency{{Name: istio}},
+		},
+		dependencyTestModule{
+			name: nats, version: version1,
+			dependsOn: []v1beta2.ModuleDependency{{Name: serverless}},
+		})
+	kyma := builder.NewKymaBuilder().
+		WithEnabledModule(testutils.NewTestModuleWithFixName(istio, "regular", "")).
+		WithEnabledModule(testutils.NewTestModuleWithFixName(serverless, "regular", "")).
+		WithEnabledModule(testutils.NewTestModuleWithFixName(nats, "regular", "")).
+		Build()
+
+	templates := lookup.GetRegularTemplates(t.Context(), kyma)
+
+	require.ErrorIs(t, templates[istio].Err, templatelookup.ErrModuleDependencyCycle)
+	require.ErrorIs(t, templates[serverless].Err, templatelookup.ErrModuleDependencyCycle)
+	require.ErrorIs(t, templates[nats].Err, templatelookup.ErrModuleDependencyCycle)
+}
+
+func newDependencyTemplateLookup(t *testing.T, modules ...dependencyTestModule) *templatelookup.TemplateLookup {
+	t.Helper()
+	fakeService := &componentdescriptor.FakeService{}
+	descriptorProvider := provider.NewCachedDescriptorProvider(
+		fakeService,
+		descriptorcache.NewDescriptorCache(),
+	)
+
+	templateList := v1beta2.ModuleTemplateList{}
+	mrmList := v1beta2.ModuleReleaseMetaList{}
+	for _, module := range modules {
+		template := builder.NewModuleTemplateBuilder().
+			WithName(v1beta2.CreateModuleTemplateName(module.name, module.version)).
+			WithModuleName(module.name).
+			WithVersion(module.version).
+			Build()
+		template.Spec.DependsOn = module.dependsOn
+		templateList.Items = append(templateList.Items, *template)
+		mrmList.Items = append(mrmList.Items, generateModuleReleaseMetaList(module.name,
+			[]v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: module.version}}).Items...)
+		require.NoError(t, registerEmptyComponentDescriptor(fakeService, "kyma-project.io/module"+
+			"/"+module.name, module.version))
+	}
+
+	reader := NewFakeModuleTemplateReader(templateList, mrmList)
+	return templatelookup.NewTemplateLookup(reader, descriptorProvider, moduletemplateinfolookup.NewLookup(reader))
+}
@@ -33,7 +33,12 @@ func GetModuleReleaseMeta(ctx context.Context, clnt client.Reader, moduleName st
 	return mrm, nil
 }
 
-func GetChannelVersionForModule(moduleReleaseMeta *v1beta2.ModuleReleaseMeta, desiredChannel string) (string, error) {
+// GetChannelVersionForModule resolves the module version assigned to the desired channel for the given Kyma.
+// If the channel has a staged rollout and the Kyma is part of its cohort or already runs the candidate version,
+// the candidate version is returned.
+func GetChannelVersionForModule(moduleReleaseMeta *v1beta2.ModuleReleaseMeta, desiredChannel string,
+	kyma *v1beta2.Kyma,
+) (string, error) {
 	channelAssignments := moduleReleaseMeta.Spec.Channels
 	if len(channelAssignments) == 0 {
 		return "", fmt.Errorf("%w: %s", ErrNoChannelsFound, moduleReleaseMeta.Name)
@@ -41,7 +46,7 @@ func GetChannelVersionForModule(moduleReleaseMeta *v1beta2.ModuleReleaseMeta, de
 
 	for _, channelAssignment := range channelAssignments {
 		if channelAssignment.Channel == desiredChannel {
-			return channelAssignment.Version, nil
+			return channelAssignment.VersionFor(moduleReleaseMeta.Spec.ModuleName, kyma), nil
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
@@ -15,7 +16,7 @@ func Test_GetChannelVersionForModule_WhenEmptyChannels(t *testing.T) {
 			Channels: nil,
 		},
 	}
-	_, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "test")
+	_, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "test", nil)
 
 	require.ErrorIs(t, err, templatelookup.ErrNoChannelsFound)
 }
@@ -31,7 +32,7 @@ func Test_GetChannelVersionForModule_WhenChannelFound(t *testing.T) {
 			},
 		},
 	}
-	version, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "regular")
+	version, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "regular", nil)
 
 	require.NoError(t, err)
 	require.Equal(t, "1.0.0", version)
@@ -48,11 +49,59 @@ func Test_GetChannelVersionForModule_WhenChannelNotFound(t *testing.T) {
 			},
 		},
 	}
-	_, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "fast")
+	_, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "fast", nil)
 
 	require.ErrorIs(t, err, templatelookup.ErrChannelNotFound)
 }
 
+func Test_GetChannelVersionForModule_WhenKymaInRolloutCohort(t *testing.T) {
+	moduleReleaseMeta := moduleReleaseMetaWithRollout(&v1beta2.ChannelRollout{
+		Version: "1.1.0",
+		Selector: &apimetav1.LabelSelector{
+			MatchLabels: map[string]string{"canary": "true"},
+		},
+	})
+	kyma := &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "kyma", Labels: map[string]string{"canary": "true"}},
+	}
+
+	version, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "regular", kyma)
+
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", version)
+}
+
+func Test_GetChannelVersionForModule_WhenKymaOutsideRolloutCohort(t *testing.T) {
+	percentage := int32(0)
+	moduleReleaseMeta := moduleReleaseMetaWithRollout(&v1beta2.ChannelRollout{
+		Version:    "1.1.0",
+		Percentage: &percentage,
+		Selector: &apimetav1.LabelSelector{
+			MatchLabels: map[string]string{"canary": "true"},
+		},
+	})
+	kyma := &v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{Name: "kyma"}}
+
+	version, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "regular", kyma)
+
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", version)
+}
+
+func Test_GetChannelVersionForModule_WhenFullRolloutPercentage(t *testing.T) {
+	percentage := int32(100)
+	moduleReleaseMeta := moduleReleaseMetaWithRollout(&v1beta2.ChannelRollout{
+		Version:    "1.1.0",
+		Percentage: &percentage,
+	})
+	kyma := &v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{Name: "kyma"}}
+
+	version, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "regular", kyma)
+
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", version)
+}
+
 func Test_GetMandatoryVersionForModule_WhenMandatoryFound(t *testing.T) {
 	moduleReleaseMeta := &v1beta2.ModuleReleaseMeta{
 		Spec: v1beta2.ModuleReleaseMetaSpec{
@@ -77,3 +126,17 @@ func Test_GetMandatoryVersionForModule_WhenMandatoryNotFound(t *testing.T) {
 
 	require.ErrorIs(t, err, templatelookup.ErrNoMandatoryFound)
 }
+
+func moduleReleaseMetaWithRollout(rollout *v1beta2.ChannelRollout) *v1beta2.ModuleReleaseMeta {
+	return &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			Channels: []v1beta2.ChannelVersionAssignment{
+				{
+					Channel: "regular",
+					Version: "1.0.0",
+					Rollout: rollout,
+				},
+			},
+		},
+	}
+}
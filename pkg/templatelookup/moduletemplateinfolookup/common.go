@@ -7,6 +7,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
 
 func TemplateNameMatch(template *v1beta2.ModuleTemplate, name string) bool {
@@ -32,6 +34,30 @@ func getTemplateByVersion(ctx context.Context,
 	return moduleTemplate, nil
 }
 
+// getTemplateBySpecVersion gets the ModuleTemplate of the module version by the name derived from both,
+// and verifies that its spec declares the module and the version.
+func getTemplateBySpecVersion(ctx context.Context,
+	clnt client.Reader,
+	moduleName,
+	moduleVersion,
+	namespace string,
+) (*v1beta2.ModuleTemplate, error) {
+	moduleTemplate := &v1beta2.ModuleTemplate{}
+	err := clnt.Get(ctx, client.ObjectKey{
+		Name:      v1beta2.CreateModuleTemplateName(moduleName, moduleVersion),
+		Namespace: namespace,
+	}, moduleTemplate)
+	if err != nil && !util.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get module template: %w", err)
+	}
+	if err != nil || !TemplateNameMatch(moduleTemplate, moduleName) || moduleTemplate.Spec.Version != moduleVersion {
+		return nil, fmt.Errorf("%w: for module %s in version %s",
+			common.ErrNoTemplatesInListResult, moduleName, moduleVersion)
+	}
+
+	return moduleTemplate, nil
+}
+
 func getDesiredChannel(moduleChannel, globalChannel string) string {
 	if moduleChannel != "" {
 		return moduleChannel
@@ -6,6 +6,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types/ocmidentity"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
@@ -35,6 +36,10 @@ func (l Lookup) Lookup(ctx context.Context,
 		}
 	}
 
+	if moduleInfo.IsInstalledByVersion() && moduleReleaseMeta.Spec.Mandatory == nil {
+		return l.lookupByVersion(ctx, moduleInfo, kyma, moduleReleaseMeta)
+	}
+
 	moduleTemplateInfo := templatelookup.ModuleTemplateInfo{}
 	moduleTemplateInfo.DesiredChannel = getDesiredChannel(moduleInfo.Channel, kyma.Spec.Channel)
 
@@ -44,7 +49,7 @@ func (l Lookup) Lookup(ctx context.Context,
 		resolvedModuleVersion, err = templatelookup.GetMandatoryVersionForModule(moduleReleaseMeta)
 	} else {
 		resolvedModuleVersion, err = templatelookup.GetChannelVersionForModule(moduleReleaseMeta,
-			moduleTemplateInfo.DesiredChannel)
+			moduleTemplateInfo.DesiredChannel, kyma)
 	}
 	if err != nil {
 		moduleTemplateInfo.Err = err
@@ -72,3 +77,34 @@ func (l Lookup) Lookup(ctx context.Context,
 	moduleTemplateInfo.ModuleTemplate = template
 	return moduleTemplateInfo
 }
+
+// lookupByVersion resolves the ModuleTemplate for a module that is pinned to a specific version.
+// The template is matched by its .spec.version instead of the version assigned to a channel.
+func (l Lookup) lookupByVersion(ctx context.Context,
+	moduleInfo *templatelookup.ModuleInfo,
+	kyma *v1beta2.Kyma,
+	moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+) templatelookup.ModuleTemplateInfo {
+	moduleTemplateInfo := templatelookup.ModuleTemplateInfo{}
+	moduleTemplateInfo.DesiredChannel = string(shared.NoneChannel)
+
+	ocmId, err := ocmidentity.NewComponentId(moduleReleaseMeta.Spec.OcmComponentName, moduleInfo.Version)
+	if err != nil {
+		moduleTemplateInfo.Err = err
+		return moduleTemplateInfo
+	}
+	moduleTemplateInfo.ComponentId = ocmId
+
+	template, err := getTemplateBySpecVersion(ctx,
+		l.client,
+		moduleInfo.Name,
+		moduleInfo.Version,
+		kyma.Namespace)
+	if err != nil {
+		moduleTemplateInfo.Err = err
+		return moduleTemplateInfo
+	}
+
+	moduleTemplateInfo.ModuleTemplate = template
+	return moduleTemplateInfo
+}
@@ -10,8 +10,10 @@ import (
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
 	"github.com/kyma-project/lifecycle-manager/pkg/testutils/builder"
 )
@@ -371,3 +373,98 @@ func TestLookup_UsesDefaultChannelWhenNeitherChannelSpecified(t *testing.T) {
 	assert.Equal(t, v1beta2.DefaultChannel, result.DesiredChannel)
 	assert.NotNil(t, result.ComponentId)
 }
+
+func TestLookup_WithPinnedVersion_ResolvesTemplateBySpecVersion(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	pinnedTemplate := builder.NewModuleTemplateBuilder().
+		WithName(v1beta2.CreateModuleTemplateName("test-module", "1.0.0")).
+		WithModuleName("test-module").
+		WithVersion("1.0.0").
+		WithNamespace("kyma-system").
+		Build()
+	channelTemplate := builder.NewModuleTemplateBuilder().
+		WithName(v1beta2.CreateModuleTemplateName("test-module", "2.0.0")).
+		WithModuleName("test-module").
+		WithVersion("2.0.0").
+		WithNamespace("kyma-system").
+		Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pinnedTemplate, channelTemplate).
+		Build()
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("test-module").
+		WithOcmComponentName("kyma-project.io/test-module").
+		WithSingleModuleChannelAndVersions("regular", "2.0.0").
+		Build()
+
+	lookup := moduletemplateinfolookup.NewLookup(fakeClient)
+
+	result := lookup.Lookup(context.Background(),
+		&templatelookup.ModuleInfo{
+			Module:  v1beta2.Module{Name: "test-module", Version: "1.0.0"},
+			Enabled: true,
+		},
+		&v1beta2.Kyma{
+			ObjectMeta: apimetav1.ObjectMeta{
+				Namespace: "kyma-system",
+			},
+			Spec: v1beta2.KymaSpec{
+				Channel: "regular",
+			},
+		},
+		moduleReleaseMeta)
+
+	require.NoError(t, result.Err)
+	assert.NotNil(t, result.ModuleTemplate)
+	assert.Equal(t, v1beta2.CreateModuleTemplateName("test-module", "1.0.0"), result.Name)
+	assert.Equal(t, "1.0.0", result.Spec.Version)
+	assert.Equal(t, string(shared.NoneChannel), result.DesiredChannel)
+	require.NotNil(t, result.ComponentId)
+	assert.Equal(t, "1.0.0", result.ComponentId.Version())
+}
+
+func TestLookup_WithPinnedVersion_WhenModuleTemplateNotFound_ReturnsError(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	channelTemplate := builder.NewModuleTemplateBuilder().
+		WithName(v1beta2.CreateModuleTemplateName("test-module", "2.0.0")).
+		WithModuleName("test-module").
+		WithVersion("2.0.0").
+		WithNamespace("kyma-system").
+		Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(channelTemplate).
+		Build()
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("test-module").
+		WithOcmComponentName("kyma-project.io/test-module").
+		WithSingleModuleChannelAndVersions("regular", "2.0.0").
+		Build()
+
+	lookup := moduletemplateinfolookup.NewLookup(fakeClient)
+
+	result := lookup.Lookup(context.Background(),
+		&templatelookup.ModuleInfo{
+			Module:  v1beta2.Module{Name: "test-module", Version: "1.0.0"},
+			Enabled: true,
+		},
+		&v1beta2.Kyma{
+			ObjectMeta: apimetav1.ObjectMeta{
+				Namespace: "kyma-system",
+			},
+		},
+		moduleReleaseMeta)
+
+	require.ErrorIs(t, result.Err, common.ErrNoTemplatesInListResult)
+	assert.Nil(t, result.ModuleTemplate)
+	assert.Equal(t, string(shared.NoneChannel), result.DesiredChannel)
+}
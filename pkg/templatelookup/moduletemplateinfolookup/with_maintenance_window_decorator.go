@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 )
 
@@ -14,11 +16,14 @@ var (
 		"waiting for next maintenance window to update module version",
 	)
 	ErrFailedToDetermineIfMaintenanceWindowIsActive = errors.New("failed to determine if maintenance window is active")
+	ErrBlackoutPeriodActive                         = errors.New("module version changes are frozen during a blackout period")
 )
 
 type MaintenanceWindow interface {
 	IsRequired(moduleTemplate *v1beta2.ModuleTemplate, kyma *v1beta2.Kyma) bool
 	IsActive(kyma *v1beta2.Kyma) (bool, error)
+	IsVersionChange(moduleTemplate *v1beta2.ModuleTemplate, kyma *v1beta2.Kyma) bool
+	ActiveBlackout(kyma *v1beta2.Kyma) (resolver.ResolvedWindow, bool)
 }
 
 type ModuleLookup interface {
@@ -58,6 +63,17 @@ func (p WithMaintenanceWindowDecorator) Lookup(ctx context.Context,
 		return moduleTemplateInfo
 	}
 
+	// blackout periods hold back every version change, not only those requiring downtime
+	if p.maintenanceWindow.IsVersionChange(moduleTemplateInfo.ModuleTemplate, kyma) {
+		if blackout, active := p.maintenanceWindow.ActiveBlackout(kyma); active {
+			moduleTemplateInfo.Err = fmt.Errorf("%w: %w until %s", ErrWaitingForNextMaintenanceWindow,
+				ErrBlackoutPeriodActive, blackout.End.UTC().Format(time.RFC3339))
+			moduleTemplateInfo.MaintenanceVersion = moduleTemplateInfo.Spec.Version
+			moduleTemplateInfo.ModuleTemplate = nil
+			return moduleTemplateInfo
+		}
+	}
+
 	if !p.maintenanceWindow.IsRequired(moduleTemplateInfo.ModuleTemplate, kyma) {
 		return moduleTemplateInfo
 	}
@@ -71,6 +87,7 @@ func (p WithMaintenanceWindowDecorator) Lookup(ctx context.Context,
 
 	if !active {
 		moduleTemplateInfo.Err = ErrWaitingForNextMaintenanceWindow
+		moduleTemplateInfo.MaintenanceVersion = moduleTemplateInfo.Spec.Version
 		moduleTemplateInfo.ModuleTemplate = nil
 		return moduleTemplateInfo
 	}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
 )
@@ -124,6 +126,7 @@ func Test_WithMWDecorator_Lookup_ReturnsError_WhenMWIsRequiredAndNotActive(t *te
 			ModuleTemplate: &v1beta2.ModuleTemplate{
 				Spec: v1beta2.ModuleTemplateSpec{
 					Channel: "test",
+					Version: "2.0.0",
 				},
 			},
 		},
@@ -140,6 +143,7 @@ func Test_WithMWDecorator_Lookup_ReturnsError_WhenMWIsRequiredAndNotActive(t *te
 	assert.True(t, maintenanceWindow.activeCalled)
 	require.ErrorIs(t, moduleTemplateInfo.Err, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow)
 	assert.Nil(t, moduleTemplateInfo.ModuleTemplate)
+	assert.Equal(t, "2.0.0", moduleTemplateInfo.MaintenanceVersion)
 }
 
 func Test_WithMWDecorator_Lookup_ReturnsModuleTemplateInfo_WhenMWIsRequiredAndActive(t *testing.T) {
@@ -171,6 +175,69 @@ func Test_WithMWDecorator_Lookup_ReturnsModuleTemplateInfo_WhenMWIsRequiredAndAc
 	assert.Equal(t, expectedModuleTemplateInfo, moduleTemplateInfo)
 }
 
+func Test_WithMWDecorator_Lookup_ReturnsError_WhenVersionChangesDuringBlackout(t *testing.T) {
+	blackoutEnd := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	maintenanceWindow := &maintenanceWindowStub{
+		versionChange:  true,
+		blackoutActive: true,
+		blackout:       resolver.ResolvedWindow{Begin: blackoutEnd.Add(-time.Hour), End: blackoutEnd},
+		required:       false,
+	}
+	decorated := &lookupStrategyStub{
+		moduleTemplateInfo: templatelookup.ModuleTemplateInfo{
+			ModuleTemplate: &v1beta2.ModuleTemplate{
+				Spec: v1beta2.ModuleTemplateSpec{
+					Channel: "test",
+					Version: "2.0.0",
+				},
+			},
+		},
+	}
+	withMaintenanceWindowDecorator := moduletemplateinfolookup.NewWithMaintenanceWindowDecorator(maintenanceWindow,
+		decorated)
+
+	moduleTemplateInfo := withMaintenanceWindowDecorator.Lookup(t.Context(),
+		nil,
+		nil,
+		nil)
+
+	assert.False(t, maintenanceWindow.requiredCalled)
+	require.ErrorIs(t, moduleTemplateInfo.Err, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow)
+	require.ErrorIs(t, moduleTemplateInfo.Err, moduletemplateinfolookup.ErrBlackoutPeriodActive)
+	assert.Contains(t, moduleTemplateInfo.Err.Error(), "2026-01-05T00:00:00Z")
+	assert.Nil(t, moduleTemplateInfo.ModuleTemplate)
+	assert.Equal(t, "2.0.0", moduleTemplateInfo.MaintenanceVersion)
+}
+
+func Test_WithMWDecorator_Lookup_ReturnsModuleTemplateInfo_WhenNoVersionChangeDuringBlackout(t *testing.T) {
+	maintenanceWindow := &maintenanceWindowStub{
+		versionChange:  false,
+		blackoutActive: true,
+		required:       false,
+	}
+	expectedModuleTemplateInfo := templatelookup.ModuleTemplateInfo{
+		DesiredChannel: "test",
+		ModuleTemplate: &v1beta2.ModuleTemplate{
+			Spec: v1beta2.ModuleTemplateSpec{
+				Channel: "test",
+			},
+		},
+	}
+	decorated := &lookupStrategyStub{
+		moduleTemplateInfo: expectedModuleTemplateInfo,
+	}
+	withMaintenanceWindowDecorator := moduletemplateinfolookup.NewWithMaintenanceWindowDecorator(maintenanceWindow,
+		decorated)
+
+	moduleTemplateInfo := withMaintenanceWindowDecorator.Lookup(t.Context(),
+		nil,
+		nil,
+		nil)
+
+	assert.True(t, maintenanceWindow.requiredCalled)
+	assert.Equal(t, expectedModuleTemplateInfo, moduleTemplateInfo)
+}
+
 type lookupStrategyStub struct {
 	moduleTemplateInfo templatelookup.ModuleTemplateInfo
 }
@@ -189,6 +256,9 @@ type maintenanceWindowStub struct {
 	activeCalled   bool
 	active         bool
 	err            error
+	versionChange  bool
+	blackoutActive bool
+	blackout       resolver.ResolvedWindow
 }
 
 func (s *maintenanceWindowStub) IsRequired(_ *v1beta2.ModuleTemplate, _ *v1beta2.Kyma) bool {
@@ -203,3 +273,11 @@ func (s *maintenanceWindowStub) IsActive(_ *v1beta2.Kyma) (bool, error) {
 	}
 	return s.active, nil
 }
+
+func (s *maintenanceWindowStub) IsVersionChange(_ *v1beta2.ModuleTemplate, _ *v1beta2.Kyma) bool {
+	return s.versionChange
+}
+
+func (s *maintenanceWindowStub) ActiveBlackout(_ *v1beta2.Kyma) (resolver.ResolvedWindow, bool) {
+	return s.blackout, s.blackoutActive
+}
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types/ocmidentity"
@@ -31,6 +32,12 @@ type ModuleTemplateInfo struct {
 
 	ComponentId *ocmidentity.ComponentId // Identifies the OCM Component that is
 	//                                          represented by this ModuleTemplateInfo.
+
+	RolledBackVersion string // This is the resolved version that was rolled back in this runtime,
+	//                          the ModuleTemplate then points to the version before the failed upgrade.
+
+	MaintenanceVersion string // This is the resolved version that is held back until the next
+	//                           maintenance window, the ModuleTemplate is then not set.
 }
 
 // GetOCMIdentity implements provider.OCMIProvider.
@@ -99,6 +106,8 @@ func (t *TemplateLookup) GetRegularTemplates(ctx context.Context, kyma *v1beta2.
 			kyma,
 			moduleReleaseMeta)
 
+		templateInfo = t.applyRollback(ctx, templateInfo, kyma.GetModuleStatusMap()[moduleInfo.Name])
+
 		templateInfo = ValidateTemplateMode(templateInfo, kyma)
 		if templateInfo.Err != nil {
 			templates[moduleInfo.Name] = &templateInfo
@@ -112,19 +121,25 @@ func (t *TemplateLookup) GetRegularTemplates(ctx context.Context, kyma *v1beta2.
 			continue
 		}
 
+		if templateInfo.RolledBackVersion != "" {
+			templateInfo.ComponentId = ocmId
+		}
+
 		if err := t.descriptorProvider.Add(*ocmId); err != nil {
 			templateInfo.Err = fmt.Errorf("failed to get descriptor: %w", err)
 			templates[moduleInfo.Name] = &templateInfo
 			continue
 		}
+		// A rollback is an intended downgrade and must not be rejected as a version skew.
 		for i := range kyma.Status.Modules {
 			moduleStatus := &kyma.Status.Modules[i]
-			if moduleMatch(moduleStatus, moduleInfo.Name) {
+			if moduleMatch(moduleStatus, moduleInfo.Name) && templateInfo.RolledBackVersion == "" {
 				markInvalidSkewUpdate(ctx, &templateInfo, moduleStatus, ocmId.Version())
 			}
 		}
 		templates[moduleInfo.Name] = &templateInfo
 	}
+	markModuleDependencies(kyma, templates)
 	return templates
 }
 
@@ -204,6 +219,11 @@ func markInvalidSkewUpdate(ctx context.Context, moduleTemplateInfo *ModuleTempla
 		msg := fmt.Sprintf("ignore channel skew (from %s to %s), "+
 			"as a higher version (%s) of the module was previously installed",
 			moduleStatus.Channel, moduleTemplateInfo.DesiredChannel, versionInStatus.String())
+		if shared.NoneChannel.Equals(moduleTemplateInfo.DesiredChannel) {
+			msg = fmt.Sprintf("ignore pinned version %s, "+
+				"as a higher version (%s) of the module was previously installed",
+				versionInTemplate.String(), versionInStatus.String())
+		}
 		checkLog.Info(msg)
 		moduleTemplateInfo.Err = fmt.Errorf("%w: %s", ErrTemplateUpdateNotAllowed, msg)
 	}
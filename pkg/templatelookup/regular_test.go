@@ -295,7 +295,6 @@ func TestTemplateLookup_GetRegularTemplates_WhenSwitchModuleChannel(t *testing.T
 }
 
 func TestTemplateLookup_GetRegularTemplates_WhenSwitchBetweenModuleVersions(t *testing.T) {
-	t.Skip("This test verifies install-by-version which is not supported yet in the logic based on ModuleReleaseMeta")
 	moduleToInstall := moduleToInstallByVersion("module1", version2)
 
 	availableModuleTemplates := (&ModuleTemplateListBuilder{}).
@@ -312,7 +311,6 @@ func TestTemplateLookup_GetRegularTemplates_WhenSwitchBetweenModuleVersions(t *t
 			{Channel: "regular", Version: version1},
 			{Channel: "fast", Version: version2},
 			{Channel: "experimental", Version: version3},
-			{Channel: string(shared.NoneChannel), Version: version2},
 		})
 
 	fakeService := &componentdescriptor.FakeService{}
@@ -369,8 +367,97 @@ func TestTemplateLookup_GetRegularTemplates_WhenSwitchBetweenModuleVersions(t *t
 		moduleToInstall, descriptorProvider)
 }
 
+func TestTemplateLookup_GetRegularTemplates_WhenVersionWasRolledBack(t *testing.T) {
+	moduleToInstall := testutils.NewTestModuleWithChannelVersion("module1", "fast", "")
+
+	availableModuleTemplates := (&ModuleTemplateListBuilder{}).
+		Add(moduleToInstall.Name, "regular", version1).
+		Add(moduleToInstall.Name, "fast", version2).
+		Build()
+
+	availableModuleReleaseMetas := generateModuleReleaseMetaList(moduleToInstall.Name,
+		[]v1beta2.ChannelVersionAssignment{
+			{Channel: "regular", Version: version1},
+			{Channel: "fast", Version: version2},
+		})
+
+	fakeService := &componentdescriptor.FakeService{}
+	descriptorProvider := provider.NewCachedDescriptorProvider(
+		fakeService,
+		descriptorcache.NewDescriptorCache(),
+	)
+	err := registerEmptyComponentDescriptor(fakeService, "kyma-project.io/module"+
+		"/"+moduleToInstall.Name, version1)
+	require.NoError(t, err)
+	err = registerEmptyComponentDescriptor(fakeService, "kyma-project.io/module"+
+		"/"+moduleToInstall.Name, version2)
+	require.NoError(t, err)
+
+	previousTemplate := &v1beta2.TrackingObject{
+		PartialMeta: v1beta2.PartialMeta{
+			Name:       v1beta2.CreateModuleTemplateName(moduleToInstall.Name, version1),
+			Generation: 1,
+		},
+	}
+	failedTemplate := &v1beta2.TrackingObject{
+		PartialMeta: v1beta2.PartialMeta{
+			Name:       v1beta2.CreateModuleTemplateName(moduleToInstall.Name, version2),
+			Generation: 1,
+		},
+	}
+	tests := getRegularTemplatesTestCases{
+		{
+			name: "When failed version is still active, then template before the upgrade is used",
+			kyma: builder.NewKymaBuilder().
+				WithEnabledModule(moduleToInstall).
+				WithModuleStatus(v1beta2.ModuleStatus{
+					Name:           moduleToInstall.Name,
+					Channel:        "fast",
+					Version:        version2,
+					Template:       failedTemplate,
+					FailedVersions: []string{version2},
+					Upgrade: &v1beta2.ModuleUpgrade{
+						PreviousVersion:  version1,
+						PreviousTemplate: previousTemplate,
+					},
+				}).Build(),
+			wantChannel: "fast",
+			wantVersion: version1,
+		},
+		{
+			name: "When module was rolled back, then it stays on the restored template",
+			kyma: builder.NewKymaBuilder().
+				WithEnabledModule(moduleToInstall).
+				WithModuleStatus(v1beta2.ModuleStatus{
+					Name:           moduleToInstall.Name,
+					Channel:        "fast",
+					Version:        version1,
+					Template:       previousTemplate,
+					FailedVersions: []string{version2},
+				}).Build(),
+			wantChannel: "fast",
+			wantVersion: version1,
+		},
+		{
+			name: "When failed version has no known previous version, then result contains error",
+			kyma: builder.NewKymaBuilder().
+				WithEnabledModule(moduleToInstall).
+				WithModuleStatus(v1beta2.ModuleStatus{
+					Name:           moduleToInstall.Name,
+					Channel:        "fast",
+					Version:        version2,
+					Template:       failedTemplate,
+					FailedVersions: []string{version2},
+				}).Build(),
+			wantErrContains: templatelookup.ErrRollbackTemplateNotFound.Error(),
+		},
+	}
+
+	executeGetRegularTemplatesTestCases(t, tests, availableModuleTemplates, availableModuleReleaseMetas,
+		moduleToInstall, descriptorProvider)
+}
+
 func TestTemplateLookup_GetRegularTemplates_WhenSwitchFromChannelToVersion(t *testing.T) {
-	t.Skip("This test verifies install-by-version which is not supported yet in the logic based on ModuleReleaseMeta")
 	moduleToInstall := moduleToInstallByVersion("module1", version2)
 	availableModuleTemplates := (&ModuleTemplateListBuilder{}).
 		Add(moduleToInstall.Name, "regular", version1).
@@ -381,7 +468,17 @@ func TestTemplateLookup_GetRegularTemplates_WhenSwitchFromChannelToVersion(t *te
 		Add(moduleToInstall.Name, string(shared.NoneChannel), version3).
 		Build()
 
-	availableModuleReleaseMetas := v1beta2.ModuleReleaseMetaList{}
+	availableModuleReleaseMetas := generateModuleReleaseMetaList(moduleToInstall.Name,
+		[]v1beta2.ChannelVersionAssignment{
+			{Channel: "regular", Version: version1},
+			{Channel: "fast", Version: version2},
+			{Channel: "experimental", Version: version3},
+		})
+
+	fakeService := &componentdescriptor.FakeService{}
+	descriptorProvider := provider.NewCachedDescriptorProvider(fakeService, descriptorcache.NewDescriptorCache())
+	err := registerEmptyComponentDescriptor(fakeService, testutils.FullOCMName(moduleToInstall.Name), version2)
+	require.NoError(t, err)
 
 	tests := getRegularTemplatesTestCases{
 		{
@@ -437,7 +534,7 @@ func TestTemplateLookup_GetRegularTemplates_WhenSwitchFromChannelToVersion(t *te
 	}
 
 	executeGetRegularTemplatesTestCases(t, tests, availableModuleTemplates, availableModuleReleaseMetas,
-		moduleToInstall, nil)
+		moduleToInstall, descriptorProvider)
 }
 
 func TestTemplateLookup_GetRegularTemplates_WhenSwitchFromVersionToChannel(t *testing.T) {
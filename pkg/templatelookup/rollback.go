@@ -0,0 +1,57 @@
+package templatelookup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var ErrRollbackTemplateNotFound = errors.New("module template for rollback not found")
+
+// applyRollback replaces the resolved ModuleTemplate with the last healthy one if the resolved version
+// was rolled back in this runtime before. This keeps the module on its previous version until a new version
+// is assigned, instead of retrying the failed upgrade in a loop.
+func (t *TemplateLookup) applyRollback(ctx context.Context, templateInfo ModuleTemplateInfo,
+	moduleStatus *v1beta2.ModuleStatus,
+) ModuleTemplateInfo {
+	if templateInfo.Err != nil || moduleStatus == nil || !moduleStatus.IsFailedVersion(templateInfo.Spec.Version) {
+		return templateInfo
+	}
+
+	failedVersion := templateInfo.Spec.Version
+	templateRef := rollbackTemplateRef(moduleStatus)
+	if templateRef == nil {
+		templateInfo.Err = fmt.Errorf("%w: version %s was rolled back, but no previous version is known",
+			ErrRollbackTemplateNotFound, failedVersion)
+		return templateInfo
+	}
+
+	template := &v1beta2.ModuleTemplate{}
+	if err := t.Get(ctx, client.ObjectKey{Namespace: templateRef.Namespace, Name: templateRef.Name},
+		template); err != nil {
+		templateInfo.Err = fmt.Errorf("%w: %s/%s: %w", ErrRollbackTemplateNotFound,
+			templateRef.Namespace, templateRef.Name, err)
+		return templateInfo
+	}
+
+	templateInfo.ModuleTemplate = template
+	templateInfo.RolledBackVersion = failedVersion
+	return templateInfo
+}
+
+// rollbackTemplateRef returns the ModuleTemplate to roll back to. During the rollback the status still tracks
+// the failed version, so the template from before the upgrade is used. Afterward, the status tracks the
+// restored template.
+func rollbackTemplateRef(moduleStatus *v1beta2.ModuleStatus) *v1beta2.TrackingObject {
+	if !moduleStatus.IsFailedVersion(moduleStatus.Version) {
+		return moduleStatus.Template
+	}
+	if moduleStatus.Upgrade != nil {
+		return moduleStatus.Upgrade.PreviousTemplate
+	}
+	return nil
+}
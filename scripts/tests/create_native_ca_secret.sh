@@ -0,0 +1,28 @@
+#!/bin/bash
+
+# Creates the klm-watcher CA secret, which the native certificate management requires, unless it exists.
+# The CA certificate is also served by the watcher gateway, so it is issued for the local gateway host names.
+
+CA_SECRET_NAME=klm-watcher
+CA_SECRET_NAMESPACE=istio-system
+
+if kubectl get secret "$CA_SECRET_NAME" -n "$CA_SECRET_NAMESPACE" > /dev/null 2>&1; then
+  echo "Secret $CA_SECRET_NAMESPACE/$CA_SECRET_NAME already exists"
+  exit 0
+fi
+
+WORK_DIR=$(mktemp -d)
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+openssl req -x509 -newkey rsa:4096 -nodes -days 90 \
+  -keyout "$WORK_DIR/tls.key" -out "$WORK_DIR/tls.crt" \
+  -subj "/C=DE/ST=Baden-Württemberg/L=Walldorf/O=SAP SE/OU=BTP Kyma Runtime/CN=klm-watcher-selfsigned-ca" \
+  -addext "basicConstraints=critical,CA:TRUE" \
+  -addext "keyUsage=critical,keyCertSign,cRLSign,digitalSignature" \
+  -addext "subjectAltName=DNS:localhost,DNS:host.k3d.internal,DNS:skr.cluster.local" || exit 1
+
+kubectl create namespace "$CA_SECRET_NAMESPACE" --dry-run=client -o yaml | kubectl apply -f -
+kubectl create secret generic "$CA_SECRET_NAME" -n "$CA_SECRET_NAMESPACE" --type=kubernetes.io/tls \
+  --from-file=tls.crt="$WORK_DIR/tls.crt" \
+  --from-file=tls.key="$WORK_DIR/tls.key" \
+  --from-file=ca.crt="$WORK_DIR/tls.crt"
@@ -86,6 +86,15 @@ var _ = Describe("RBAC Privileges", func() {
 					Verbs:     []string{"get", "list", "watch"},
 				},
 				{
+					APIGroups: []string{"gateway.networking.k8s.io"},
+					Resources: []string{"gateways"},
+					Verbs:     []string{"get", "list"},
+				},
+				{
+					APIGroups: []string{"gateway.networking.k8s.io"},
+					Resources: []string{"httproutes"},
+					Verbs:     []string{"delete", "get", "patch"},
+				},				{
 					APIGroups: []string{"networking.istio.io"},
 					Resources: []string{"gateways"},
 					Verbs:     []string{"get", "list"},
@@ -110,6 +119,16 @@ var _ = Describe("RBAC Privileges", func() {
 					Resources: []string{"kymas/status"},
 					Verbs:     []string{"get", "patch", "update", "watch"},
 				},
+				{
+					APIGroups: []string{"operator.kyma-project.io"},
+					Resources: []string{"maintenancewindowpolicies"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{"operator.kyma-project.io"},
+					Resources: []string{"maintenancewindowpolicies/status"},
+					Verbs:     []string{"get", "patch", "update"},
+				},
 				{
 					APIGroups: []string{"operator.kyma-project.io"},
 					Resources: []string{"manifests"},
@@ -144,6 +163,11 @@ var _ = Describe("RBAC Privileges", func() {
 					Resources: []string{"modulereleasemetas/finalizers"},
 					Verbs:     []string{"update"},
 				},
+				{
+					APIGroups: []string{"operator.kyma-project.io"},
+					Resources: []string{"modulereleasemetas/status"},
+					Verbs:     []string{"get", "patch", "update"},
+				},
 				{
 					APIGroups: []string{"operator.kyma-project.io"},
 					Resources: []string{"moduletemplates"},
@@ -154,6 +178,21 @@ var _ = Describe("RBAC Privileges", func() {
 					Resources: []string{"moduletemplates/finalizers"},
 					Verbs:     []string{"update"},
 				},
+				{
+					APIGroups: []string{"operator.kyma-project.io"},
+					Resources: []string{"moduleversionhistories"},
+					Verbs:     []string{"create", "get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{"operator.kyma-project.io"},
+					Resources: []string{"moduleversionhistories/status"},
+					Verbs:     []string{"get", "patch", "update"},
+				},
+				{
+					APIGroups: []string{"operator.kyma-project.io"},
+					Resources: []string{"purgepolicies"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{"operator.kyma-project.io"},
 					Resources: []string{"watchers"},
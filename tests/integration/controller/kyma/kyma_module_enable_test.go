@@ -58,15 +58,10 @@ var _ = Describe("Given kyma CR with invalid module enabled", Ordered, func() {
 			WithArguments(skrClient, skrKyma.GetName(), skrKyma.GetNamespace()).
 			Should(Succeed())
 	})
-	It("When enable module with channel and version, expect module status in Error state", func() {
-		Skip("Version attribute is disabled for now on the CRD level")
+	It("When enable module with channel and version, expect the update to be rejected", func() {
 		module := NewTestModuleWithChannelVersion("test", v1beta2.DefaultChannel, "1.0.0")
-		Eventually(givenKymaWithModule, Timeout, Interval).
-			WithContext(ctx).
-			WithArguments(kcpClient, kyma, skrClient, skrKyma, module, objTracker).
-			Should(Succeed())
-		Eventually(expectKymaStatusModules(ctx, kyma, module.Name, shared.StateError), Timeout,
-			Interval).Should(Succeed())
+		err := EnableModule(ctx, skrClient, skrKyma.GetName(), skrKyma.GetNamespace(), module)
+		Expect(ignoreInvalidError(err)).To(Succeed())
 	})
 	It("When enable module with none channel, expect module status become error", func() {
 		module := NewTestModuleWithChannelVersion("test", string(shared.NoneChannel), "")
@@ -12,7 +12,6 @@ import (
 var _ = Describe("Given invalid module version which is rejected by CRD validation rules", func() {
 	DescribeTable(
 		"Test enable module", func(givenCondition func() error) {
-			Skip("Version attribute is disabled for now on the CRD level")
 			Eventually(givenCondition, Timeout, Interval).Should(Succeed())
 		},
 
@@ -3,6 +3,8 @@ package custom_resource_check_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -11,10 +13,13 @@ import (
 	apicorev1 "k8s.io/api/core/v1"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
 	. "github.com/kyma-project/lifecycle-manager/pkg/testutils"
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
@@ -23,6 +28,8 @@ import (
 	. "github.com/onsi/gomega"
 )
 
+var errUnexpectedModuleConfig = errors.New("module config not propagated")
+
 var _ = Describe("Warning state propagation test", Ordered, func() {
 	customDir := "custom-dir"
 	installName := filepath.Join(customDir, "installs")
@@ -127,6 +134,116 @@ var _ = Describe("Warning state propagation test", Ordered, func() {
 	})
 })
 
+var _ = Describe("Module config propagation test", Ordered, func() {
+	customDir := "module-config-dir"
+	installName := filepath.Join(customDir, "installs")
+	deploymentName := "nginx-deployment"
+
+	It(
+		"setup OCI", func() {
+			err := PushToRemoteOCIRegistry(server, manifestFilePath, installName)
+			Expect(err).NotTo(HaveOccurred())
+		},
+	)
+	It("Propagates changes of the module config after the module CR is ready", func() {
+		By("Install test Manifest CR")
+		testManifest, kyma := NewTestManifestWithParentKyma("module-config")
+		Eventually(CreateCR, standardTimeout, standardInterval).
+			WithContext(ctx).
+			WithArguments(kcpClient, kyma).
+			Should(Succeed())
+		Eventually(AddManifestToKymaStatus, standardTimeout, standardInterval).
+			WithContext(ctx).
+			WithArguments(kcpClient, kyma.GetName(), kyma.GetNamespace(), testManifest.Name).
+			Should(Succeed())
+		manifestName := testManifest.GetName()
+		validImageSpec, err := CreateOCIImageSpecFromFile(installName, server.Listener.Addr().String(),
+			manifestFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		imageSpecByte, err := json.Marshal(validImageSpec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(InstallManifest(ctx, kcpClient, testManifest, imageSpecByte, true)).To(Succeed())
+		defaultData := testManifest.Spec.Resource.DeepCopy()
+
+		By("Ensure that the Manifest CR is ready")
+		Eventually(setDeploymentStatus(ctx, kcpClient, deploymentName, &apiappsv1.Deployment{}), standardTimeout,
+			standardInterval).Should(Succeed())
+		sampleCR := emptySampleCR(manifestName)
+		Eventually(setCRStatus(ctx, kcpClient, sampleCR, shared.StateReady), standardTimeout,
+			standardInterval).Should(Succeed())
+		Eventually(ExpectManifestStateIn(ctx, kcpClient, shared.StateReady), standardTimeout,
+			standardInterval).
+			WithArguments(manifestName).Should(Succeed())
+
+		By("When the module is configured")
+		Eventually(setModuleConfig(ctx, kcpClient, testManifest, defaultData,
+			`{"spec":{"resourceFilePath":"./configured"}}`), standardTimeout, standardInterval).Should(Succeed())
+
+		By("Then the configuration is applied to the module CR")
+		Eventually(expectResourceFilePath(ctx, kcpClient, sampleCR, "./configured"), standardTimeout,
+			standardInterval).Should(Succeed())
+
+		By("When the configuration is changed")
+		Eventually(setModuleConfig(ctx, kcpClient, testManifest, defaultData,
+			`{"spec":{"resourceFilePath":"./changed"}}`), standardTimeout, standardInterval).Should(Succeed())
+
+		By("Then the changed configuration is applied to the module CR")
+		Eventually(expectResourceFilePath(ctx, kcpClient, sampleCR, "./changed"), standardTimeout,
+			standardInterval).Should(Succeed())
+
+		By("When the configuration is removed")
+		Eventually(setModuleConfig(ctx, kcpClient, testManifest, defaultData, ""), standardTimeout,
+			standardInterval).Should(Succeed())
+
+		By("Then the configured field is removed from the module CR")
+		Eventually(expectResourceFilePath(ctx, kcpClient, sampleCR, ""), standardTimeout,
+			standardInterval).Should(Succeed())
+
+		By("cleaning up the manifest")
+		Eventually(DeleteManifestAndVerify(ctx, kcpClient, testManifest), standardTimeout,
+			standardInterval).Should(Succeed())
+	})
+})
+
+func setModuleConfig(ctx context.Context, clnt client.Client, manifest *v1beta2.Manifest,
+	defaultData *unstructured.Unstructured, config string,
+) func() error {
+	return func() error {
+		if err := clnt.Get(ctx, client.ObjectKeyFromObject(manifest), manifest); err != nil {
+			return err
+		}
+		manifest.Spec.ResourceOverride = nil
+		if config != "" {
+			manifest.Spec.ResourceOverride = &machineryruntime.RawExtension{Raw: []byte(config)}
+		}
+		resource, err := modulecr.MergeConfig(defaultData, manifest.Spec.ResourceOverride)
+		if err != nil {
+			return err
+		}
+		manifest.Spec.Resource = resource
+		return clnt.Update(ctx, manifest)
+	}
+}
+
+func expectResourceFilePath(ctx context.Context, clnt client.Client, moduleCR *unstructured.Unstructured,
+	expected string,
+) func() error {
+	return func() error {
+		if err := clnt.Get(ctx, client.ObjectKeyFromObject(moduleCR), moduleCR); err != nil {
+			return err
+		}
+		resourceFilePath, _, err := unstructured.NestedString(moduleCR.Object, "spec", "resourceFilePath")
+		if err != nil {
+			return err
+		}
+		if resourceFilePath != expected {
+			return fmt.Errorf("%w: expected resourceFilePath %q, got %q", errUnexpectedModuleConfig, expected,
+				resourceFilePath)
+		}
+		return nil
+	}
+}
+
 func asResource(name, namespace, group, version, kind string) shared.Resource {
 	return shared.Resource{
 		Name: name, Namespace: namespace,
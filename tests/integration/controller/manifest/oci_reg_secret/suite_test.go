@@ -47,6 +47,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/keychainprovider"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/manifestclient"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/spec"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	kymarepo "github.com/kyma-project/lifecycle-manager/internal/repository/kyma"
@@ -166,6 +167,9 @@ var _ = BeforeSuite(func() {
 		cachedManifestParser,
 		declarativev2.NewExistsStateCheck(),
 		"",
+		skrresources.DriftDetectionCorrect,
+		0,
+		testEventRec,
 	)
 
 	err = ctrl.NewControllerManagedBy(mgr).
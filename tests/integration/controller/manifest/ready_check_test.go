@@ -91,9 +91,9 @@ var _ = Describe("Manifest readiness check", Ordered, func() {
 		statefulChecker := statecheck.NewStatefulSetStateCheck()
 		deploymentChecker := statecheck.NewDeploymentStateCheck()
 		customStateCheck := statecheck.NewManagerStateCheck(statefulChecker, deploymentChecker)
-		state, err := customStateCheck.GetState(ctx, testClient, resources)
+		result, err := customStateCheck.GetState(ctx, testClient, nil, resources)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(state).To(Equal(shared.StateReady))
+		Expect(result.State).To(Equal(shared.StateReady))
 
 		By("cleaning up the manifest")
 		Eventually(verifyObjectExists(ctx, kcpClient, expectedDeployment.ToUnstructured()), standardTimeout,
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"time"
 
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -239,6 +240,106 @@ var _ = Describe("When some important CRDs should be skipped", Ordered, func() {
 	})
 })
 
+var _ = Describe("When a PurgePolicy is configured", Ordered, Serial, func() {
+	var skrClient client.Client
+
+	AfterEach(func() {
+		Eventually(DeleteCR, Timeout, Interval).
+			WithContext(ctx).
+			WithArguments(kcpClient, &v1beta2.PurgePolicy{ObjectMeta: apimetav1.ObjectMeta{Name: purgePolicyName}}).
+			Should(Succeed())
+	})
+
+	It("Should delete the resources of CRD groups with the Delete action", func() {
+		kyma := NewTestKyma("delete-policy-kyma")
+		skrKyma := NewSKRKyma()
+		ensureSetup(kyma, &skrClient)()
+		createPurgePolicy(v1beta2.PurgePolicySpec{
+			Rules: []v1beta2.PurgePolicyRule{{Group: "cert-manager.io", Action: v1beta2.PurgeActionDelete}},
+		})
+		issuer := createKymaWithIssuer(kyma, skrKyma, skrClient)
+
+		By("Triggering kyma deletion", func() {
+			Expect(kcpClient.Delete(ctx, kyma)).To(Succeed())
+		})
+
+		By("The resource is deleted but keeps its finalizers", func() {
+			Eventually(func() bool {
+				res := createIssuerObj()
+				Expect(skrClient.Get(ctx, client.ObjectKeyFromObject(issuer), res)).To(Succeed())
+				return !res.GetDeletionTimestamp().IsZero()
+			}, Timeout, Interval).Should(BeTrue())
+			Expect(getIssuerFinalizers(ctx, client.ObjectKeyFromObject(issuer), skrClient)).
+				To(ContainElement(testFinalizer))
+		})
+
+		By("The purge finalizer is kept while the resource is not removed", func() {
+			Consistently(getKymaFinalizers, ConsistentCheckTimeout, Interval).
+				WithContext(ctx).
+				WithArguments(client.ObjectKeyFromObject(kyma)).
+				Should(ContainElement(shared.PurgeFinalizer))
+		})
+
+		By("The purge finalizer is removed once the resource is removed", func() {
+			res := createIssuerObj()
+			Expect(skrClient.Get(ctx, client.ObjectKeyFromObject(issuer), res)).To(Succeed())
+			res.SetFinalizers(nil)
+			Expect(skrClient.Update(ctx, res)).To(Succeed())
+			Eventually(getKymaFinalizers, Timeout, Interval).
+				WithContext(ctx).
+				WithArguments(client.ObjectKeyFromObject(kyma)).
+				ShouldNot(ContainElement(shared.PurgeFinalizer))
+		})
+	})
+
+	It("Should leave the resources untouched in a dry run", func() {
+		kyma := NewTestKyma("dry-run-policy-kyma")
+		skrKyma := NewSKRKyma()
+		ensureSetup(kyma, &skrClient)()
+		createPurgePolicy(v1beta2.PurgePolicySpec{DryRun: true})
+		issuer := createKymaWithIssuer(kyma, skrKyma, skrClient)
+
+		By("Triggering kyma deletion", func() {
+			Expect(kcpClient.Delete(ctx, kyma)).To(Succeed())
+		})
+
+		By("The purge finalizer and the finalizers of the resource are kept", func() {
+			Consistently(getKymaFinalizers, ConsistentCheckTimeout, Interval).
+				WithContext(ctx).
+				WithArguments(client.ObjectKeyFromObject(kyma)).
+				Should(ContainElement(shared.PurgeFinalizer))
+			Consistently(getIssuerFinalizers, ConsistentCheckTimeout, Interval).
+				WithContext(ctx).
+				WithArguments(client.ObjectKeyFromObject(issuer), skrClient).
+				Should(ContainElement(testFinalizer))
+		})
+	})
+})
+
+func createPurgePolicy(spec v1beta2.PurgePolicySpec) {
+	policy := &v1beta2.PurgePolicy{
+		ObjectMeta: apimetav1.ObjectMeta{Name: purgePolicyName},
+		Spec:       spec,
+	}
+	Expect(kcpClient.Create(ctx, policy)).To(Succeed())
+}
+
+func createKymaWithIssuer(kyma, skrKyma *v1beta2.Kyma, skrClient client.Client) *unstructured.Unstructured {
+	Expect(kcpClient.Create(ctx, kyma)).To(Succeed())
+	Eventually(func() error {
+		if err := kcpClient.Get(ctx, client.ObjectKeyFromObject(kyma), kyma); err != nil {
+			return err
+		}
+		kyma.EnsureLabelsAndFinalizers()
+		return kcpClient.Update(ctx, kyma)
+	}, Timeout, Interval).Should(Succeed())
+
+	issuer := createIssuerFor(skrKyma, "policy")
+	Expect(issuer).NotTo(BeNil())
+	Expect(skrClient.Create(ctx, issuer)).To(Succeed())
+	return issuer
+}
+
 func createDestinationRuleObj() *unstructured.Unstructured {
 	gvk := schema.GroupVersionKind{
 		Group:   "networking.istio.io",
@@ -311,6 +412,14 @@ func createIssuerFor(kyma *v1beta2.Kyma, nameSuffix string) *unstructured.Unstru
 	return res
 }
 
+func getKymaFinalizers(ctx context.Context, key client.ObjectKey) []string {
+	kyma := &v1beta2.Kyma{}
+	if err := kcpClient.Get(ctx, key, kyma); err != nil {
+		return nil
+	}
+	return kyma.GetFinalizers()
+}
+
 func getIssuerFinalizers(ctx context.Context, key client.ObjectKey, cl client.Client) []string {
 	res := createIssuerObj()
 	Expect(cl.Get(ctx, key, res)).Should(Succeed())
@@ -35,6 +35,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api"
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/purge"
 	"github.com/kyma-project/lifecycle-manager/internal/event"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
@@ -66,6 +67,8 @@ var (
 	skipFinalizerRemovalForCRDs = "*.networking.istio.io"
 )
 
+const purgePolicyName = "policy"
+
 func TestAPIs(t *testing.T) {
 	t.Parallel()
 	RegisterFailHandler(Fail)
@@ -118,9 +121,11 @@ var _ = BeforeSuite(func() {
 		Client:                kcpClient,
 		SkrContextFactory:     testSkrContextFactory,
 		Event:                 testEventRec,
+		RateLimiter:           internal.RateLimiter(1*time.Second, 5*time.Second, 30, 200),
 		PurgeFinalizerTimeout: time.Second,
 		SkipCRDs:              matcher.CreateCRDMatcherFrom(skipFinalizerRemovalForCRDs),
 		Metrics:               metrics.NewPurgeMetrics(),
+		PolicyName:            purgePolicyName,
 	}
 
 	err = reconciler.SetupWithManager(mgr, ctrlruntime.Options{})
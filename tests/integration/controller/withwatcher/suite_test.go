@@ -209,7 +209,7 @@ var _ = BeforeSuite(func() {
 		ModulesStatusHandler: modules.NewStatusHandler(moduleStatusGen, kcpClient, noOpMetricsFunc),
 		Metrics:              kymaMetrics,
 		RemoteCatalog: remote.NewRemoteCatalogFromKyma(kcpClient, testSkrContextFactory,
-			flags.DefaultRemoteSyncNamespace),
+			flags.DefaultRemoteSyncNamespace, remote.ModuleCatalogMode(flags.DefaultModuleCatalogMode)),
 		Config:          kymaReconcilerConfig,
 		DeletionMetrics: deletionMetrics,
 		DeletionEvents:  deletionEvents,